@@ -0,0 +1,489 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/bytedance/sonic"
+	"github.com/robalyx/rotector/internal/cloudflare/manager"
+	"github.com/robalyx/rotector/internal/roblox/fetcher"
+	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/setup/telemetry"
+	"github.com/robalyx/rotector/pkg/utils"
+	"github.com/urfave/cli/v3"
+)
+
+var (
+	// ErrNoInputs indicates a command was called without any files or group IDs.
+	ErrNoInputs = errors.New("no inputs provided")
+	// ErrInvalidGroupID indicates an invalid group ID was provided.
+	ErrInvalidGroupID = errors.New("invalid group ID")
+	// ErrColumnNotFound indicates the requested CSV column does not exist.
+	ErrColumnNotFound = errors.New("column not found")
+)
+
+// importSummary reports the outcome of an import.
+type importSummary struct {
+	Source          string `json:"source"`
+	Priority        string `json:"priority"`
+	DryRun          bool   `json:"dryRun"`
+	Input           int    `json:"input"`
+	Invalid         int    `json:"invalid"`
+	Duplicates      int    `json:"duplicates"`
+	Queued          int    `json:"queued"`
+	Skipped         int    `json:"skipped"`
+	SkippedExisting int    `json:"skippedExisting"`
+	SkippedQueued   int    `json:"skippedQueued"`
+	Failed          int    `json:"failed"`
+	Error           string `json:"error,omitempty"`
+}
+
+// batchResult contains the outcome of queueing a single batch.
+type batchResult struct {
+	Queued          int
+	SkippedExisting int
+	SkippedQueued   int
+	Failed          int
+}
+
+// add merges a batch result into the summary.
+func (s *importSummary) add(result batchResult) {
+	s.Queued += result.Queued
+	s.SkippedExisting += result.SkippedExisting
+	s.SkippedQueued += result.SkippedQueued
+	s.Skipped += result.SkippedExisting + result.SkippedQueued
+	s.Failed += result.Failed
+}
+
+// importInput collects unique user IDs from one or more sources.
+type importInput struct {
+	userIDs      []int64
+	seen         map[int64]struct{}
+	sourceGroups map[int64]int64
+	invalid      []string
+	total        int
+	duplicates   int
+}
+
+// newImportInput creates an empty import input.
+func newImportInput() *importInput {
+	return &importInput{
+		seen:         make(map[int64]struct{}),
+		sourceGroups: make(map[int64]int64),
+	}
+}
+
+// addEntry parses a user ID or profile URL and adds it if valid.
+// Comments and empty entries are ignored.
+func (in *importInput) addEntry(entry string) {
+	entry = strings.TrimSpace(entry)
+	if entry == "" || strings.HasPrefix(entry, "#") {
+		return
+	}
+
+	userID, err := processUserIDInput(entry)
+	if err != nil {
+		in.total++
+		in.invalid = append(in.invalid, entry)
+
+		return
+	}
+
+	in.addID(userID)
+}
+
+// addID adds a user ID, counting it as a duplicate if already seen.
+func (in *importInput) addID(userID int64) {
+	in.total++
+
+	if _, exists := in.seen[userID]; exists {
+		in.duplicates++
+		return
+	}
+
+	in.seen[userID] = struct{}{}
+	in.userIDs = append(in.userIDs, userID)
+}
+
+// readLines adds one entry per line from the reader.
+func (in *importInput) readLines(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		in.addEntry(scanner.Text())
+	}
+
+	return scanner.Err()
+}
+
+// readCSV adds entries from a single column of CSV data. The column is
+// either a header name or a 1-based index.
+func (in *importInput) readCSV(r io.Reader, column string, hasHeader bool) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	index := -1
+	if n, err := strconv.Atoi(column); err == nil && n > 0 {
+		index = n - 1
+	}
+
+	first := true
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to read CSV: %w", err)
+		}
+
+		if first && hasHeader {
+			first = false
+
+			if index < 0 {
+				for i, name := range record {
+					if strings.EqualFold(strings.TrimSpace(name), column) {
+						index = i
+						break
+					}
+				}
+			}
+
+			if index < 0 {
+				return fmt.Errorf("%w: %s", ErrColumnNotFound, column)
+			}
+
+			continue
+		}
+
+		first = false
+
+		if index < 0 {
+			return fmt.Errorf("%w: %s (use a 1-based index without a header)", ErrColumnNotFound, column)
+		}
+
+		if index >= len(record) {
+			in.total++
+			in.invalid = append(in.invalid, strings.Join(record, ","))
+
+			continue
+		}
+
+		in.addEntry(record[index])
+	}
+
+	return nil
+}
+
+// summary creates an import summary from the collected input.
+func (in *importInput) summary(source string, priority manager.QueuePriority, dryRun bool) *importSummary {
+	return &importSummary{
+		Source:     source,
+		Priority:   string(priority),
+		DryRun:     dryRun,
+		Input:      in.total,
+		Invalid:    len(in.invalid),
+		Duplicates: in.duplicates,
+	}
+}
+
+// importCollector adds user IDs from a source to the input.
+type importCollector func(ctx context.Context, c *cli.Command, app *setup.App, in *importInput, progress io.Writer) error
+
+// importCommands builds the non-interactive import subcommands.
+func importCommands() []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "stdin",
+			Usage: "Queue user IDs or profile URLs read from standard input, one per line",
+			Description: `Examples:
+  cat ids.txt | queue stdin
+  queue stdin --dry-run --json < ids.txt`,
+			Flags:  importFlags(),
+			Action: runImport("stdin", collectStdin),
+		},
+		{
+			Name:      "file",
+			Usage:     "Queue user IDs or profile URLs from files, one per line",
+			ArgsUsage: "<path>...",
+			Flags:     importFlags(),
+			Action:    runImport("file", collectFiles),
+		},
+		{
+			Name:      "csv",
+			Usage:     "Queue user IDs or profile URLs from a CSV column",
+			ArgsUsage: "<path>...",
+			Description: `The column is a header name or a 1-based index.
+
+Examples:
+  queue csv reports.csv --column user_id
+  queue csv export.csv --column 3 --no-header`,
+			Flags: append(importFlags(),
+				&cli.StringFlag{
+					Name:    "column",
+					Usage:   "Header name or 1-based index of the column containing user IDs",
+					Value:   "1",
+					Aliases: []string{"c"},
+				},
+				&cli.BoolFlag{
+					Name:  "no-header",
+					Usage: "Treat the first row as data instead of a header",
+				},
+			),
+			Action: runImport("csv", collectCSV),
+		},
+		{
+			Name:      "group",
+			Usage:     "Queue all members of Roblox groups",
+			ArgsUsage: "<groupID|groupURL>...",
+			Description: `Groups with more members than max_group_members_track are rejected.
+Each queued user records the group it was found in.
+
+Examples:
+  queue group 1234567
+  queue group 1234567 --allies --priority reviewer`,
+			Flags: append(importFlags(),
+				&cli.BoolFlag{
+					Name:  "allies",
+					Usage: "Also queue members of allied groups",
+				},
+			),
+			Action: runImport("group", collectGroups),
+		},
+	}
+}
+
+// importFlags returns the flags shared by all import subcommands.
+func importFlags() []cli.Flag {
+	return []cli.Flag{
+		&cli.BoolFlag{
+			Name:    "dry-run",
+			Usage:   "Report what would be queued without queueing anything",
+			Aliases: []string{"n"},
+		},
+		&cli.BoolFlag{
+			Name:  "json",
+			Usage: "Print the summary as JSON on stdout and progress on stderr",
+		},
+		&cli.StringFlag{
+			Name:    "priority",
+			Usage:   "Queue lane to use (reviewer, extension, recheck or bulk)",
+			Value:   string(manager.QueuePriorityBulk),
+			Aliases: []string{"p"},
+		},
+	}
+}
+
+// runImport initializes the app, collects user IDs from a source and queues them.
+func runImport(source string, collect importCollector) cli.ActionFunc {
+	return func(ctx context.Context, c *cli.Command) error {
+		dryRun := c.Bool("dry-run")
+		jsonOutput := c.Bool("json")
+
+		priority := manager.QueuePriority(c.String("priority"))
+		if priority.Rank() == len(manager.QueuePriorities) {
+			return fmt.Errorf("%w: %q", manager.ErrInvalidPriority, priority)
+		}
+
+		// Keep stdout clean for the JSON summary
+		progress := io.Writer(os.Stdout)
+		if jsonOutput {
+			progress = os.Stderr
+		}
+
+		app, err := setup.InitializeApp(ctx, telemetry.ServiceQueue, QueueLogDir)
+		if err != nil {
+			return fmt.Errorf("failed to initialize application: %w", err)
+		}
+		defer app.Cleanup(ctx)
+
+		input := newImportInput()
+		if err := collect(ctx, c, app, input, progress); err != nil {
+			return err
+		}
+
+		for _, invalid := range input.invalid {
+			fmt.Fprintf(progress, "Skipping invalid entry: %s\n", invalid)
+		}
+
+		fmt.Fprintf(progress, "Found %d unique user IDs (%d duplicates, %d invalid).\n",
+			len(input.userIDs), input.duplicates, len(input.invalid))
+
+		summary := input.summary(source, priority, dryRun)
+
+		var queueErr error
+		if len(input.userIDs) > 0 {
+			// Make sure the queue table has priority lanes before importing
+			if !dryRun {
+				if err := app.CFClient.Queue.EnsureSchema(ctx); err != nil {
+					return fmt.Errorf("failed to prepare queue: %w", err)
+				}
+			}
+
+			queueErr = queueUsers(ctx, app, input, summary, progress)
+			if queueErr != nil {
+				summary.Error = queueErr.Error()
+			}
+		}
+
+		if jsonOutput {
+			output, err := sonic.Marshal(summary)
+			if err != nil {
+				return fmt.Errorf("failed to encode summary: %w", err)
+			}
+
+			fmt.Println(string(output))
+		} else {
+			printSummary(os.Stdout, summary)
+		}
+
+		if queueErr != nil {
+			return fmt.Errorf("failed to queue users: %w", queueErr)
+		}
+
+		return nil
+	}
+}
+
+// printSummary writes a human readable import summary.
+func printSummary(w io.Writer, summary *importSummary) {
+	queuedLabel := "Queued"
+	if summary.DryRun {
+		queuedLabel = "Would queue"
+	}
+
+	fmt.Fprintf(w, "%s %d users.\n", queuedLabel, summary.Queued)
+
+	if summary.Skipped > 0 {
+		fmt.Fprintf(w, "Skipped %d users (%d already in database, %d recently queued).\n",
+			summary.Skipped, summary.SkippedExisting, summary.SkippedQueued)
+	}
+
+	if summary.Failed > 0 {
+		fmt.Fprintf(w, "Failed to queue %d users (see logs for details).\n", summary.Failed)
+	}
+}
+
+// collectStdin reads entries from standard input.
+func collectStdin(_ context.Context, _ *cli.Command, _ *setup.App, in *importInput, _ io.Writer) error {
+	if err := in.readLines(os.Stdin); err != nil {
+		return fmt.Errorf("failed to read stdin: %w", err)
+	}
+
+	return nil
+}
+
+// collectFiles reads entries from each file argument.
+func collectFiles(_ context.Context, c *cli.Command, _ *setup.App, in *importInput, _ io.Writer) error {
+	if c.Args().Len() == 0 {
+		return fmt.Errorf("%w: expected <path>...", ErrNoInputs)
+	}
+
+	for _, path := range c.Args().Slice() {
+		if err := readFile(path, in.readLines); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectCSV reads entries from a column of each CSV file argument.
+func collectCSV(_ context.Context, c *cli.Command, _ *setup.App, in *importInput, _ io.Writer) error {
+	if c.Args().Len() == 0 {
+		return fmt.Errorf("%w: expected <path>...", ErrNoInputs)
+	}
+
+	column := c.String("column")
+	hasHeader := !c.Bool("no-header")
+
+	for _, path := range c.Args().Slice() {
+		if err := readFile(path, func(r io.Reader) error {
+			return in.readCSV(r, column, hasHeader)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// collectGroups expands each group argument to its members, and optionally
+// the members of its allies, recording the group each user was found in.
+func collectGroups(ctx context.Context, c *cli.Command, app *setup.App, in *importInput, progress io.Writer) error {
+	if c.Args().Len() == 0 {
+		return fmt.Errorf("%w: expected <groupID>...", ErrNoInputs)
+	}
+
+	memberFetcher := fetcher.NewGroupMemberFetcher(app.RoAPI, app.Logger)
+	maxMembers := app.Config.Worker.ThresholdLimits.MaxGroupMembersTrack
+	includeAllies := c.Bool("allies")
+
+	for _, arg := range c.Args().Slice() {
+		groupID, err := parseGroupID(arg)
+		if err != nil {
+			return err
+		}
+
+		expansion, err := memberFetcher.Expand(ctx, groupID, includeAllies, maxMembers, 0)
+		if err != nil {
+			return fmt.Errorf("failed to expand group %d: %w", groupID, err)
+		}
+
+		for allyID, skipErr := range expansion.Skipped {
+			fmt.Fprintf(progress, "Skipping allied group %d: %v\n", allyID, skipErr)
+		}
+
+		for userID, sourceID := range expansion.Members {
+			if _, exists := in.seen[userID]; !exists {
+				in.sourceGroups[userID] = sourceID
+			}
+
+			in.addID(userID)
+		}
+
+		fmt.Fprintf(progress, "Group %d: found %d members across %d groups\n",
+			groupID, len(expansion.Members), len(expansion.Groups))
+	}
+
+	return nil
+}
+
+// readFile opens a file and passes it to the reader function.
+func readFile(path string, read func(io.Reader) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	if err := read(file); err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// parseGroupID parses a numeric group ID or group URL.
+func parseGroupID(input string) (int64, error) {
+	groupIDStr := strings.TrimSpace(input)
+
+	if parsed, err := utils.ExtractGroupIDFromURL(groupIDStr); err == nil {
+		groupIDStr = parsed
+	}
+
+	groupID, err := strconv.ParseInt(groupIDStr, 10, 64)
+	if err != nil || groupID <= 0 {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidGroupID, input)
+	}
+
+	return groupID, nil
+}
@@ -1,10 +1,10 @@
 package main
 
 import (
-	"bufio"
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"os/exec"
@@ -48,8 +48,9 @@ func main() {
 
 func run() error {
 	app := &cli.Command{
-		Name:  "queue",
-		Usage: "Queue Roblox users for processing",
+		Name:     "queue",
+		Usage:    "Queue Roblox users for processing",
+		Commands: importCommands(),
 		Action: func(ctx context.Context, _ *cli.Command) error {
 			// Initialize application with required dependencies
 			app, err := setup.InitializeApp(ctx, telemetry.ServiceQueue, QueueLogDir)
@@ -80,29 +81,30 @@ func run() error {
 			_, _ = fmt.Scanln()
 
 			// Read and process the file
-			userIDs, err := readUserIDsFromFile(tempFile)
+			input, err := readUserIDsFromFile(tempFile)
 			if err != nil {
 				return fmt.Errorf("failed to read user IDs: %w", err)
 			}
 
-			if len(userIDs) == 0 {
+			if len(input.userIDs) == 0 {
 				fmt.Println("No valid user IDs found in the file.")
 				return nil
 			}
 
-			fmt.Printf("Found %d user IDs to queue.\n", len(userIDs))
+			fmt.Printf("Found %d user IDs to queue.\n", len(input.userIDs))
+
+			// Make sure the queue table has priority lanes before importing
+			if err := app.CFClient.Queue.EnsureSchema(ctx); err != nil {
+				return fmt.Errorf("failed to prepare queue: %w", err)
+			}
 
 			// Queue users in batches
-			totalQueued, totalFailed, err := queueUsers(ctx, app, userIDs)
-			if err != nil {
+			summary := input.summary("editor", manager.QueuePriorityBulk, false)
+			if err := queueUsers(ctx, app, input, summary, os.Stdout); err != nil {
 				return fmt.Errorf("failed to queue users: %w", err)
 			}
 
-			fmt.Printf("Successfully queued %d users.\n", totalQueued)
-
-			if totalFailed > 0 {
-				fmt.Printf("Failed to queue %d users (see logs for details).\n", totalFailed)
-			}
+			printSummary(os.Stdout, summary)
 
 			return nil
 		},
@@ -170,63 +172,29 @@ func openFileInEditor(ctx context.Context, filename string) error {
 }
 
 // readUserIDsFromFile reads and parses user IDs from the temporary file.
-func readUserIDsFromFile(filename string) ([]int64, error) {
+func readUserIDsFromFile(filename string) (*importInput, error) {
 	file, err := os.Open(filename)
 	if err != nil {
 		return nil, err
 	}
 	defer file.Close()
 
-	var (
-		userIDs       []int64
-		invalidInputs []string
-	)
-
-	processedLines := make(map[string]struct{})
-
-	scanner := bufio.NewScanner(file)
-	lineNum := 0
-
-	for scanner.Scan() {
-		lineNum++
-		line := strings.TrimSpace(scanner.Text())
-
-		// Skip comments and empty lines
-		if line == "" || strings.HasPrefix(line, "#") {
-			continue
-		}
-
-		// Skip duplicate lines
-		if _, exists := processedLines[line]; exists {
-			continue
-		}
-
-		processedLines[line] = struct{}{}
-
-		userID, err := processUserIDInput(line)
-		if err != nil {
-			invalidInputs = append(invalidInputs, fmt.Sprintf("line %d: %s", lineNum, line))
-			continue
-		}
-
-		userIDs = append(userIDs, userID)
-	}
-
-	if err := scanner.Err(); err != nil {
+	input := newImportInput()
+	if err := input.readLines(file); err != nil {
 		return nil, err
 	}
 
-	if len(invalidInputs) > 0 {
-		fmt.Printf("Warning: Found %d invalid entries:\n", len(invalidInputs))
+	if len(input.invalid) > 0 {
+		fmt.Printf("Warning: Found %d invalid entries:\n", len(input.invalid))
 
-		for _, invalid := range invalidInputs {
+		for _, invalid := range input.invalid {
 			fmt.Printf("  - %s\n", invalid)
 		}
 
 		fmt.Println()
 	}
 
-	return userIDs, nil
+	return input, nil
 }
 
 // processUserIDInput processes a single line of input and returns the parsed user ID if valid.
@@ -258,97 +226,111 @@ func processUserIDInput(line string) (int64, error) {
 }
 
 // queueUsers queues multiple users for processing, handling batching and error reporting.
-func queueUsers(ctx context.Context, app *setup.App, userIDs []int64) (int, int, error) {
-	var (
-		totalQueued int
-		totalFailed int
-	)
-
+// Results are added to the summary and batch progress is written to the progress writer.
+func queueUsers(
+	ctx context.Context, app *setup.App, input *importInput, summary *importSummary, progress io.Writer,
+) error {
 	logger := app.Logger.Named("queue_cli")
+	userIDs := input.userIDs
 
 	for i := 0; i < len(userIDs); i += manager.MaxQueueBatchSize {
 		end := min(i+manager.MaxQueueBatchSize, len(userIDs))
 		batch := userIDs[i:end]
 
-		queuedCount, failedCount, err := queueUserBatch(ctx, app, batch, logger)
+		result, err := queueUserBatch(ctx, app, batch, input.sourceGroups, summary, logger)
 		if err != nil {
-			return totalQueued, totalFailed, err
+			summary.Failed += len(userIDs) - i
+
+			return err
 		}
 
-		totalQueued += queuedCount
-		totalFailed += failedCount
+		summary.add(result)
 
-		fmt.Printf("Processed batch %d-%d: %d queued, %d failed\n", i+1, end, queuedCount, failedCount)
+		fmt.Fprintf(progress, "Processed batch %d-%d of %d: %d queued, %d skipped, %d failed\n",
+			i+1, end, len(userIDs), result.Queued, result.SkippedExisting+result.SkippedQueued, result.Failed)
 	}
 
-	return totalQueued, totalFailed, nil
+	return nil
 }
 
 // queueUserBatch queues a batch of users and returns the results.
-func queueUserBatch(ctx context.Context, app *setup.App, batch []int64, logger *zap.Logger) (int, int, error) {
+// Users already in the database or queued within the past 7 days are skipped.
+// In a dry run, users that would be queued are counted without being queued.
+func queueUserBatch(
+	ctx context.Context, app *setup.App, batch []int64, sourceGroups map[int64]int64,
+	summary *importSummary, logger *zap.Logger,
+) (batchResult, error) {
+	var result batchResult
+
 	// Check which users already exist in database
 	existingUsers, err := app.DB.Service().User().GetUsersByIDs(ctx, batch, types.UserFieldBasic)
 	if err != nil {
 		logger.Error("Failed to check existing users in database", zap.Error(err))
-		return 0, len(batch), err
+		return result, err
+	}
+
+	// Check which users are already waiting in the D1 queue
+	recentlyQueued, err := app.CFClient.Queue.GetRecentlyQueued(ctx, batch)
+	if err != nil {
+		logger.Error("Failed to check queued users", zap.Error(err))
+		return result, err
 	}
 
-	// Filter out users that already exist
-	existingUserSet := make(map[int64]struct{})
+	// Filter out users that already exist or are queued
 	usersToQueue := make([]int64, 0, len(batch))
 
 	for _, userID := range batch {
 		if _, exists := existingUsers[userID]; exists {
-			existingUserSet[userID] = struct{}{}
+			result.SkippedExisting++
+
 			logger.Debug("Skipping user - already exists in database", zap.Int64("userID", userID))
 
 			continue
 		}
 
-		usersToQueue = append(usersToQueue, userID)
-	}
-
-	// Queue the remaining users in D1 if any
-	queueErrors := make(map[int64]error)
+		if _, queued := recentlyQueued[userID]; queued {
+			result.SkippedQueued++
 
-	if len(usersToQueue) > 0 {
-		var err error
+			logger.Debug("Skipping user - recently queued", zap.Int64("userID", userID))
 
-		queueErrors, err = app.CFClient.Queue.AddUsers(ctx, usersToQueue)
-		if err != nil {
-			logger.Error("Failed to queue batch", zap.Error(err))
-			return 0, len(batch), err
+			continue
 		}
+
+		usersToQueue = append(usersToQueue, userID)
 	}
 
-	// Process results and create activity logs
-	var (
-		queuedCount int
-		failedCount int
-	)
+	if summary.DryRun || len(usersToQueue) == 0 {
+		result.Queued = len(usersToQueue)
+		return result, nil
+	}
 
-	for _, userID := range batch {
-		// Skip if user exists in database
-		if _, existsInDB := existingUserSet[userID]; existsInDB {
-			failedCount++
-			continue
-		}
+	// Queue the remaining users in D1
+	queueErrors, err := app.CFClient.Queue.AddUsersFromGroups(
+		ctx, usersToQueue, manager.QueuePriority(summary.Priority), sourceGroups,
+	)
+	if err != nil {
+		logger.Error("Failed to queue batch", zap.Error(err))
+		return result, err
+	}
 
+	for _, userID := range usersToQueue {
 		// Check if user had D1 queue errors
 		if queueErr, failed := queueErrors[userID]; failed {
-			failedCount++
-
 			if errors.Is(queueErr, manager.ErrUserRecentlyQueued) {
+				result.SkippedQueued++
+
 				logger.Warn("User recently queued", zap.Int64("userID", userID))
 			} else {
+				result.Failed++
+
 				logger.Error("Failed to queue user", zap.Int64("userID", userID), zap.Error(queueErr))
 			}
 
 			continue
 		}
 
-		queuedCount++
+		result.Queued++
 	}
 
-	return queuedCount, failedCount, nil
+	return result, nil
 }
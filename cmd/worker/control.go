@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/robalyx/rotector/internal/redis"
+	"github.com/robalyx/rotector/internal/setup/config"
+	"github.com/robalyx/rotector/internal/worker/core"
+	"github.com/urfave/cli/v3"
+	"go.uber.org/zap"
+)
+
+// controlActor identifies changes made through the CLI.
+const controlActor = "cli"
+
+// ErrMissingArguments indicates a control command was called without required arguments.
+var ErrMissingArguments = errors.New("missing arguments")
+
+// controlCommand builds the command used to manage running workers.
+func controlCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "control",
+		Usage: "Pause, resume, drain or reconfigure running workers",
+		Commands: []*cli.Command{
+			{
+				Name:  "status",
+				Usage: "Show control state for all worker types",
+				Action: withControlClient(func(ctx context.Context, _ *cli.Command, client *core.ControlClient) error {
+					states, err := client.GetAllStates(ctx)
+					if err != nil {
+						return err
+					}
+
+					printControlStates(states)
+
+					return nil
+				}),
+			},
+			{
+				Name:      "pause",
+				Usage:     "Pause workers of a type at their next checkpoint",
+				ArgsUsage: "<type>",
+				Action: withWorkerType(func(ctx context.Context, client *core.ControlClient, workerType string) error {
+					return client.Pause(ctx, workerType, controlActor)
+				}),
+			},
+			{
+				Name:      "resume",
+				Usage:     "Resume paused or drained workers of a type",
+				ArgsUsage: "<type>",
+				Action: withWorkerType(func(ctx context.Context, client *core.ControlClient, workerType string) error {
+					return client.Resume(ctx, workerType, controlActor)
+				}),
+			},
+			{
+				Name:      "drain",
+				Usage:     "Let workers of a type finish their current batch and exit",
+				ArgsUsage: "<type>",
+				Action: withWorkerType(func(ctx context.Context, client *core.ControlClient, workerType string) error {
+					return client.Drain(ctx, workerType, controlActor)
+				}),
+			},
+			{
+				Name:      "run",
+				Usage:     "Wake idle workers of a type to run immediately",
+				ArgsUsage: "<type>",
+				Action: withWorkerType(func(ctx context.Context, client *core.ControlClient, workerType string) error {
+					return client.RunNow(ctx, workerType, controlActor)
+				}),
+			},
+			{
+				Name:      "set",
+				Usage:     "Override a batch size or threshold for a worker type",
+				ArgsUsage: "<type> <key> <value>",
+				Action: withControlClient(func(ctx context.Context, c *cli.Command, client *core.ControlClient) error {
+					if c.Args().Len() < 3 {
+						return fmt.Errorf("%w: expected <type> <key> <value>", ErrMissingArguments)
+					}
+
+					return client.SetOverride(ctx, c.Args().Get(0), c.Args().Get(1), c.Args().Get(2), controlActor)
+				}),
+			},
+			{
+				Name:      "clear",
+				Usage:     "Remove all config overrides for a worker type",
+				ArgsUsage: "<type>",
+				Action: withWorkerType(func(ctx context.Context, client *core.ControlClient, workerType string) error {
+					return client.ClearOverrides(ctx, workerType, controlActor)
+				}),
+			},
+			{
+				Name:  "keys",
+				Usage: "List config keys that can be overridden",
+				Action: func(_ context.Context, _ *cli.Command) error {
+					for _, key := range core.OverrideKeys() {
+						fmt.Println(key)
+					}
+
+					return nil
+				},
+			},
+		},
+	}
+}
+
+// withControlClient connects to the worker status database and runs the action with a control client.
+func withControlClient(
+	action func(ctx context.Context, c *cli.Command, client *core.ControlClient) error,
+) cli.ActionFunc {
+	return func(ctx context.Context, c *cli.Command) error {
+		cfg, _, err := config.LoadConfig()
+		if err != nil {
+			return fmt.Errorf("failed to load config: %w", err)
+		}
+
+		logger := zap.NewNop()
+
+		redisManager := redis.NewManager(&cfg.Common.Redis, logger)
+		defer redisManager.Close()
+
+		statusClient, err := redisManager.GetClient(redis.WorkerStatusDBIndex)
+		if err != nil {
+			return fmt.Errorf("failed to connect to Redis: %w", err)
+		}
+
+		return action(ctx, c, core.NewControlClient(statusClient, logger))
+	}
+}
+
+// withWorkerType wraps an action that operates on a single worker type argument.
+func withWorkerType(
+	action func(ctx context.Context, client *core.ControlClient, workerType string) error,
+) cli.ActionFunc {
+	return withControlClient(func(ctx context.Context, c *cli.Command, client *core.ControlClient) error {
+		workerType := c.Args().First()
+		if workerType == "" {
+			return fmt.Errorf("%w: expected <type>", ErrMissingArguments)
+		}
+
+		if err := action(ctx, client, workerType); err != nil {
+			return err
+		}
+
+		fmt.Printf("Updated %s workers.\n", workerType)
+
+		return nil
+	})
+}
+
+// printControlStates prints control states as a table.
+func printControlStates(states []*core.ControlState) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "TYPE\tPAUSED\tDRAIN\tOVERRIDES\tUPDATED")
+
+	for _, state := range states {
+		overrides := make([]string, 0, len(state.Overrides))
+		for key, value := range state.Overrides {
+			overrides = append(overrides, key+"="+value)
+		}
+
+		slices.Sort(overrides)
+
+		drain := "-"
+		if !state.DrainAt.IsZero() {
+			drain = state.DrainAt.Format(time.DateTime)
+		}
+
+		updated := "-"
+		if !state.UpdatedAt.IsZero() {
+			updated = fmt.Sprintf("%s by %s", state.UpdatedAt.Format(time.DateTime), state.UpdatedBy)
+		}
+
+		overrideText := "-"
+		if len(overrides) > 0 {
+			overrideText = strings.Join(overrides, ", ")
+		}
+
+		fmt.Fprintf(w, "%s\t%t\t%s\t%s\t%s\n", state.WorkerType, state.Paused, drain, overrideText, updated)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/robalyx/rotector/internal/tui"
 	"github.com/robalyx/rotector/internal/tui/components"
 	"github.com/robalyx/rotector/internal/worker/category"
+	"github.com/robalyx/rotector/internal/worker/core"
 	"github.com/robalyx/rotector/internal/worker/friend"
 	"github.com/robalyx/rotector/internal/worker/group"
 	"github.com/robalyx/rotector/internal/worker/maintenance"
@@ -136,6 +137,7 @@ func run() error {
 					return nil
 				},
 			},
+			controlCommand(),
 		},
 	}
 
@@ -190,6 +192,15 @@ func runWorkers(ctx context.Context, workerType string, count int) {
 		startupDelay = 2000 // Default to 2000ms if not configured
 	}
 
+	// Hostname identifies the worker slots of this machine
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+
+	// Control client lets drained workers exit instead of restarting
+	control := core.NewControlClient(app.StatusClient, app.Logger)
+
 	// Start workers
 	var wg stdSync.WaitGroup
 	for workerID := range count {
@@ -208,6 +219,9 @@ func runWorkers(ctx context.Context, workerType string, count int) {
 			// Generate unique instance ID for this worker
 			instanceID := fmt.Sprintf("%s_%d", app.LogManager.GetInstanceID(), workerID)
 
+			// The slot stays the same across process restarts so crash loops can be detected
+			slot := fmt.Sprintf("%s/%s/%d", hostname, workerType, workerID)
+
 			// Get progress bar for this worker
 			bar := bars[workerID]
 
@@ -236,7 +250,7 @@ func runWorkers(ctx context.Context, workerType string, count int) {
 				log.Fatalf("Invalid worker type: %s", workerType)
 			}
 
-			runWorker(ctx, w, workerLogger)
+			runWorker(core.WithSlot(ctx, slot), w, workerType, control, workerLogger)
 		})
 	}
 
@@ -245,7 +259,11 @@ func runWorkers(ctx context.Context, workerType string, count int) {
 }
 
 // runWorker runs a single worker in a loop with error recovery.
-func runWorker(ctx context.Context, w interface{ Start(context.Context) }, logger *zap.Logger) {
+// The loop ends when the context is cancelled or the worker type is drained.
+func runWorker(
+	ctx context.Context, w interface{ Start(context.Context) }, workerType string,
+	control *core.ControlClient, logger *zap.Logger,
+) {
 	for {
 		select {
 		case <-ctx.Done():
@@ -276,6 +294,12 @@ func runWorker(ctx context.Context, w interface{ Start(context.Context) }, logge
 				return
 			}
 
+			// Check if the worker was drained through the control plane
+			if control.IsDraining(ctx, workerType) {
+				logger.Info("Worker drained, not restarting")
+				return
+			}
+
 			logger.Warn("Worker stopped unexpectedly",
 				zap.String("worker_type", fmt.Sprintf("%T", w)),
 			)
@@ -0,0 +1,60 @@
+package ai
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robalyx/rotector/internal/ai/client"
+	"github.com/robalyx/rotector/internal/setup/config"
+	"github.com/robalyx/rotector/pkg/utils"
+)
+
+// newAdaptiveController creates the batch size and concurrency controller for an analyzer.
+// The configured values are used as-is when adaptive batching is disabled.
+func newAdaptiveController(
+	cfg *config.AdaptiveBatchingConfig, batchSize, concurrency int, maxCompletionTokens int64,
+) *utils.AIMDController {
+	if !cfg.Enabled {
+		return utils.NewFixedAIMDController(batchSize, concurrency)
+	}
+
+	// Unset maximums never grow past the configured values
+	maxBatch := cfg.MaxBatchSize
+	if maxBatch <= 0 {
+		maxBatch = batchSize
+	}
+
+	maxConcurrency := cfg.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = concurrency
+	}
+
+	return utils.NewAIMDController(batchSize, concurrency, utils.AIMDBounds{
+		MinBatch:       cfg.MinBatchSize,
+		MaxBatch:       maxBatch,
+		MinConcurrency: cfg.MinConcurrency,
+		MaxConcurrency: maxConcurrency,
+	}, utils.AIMDOptions{
+		TargetLatency:  cfg.TargetLatency,
+		TokenBudget:    int64(cfg.TokenBudgetRatio * float64(maxCompletionTokens)),
+		DecreaseFactor: 0.5,
+	})
+}
+
+// observeAdaptive reports the outcome of an AI request to the controller.
+func observeAdaptive(controller *utils.AIMDController, start time.Time, completionTokens int64, err error) {
+	controller.Observe(utils.AIMDOutcome{
+		Latency:     time.Since(start),
+		Tokens:      completionTokens,
+		Truncated:   errors.Is(err, client.ErrResponseTruncated),
+		RateLimited: client.IsRateLimited(err),
+		Failed:      err != nil,
+	})
+}
+
+// formatAdaptiveState formats a controller's settings for worker status.
+func formatAdaptiveState(controller *utils.AIMDController) string {
+	state := controller.State()
+	return fmt.Sprintf("batch %d, concurrency %d", state.BatchSize, state.Concurrency)
+}
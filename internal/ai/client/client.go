@@ -5,7 +5,10 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -13,6 +16,7 @@ import (
 	"github.com/openai/openai-go/option"
 	"github.com/openai/openai-go/packages/ssestream"
 	"github.com/robalyx/rotector/internal/cloudflare/manager"
+	"github.com/robalyx/rotector/internal/metrics"
 	"github.com/robalyx/rotector/internal/setup/config"
 	"github.com/robalyx/rotector/pkg/utils"
 	"github.com/sony/gobreaker"
@@ -113,25 +117,28 @@ func (c *AIClient) blockIndefinitely(ctx context.Context, model string, err erro
 
 // trackUsage records AI usage statistics to the D1 database.
 func (c *AIClient) trackUsage(ctx context.Context, modelName string, usage openai.CompletionUsage) {
+	// Extract token counts
+	promptTokens := usage.PromptTokens
+	completionTokens := usage.CompletionTokens
+	reasoningTokens := usage.CompletionTokensDetails.ReasoningTokens
+
 	// Look up pricing for this model
 	pricing, ok := c.modelPricing[modelName]
 	if !ok {
+		metrics.AddAIUsage(modelName, promptTokens, completionTokens, reasoningTokens, 0)
 		c.logger.Warn("No pricing configured for model, skipping usage tracking",
 			zap.String("model", modelName))
 
 		return
 	}
 
-	// Extract token counts
-	promptTokens := usage.PromptTokens
-	completionTokens := usage.CompletionTokens
-	reasoningTokens := usage.CompletionTokensDetails.ReasoningTokens
-
 	// Calculate cost (pricing is per million tokens)
 	cost := (float64(promptTokens)*pricing.Input +
 		float64(completionTokens)*pricing.Completion +
 		float64(reasoningTokens)*pricing.Reasoning) / 1_000_000
 
+	metrics.AddAIUsage(modelName, promptTokens, completionTokens, reasoningTokens, cost)
+
 	// Get today's date in UTC formatted as YYYY-MM-DD
 	date := time.Now().UTC().Format("2006-01-02")
 
@@ -162,6 +169,65 @@ func (c *AIClient) applyModelSettings(params *openai.ChatCompletionNewParams) {
 	params.SetExtraFields(extraFields)
 }
 
+// observeRequest records the outcome and latency of a chat completion request.
+func observeRequest(model string, err error, start time.Time) {
+	status := metrics.StatusSuccess
+
+	switch {
+	case errors.Is(err, utils.ErrContentBlocked):
+		status = metrics.StatusBlocked
+	case err != nil:
+		status = metrics.StatusError
+	}
+
+	metrics.ObserveAIRequest(model, status, time.Since(start))
+}
+
+// streamObserver records exactly one outcome for a streaming request, whichever of
+// the response body or the request error reports it first.
+type streamObserver struct {
+	model string
+	start time.Time
+	once  sync.Once
+}
+
+// observe records the outcome of the request if it has not been recorded yet.
+func (o *streamObserver) observe(err error) {
+	o.once.Do(func() { observeRequest(o.model, err, o.start) })
+}
+
+// observedBody records the outcome and latency of a streamed response once it is closed.
+type observedBody struct {
+	io.ReadCloser
+
+	observer *streamObserver
+	err      error
+}
+
+// Read reads from the response body and keeps the first read error.
+func (b *observedBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) && b.err == nil {
+		b.err = err
+	}
+
+	return n, err
+}
+
+// Close closes the response body and records the request.
+func (b *observedBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.observer.observe(b.err)
+
+	return err
+}
+
+// IsRateLimited checks if the error was caused by a rate limit response.
+func IsRateLimited(err error) bool {
+	var apiErr *openai.Error
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTooManyRequests
+}
+
 // chatCompletions implements the ChatCompletions interface.
 type chatCompletions struct {
 	client *AIClient
@@ -187,16 +253,16 @@ func (c *chatCompletions) New(ctx context.Context, params openai.ChatCompletionN
 
 	// Execute request
 	result, err := c.client.breaker.Execute(func() (any, error) {
+		start := time.Now()
+
 		resp, err := c.client.client.Chat.Completions.New(ctx, params)
-		if err != nil {
-			return resp, err
+		if err == nil {
+			err = c.checkBlockReasons(resp, params.Model)
 		}
 
-		if bl := c.checkBlockReasons(resp, params.Model); bl != nil {
-			return resp, bl
-		}
+		observeRequest(originalModel, err, start)
 
-		return resp, nil
+		return resp, err
 	})
 	if err != nil {
 		switch {
@@ -284,16 +350,16 @@ func (c *chatCompletions) NewWithRetry(
 		result, err := c.client.breaker.Execute(func() (any, error) {
 			var execErr error
 
+			start := time.Now()
+
 			resp, execErr = c.client.client.Chat.Completions.New(ctx, params)
-			if execErr != nil {
-				return resp, execErr
+			if execErr == nil {
+				execErr = c.checkBlockReasons(resp, params.Model)
 			}
 
-			if bl := c.checkBlockReasons(resp, params.Model); bl != nil {
-				return resp, bl
-			}
+			observeRequest(originalModel, execErr, start)
 
-			return resp, nil
+			return resp, execErr
 		})
 		if err != nil {
 			lastErr = err
@@ -417,8 +483,21 @@ func (c *chatCompletions) NewStreaming(
 
 	// Execute stream creation with circuit breaker
 	result, err := c.client.breaker.Execute(func() (any, error) {
-		stream := c.client.client.Chat.Completions.NewStreaming(ctx, params)
+		observer := &streamObserver{model: originalModel, start: time.Now()}
+
+		// Latency is recorded when the response body is closed so it covers the whole stream.
+		// Error responses are closed by the SDK and are recorded through the stream error instead.
+		stream := c.client.client.Chat.Completions.NewStreaming(ctx, params,
+			option.WithMiddleware(func(req *http.Request, next option.MiddlewareNext) (*http.Response, error) {
+				resp, err := next(req)
+				if err == nil && resp != nil && resp.StatusCode < http.StatusBadRequest {
+					resp.Body = &observedBody{ReadCloser: resp.Body, observer: observer}
+				}
+
+				return resp, err
+			}))
 		if stream.Err() != nil {
+			observer.observe(stream.Err())
 			return nil, stream.Err()
 		}
 
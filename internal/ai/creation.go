@@ -0,0 +1,286 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+	"github.com/sourcegraph/conc/pool"
+	"go.uber.org/zap"
+)
+
+const (
+	// CreationAnalysisBatchSize is the number of creations to analyze in one AI request.
+	CreationAnalysisBatchSize = 50
+	// MaxCreationImages is the maximum number of creation images analyzed per user.
+	MaxCreationImages = 30
+)
+
+// CreationAnalyzerParams contains all the parameters needed for creation analysis processing.
+type CreationAnalyzerParams struct {
+	Users      []*types.ReviewUser                          `json:"users"`
+	ReasonsMap map[int64]types.Reasons[enum.UserReasonType] `json:"reasonsMap"`
+}
+
+// creationRef links a creation summary key back to its creator.
+type creationRef struct {
+	user     *types.ReviewUser
+	creation *types.CreationInfo
+}
+
+// creationFinding is a single flagged creation with its evidence.
+type creationFinding struct {
+	evidence   string
+	confidence float64
+}
+
+// CreationAnalyzer analyzes places and catalog items created by users. Names and
+// descriptions go through the profile text analysis while thumbnails go through
+// the outfit vision analysis.
+type CreationAnalyzer struct {
+	userAnalyzer   *UserAnalyzer
+	outfitAnalyzer *OutfitAnalyzer
+	logger         *zap.Logger
+}
+
+// NewCreationAnalyzer creates a CreationAnalyzer.
+func NewCreationAnalyzer(
+	userAnalyzer *UserAnalyzer, outfitAnalyzer *OutfitAnalyzer, logger *zap.Logger,
+) *CreationAnalyzer {
+	return &CreationAnalyzer{
+		userAnalyzer:   userAnalyzer,
+		outfitAnalyzer: outfitAnalyzer,
+		logger:         logger.Named("ai_creation"),
+	}
+}
+
+// ProcessUsers analyzes the creations of a batch of users and adds creation reasons
+// to users whose places or catalog items contain violations.
+func (a *CreationAnalyzer) ProcessUsers(ctx context.Context, params *CreationAnalyzerParams) {
+	var (
+		findings = make(map[int64][]creationFinding)
+		mu       sync.Mutex
+	)
+
+	// Analyze names and descriptions
+	a.analyzeTexts(ctx, params.Users, findings, &mu)
+
+	// Analyze place icons and catalog item images only for users already flagged by
+	// other checks or by their creation texts, as each user may need many vision calls
+	imageUsers := make([]*types.ReviewUser, 0, len(params.Users))
+	for _, user := range params.Users {
+		if len(params.ReasonsMap[user.ID]) > 0 || len(findings[user.ID]) > 0 {
+			imageUsers = append(imageUsers, user)
+		}
+	}
+
+	a.analyzeImages(ctx, imageUsers, findings, &mu)
+
+	// Add reasons for users with flagged creations
+	for _, user := range params.Users {
+		userFindings, ok := findings[user.ID]
+		if !ok || len(userFindings) == 0 {
+			continue
+		}
+
+		var highestConfidence float64
+
+		evidence := make([]string, 0, len(userFindings))
+		for _, finding := range userFindings {
+			evidence = append(evidence, finding.evidence)
+			highestConfidence = math.Max(highestConfidence, finding.confidence)
+		}
+
+		// Boost confidence slightly for each additional flagged creation
+		confidence := math.Min(highestConfidence+0.05*float64(len(userFindings)-1), 1.0)
+		confidence = math.Round(confidence*100) / 100
+
+		if _, exists := params.ReasonsMap[user.ID]; !exists {
+			params.ReasonsMap[user.ID] = make(types.Reasons[enum.UserReasonType])
+		}
+
+		params.ReasonsMap[user.ID].Add(enum.UserReasonTypeCreations, &types.Reason{
+			Message:    fmt.Sprintf("User created %d place(s) or item(s) with inappropriate content.", len(userFindings)),
+			Confidence: confidence,
+			Evidence:   evidence,
+		})
+
+		a.logger.Info("AI flagged user with inappropriate creations",
+			zap.Int64("userID", user.ID),
+			zap.String("username", user.Name),
+			zap.Int("flaggedCreations", len(userFindings)),
+			zap.Float64("confidence", confidence))
+	}
+
+	a.logger.Info("Finished processing creations",
+		zap.Int("totalUsers", len(params.Users)),
+		zap.Int("flaggedUsers", len(findings)))
+}
+
+// analyzeTexts runs creation names and descriptions through the profile text analysis.
+// Each creation is sent as a summary keyed by a short ID with its name as the display name.
+func (a *CreationAnalyzer) analyzeTexts(
+	ctx context.Context, users []*types.ReviewUser, findings map[int64][]creationFinding, mu *sync.Mutex,
+) {
+	// Build summaries with short keys that map back to creations
+	refs := make(map[string]creationRef)
+	summaries := make([]UserSummary, 0)
+
+	for _, user := range users {
+		for _, creation := range user.Creations {
+			key := "c" + strconv.Itoa(len(summaries)+1)
+			refs[key] = creationRef{user: user, creation: creation}
+
+			description := creation.Description
+			if description == "" {
+				description = "No description"
+			}
+
+			summaries = append(summaries, UserSummary{
+				Name:        key,
+				DisplayName: creation.Name,
+				Description: description,
+			})
+		}
+	}
+
+	if len(summaries) == 0 {
+		return
+	}
+
+	p := pool.New().WithContext(ctx)
+
+	for i := 0; i < len(summaries); i += CreationAnalysisBatchSize {
+		batch := summaries[i:min(i+CreationAnalysisBatchSize, len(summaries))]
+
+		p.Go(func(ctx context.Context) error {
+			result, err := a.userAnalyzer.AnalyzeSummaries(ctx, batch)
+			if err != nil {
+				a.logger.Warn("Failed to analyze creation batch",
+					zap.Error(err),
+					zap.Int("batchSize", len(batch)))
+
+				return nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			for _, flagged := range result.Users {
+				ref, ok := refs[flagged.Name]
+				if !ok {
+					a.logger.Info("AI flagged non-existent creation", zap.String("key", flagged.Name))
+					continue
+				}
+
+				if flagged.Confidence < 0.1 || flagged.Confidence > 1.0 {
+					continue
+				}
+
+				findings[ref.user.ID] = append(findings[ref.user.ID], creationFinding{
+					evidence: fmt.Sprintf("%s: %s (%d) - %s",
+						ref.creation.Type, ref.creation.Name, ref.creation.ID, flagged.Hint),
+					confidence: flagged.Confidence,
+				})
+			}
+
+			return nil
+		})
+	}
+
+	if err := p.Wait(); err != nil {
+		a.logger.Error("Error during creation text analysis", zap.Error(err))
+	}
+}
+
+// analyzeImages runs place icons and catalog item thumbnails through the outfit vision analysis.
+// Each image is sent under a short key that maps back to its creation.
+func (a *CreationAnalyzer) analyzeImages(
+	ctx context.Context, users []*types.ReviewUser, findings map[int64][]creationFinding, mu *sync.Mutex,
+) {
+	p := pool.New().WithContext(ctx)
+
+	for _, user := range users {
+		// Collect place icons and uploaded catalog item thumbnails
+		images := make(map[string]string)
+		refs := make(map[string]*types.CreationInfo)
+
+		for _, creation := range user.Creations {
+			if creation.ThumbnailURL == "" {
+				continue
+			}
+
+			if len(images) >= MaxCreationImages {
+				break
+			}
+
+			key := "c" + strconv.Itoa(len(images)+1)
+			images[key] = creation.ThumbnailURL
+			refs[key] = creation
+		}
+
+		if len(images) == 0 {
+			continue
+		}
+
+		p.Go(func(ctx context.Context) error {
+			result, err := a.outfitAnalyzer.analyzeImages(ctx, user, images)
+			if err != nil {
+				if !errors.Is(err, ErrNoOutfits) {
+					a.logger.Warn("Failed to analyze creation images",
+						zap.Error(err),
+						zap.Int64("userID", user.ID))
+				}
+
+				return nil
+			}
+
+			// Apply the same flagging criteria as outfits
+			confidence := result.highestConfidence
+
+			switch {
+			case result.uniqueFlaggedCount > 1 && confidence >= 0.5:
+				// Multiple flagged items keep their full confidence
+			case result.uniqueFlaggedCount == 1 && confidence >= 0.7:
+				confidence *= 0.6
+			default:
+				return nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			for _, theme := range result.suspiciousThemes {
+				// Themes are formatted as "key|theme|confidence"
+				parts := strings.SplitN(theme, "|", 3)
+				if len(parts) < 2 {
+					continue
+				}
+
+				creation, ok := refs[parts[0]]
+				if !ok {
+					a.logger.Info("AI flagged non-existent creation image", zap.String("key", parts[0]))
+					continue
+				}
+
+				findings[user.ID] = append(findings[user.ID], creationFinding{
+					evidence: fmt.Sprintf("%s: %s (%d) - %s",
+						creation.Type, creation.Name, creation.ID, parts[1]),
+					confidence: confidence,
+				})
+			}
+
+			return nil
+		})
+	}
+
+	if err := p.Wait(); err != nil {
+		a.logger.Error("Error during creation image analysis", zap.Error(err))
+	}
+}
@@ -0,0 +1,356 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/alpkeskin/gotoon"
+	"github.com/bytedance/sonic"
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/openai/openai-go"
+	"github.com/robalyx/rotector/internal/ai/client"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/translator"
+	"github.com/robalyx/rotector/pkg/utils"
+	"github.com/sourcegraph/conc/pool"
+	"go.uber.org/zap"
+	"golang.org/x/sync/semaphore"
+)
+
+const (
+	// GroupAnalysisBatchSize is the number of groups to analyze in one AI request.
+	GroupAnalysisBatchSize = 20
+	// MaxGroupEvidenceLength is the maximum length of group text kept as evidence.
+	MaxGroupEvidenceLength = 300
+)
+
+// GroupContentSummary is a struct for group content summaries for AI analysis.
+type GroupContentSummary struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Shout       string `json:"shout,omitempty"`
+}
+
+// FlaggedGroups holds a list of groups that the AI has identified as inappropriate.
+type FlaggedGroups struct {
+	Groups []FlaggedGroup `json:"groups" jsonschema:"description=List of groups that have been flagged for inappropriate content"`
+}
+
+// FlaggedGroup contains the AI's analysis results for a single group.
+type FlaggedGroup struct {
+	Key                     string  `json:"key"                     jsonschema:"required,minLength=1,description=Exact key of the flagged group"`
+	Hint                    string  `json:"hint"                    jsonschema:"required,minLength=1,description=Brief clinical description using safe terminology"`
+	Confidence              float64 `json:"confidence"              jsonschema:"required,minimum=0,maximum=1,description=Overall confidence score for the violations"`
+	HasNameViolation        bool    `json:"hasNameViolation"        jsonschema:"required,description=Group name contains violations"`
+	HasDescriptionViolation bool    `json:"hasDescriptionViolation" jsonschema:"required,description=Group description contains violations"`
+	HasShoutViolation       bool    `json:"hasShoutViolation"       jsonschema:"required,description=Group shout contains violations"`
+}
+
+// GroupContentAnalysisSchema is the JSON schema for the group content analysis response.
+var GroupContentAnalysisSchema = utils.GenerateSchema[FlaggedGroups]()
+
+// GroupAnalyzer handles AI-based analysis of group names, descriptions and shouts.
+type GroupAnalyzer struct {
+	chat          client.ChatCompletions
+	translator    *translator.Translator
+	analysisSem   *semaphore.Weighted
+	logger        *zap.Logger
+	textLogger    *zap.Logger
+	model         string
+	fallbackModel string
+}
+
+// NewGroupAnalyzer creates a GroupAnalyzer.
+func NewGroupAnalyzer(app *setup.App, translator *translator.Translator, logger *zap.Logger) *GroupAnalyzer {
+	// Get text logger
+	textLogger, _, err := app.LogManager.GetTextLogger("group_analyzer")
+	if err != nil {
+		logger.Error("Failed to create text logger", zap.Error(err))
+		textLogger = logger
+	}
+
+	return &GroupAnalyzer{
+		chat:          app.AIClient.Chat(),
+		translator:    translator,
+		analysisSem:   semaphore.NewWeighted(int64(app.Config.Worker.BatchSizes.UserAnalysis)),
+		logger:        logger.Named("ai_group"),
+		textLogger:    textLogger,
+		model:         app.Config.Common.OpenAI.UserModel,
+		fallbackModel: app.Config.Common.OpenAI.UserFallbackModel,
+	}
+}
+
+// ProcessGroups analyzes the name, description and shout of each group.
+// Returns the purpose, description and shout reasons of groups with violations,
+// and the IDs of all groups whose batch was analyzed successfully.
+func (a *GroupAnalyzer) ProcessGroups(
+	ctx context.Context, groups []*apiTypes.GroupResponse,
+) (map[int64]types.Reasons[enum.GroupReasonType], map[int64]struct{}) {
+	results := make(map[int64]types.Reasons[enum.GroupReasonType])
+	analyzed := make(map[int64]struct{})
+
+	if len(groups) == 0 {
+		return results, analyzed
+	}
+
+	// Build translated summaries with short keys that map back to groups
+	refs := make(map[string]*apiTypes.GroupResponse, len(groups))
+	summaries := a.prepareSummaries(ctx, groups, refs)
+
+	var (
+		p  = pool.New().WithContext(ctx)
+		mu sync.Mutex
+	)
+
+	for i := 0; i < len(summaries); i += GroupAnalysisBatchSize {
+		batch := summaries[i:min(i+GroupAnalysisBatchSize, len(summaries))]
+
+		p.Go(func(ctx context.Context) error {
+			// Acquire semaphore
+			if err := a.analysisSem.Acquire(ctx, 1); err != nil {
+				return fmt.Errorf("failed to acquire semaphore: %w", err)
+			}
+			defer a.analysisSem.Release(1)
+
+			result, err := a.processBatch(ctx, batch)
+			if err != nil {
+				a.logger.Warn("Failed to analyze group batch",
+					zap.Error(err),
+					zap.Int("batchSize", len(batch)))
+
+				return nil
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			for _, summary := range batch {
+				if group, ok := refs[summary.Key]; ok {
+					analyzed[group.ID] = struct{}{}
+				}
+			}
+
+			for _, flagged := range result.Groups {
+				group, ok := refs[flagged.Key]
+				if !ok {
+					a.logger.Info("AI flagged non-existent group", zap.String("key", flagged.Key))
+					continue
+				}
+
+				if reasons := a.createReasons(flagged, group); len(reasons) > 0 {
+					results[group.ID] = reasons
+				}
+			}
+
+			return nil
+		})
+	}
+
+	if err := p.Wait(); err != nil {
+		a.logger.Error("Error during group analysis", zap.Error(err))
+	}
+
+	a.logger.Info("Finished processing groups",
+		zap.Int("totalGroups", len(groups)),
+		zap.Int("analyzedGroups", len(analyzed)),
+		zap.Int("flaggedGroups", len(results)))
+
+	return results, analyzed
+}
+
+// prepareSummaries translates group text and builds the summaries sent to the AI.
+func (a *GroupAnalyzer) prepareSummaries(
+	ctx context.Context, groups []*apiTypes.GroupResponse, refs map[string]*apiTypes.GroupResponse,
+) []GroupContentSummary {
+	var (
+		summaries = make([]GroupContentSummary, len(groups))
+		p         = pool.New().WithContext(ctx).WithMaxGoroutines(50)
+	)
+
+	for i, group := range groups {
+		key := "g" + strconv.Itoa(i+1)
+		refs[key] = group
+
+		p.Go(func(ctx context.Context) error {
+			var shout string
+			if group.Shout != nil {
+				shout = group.Shout.Body
+			}
+
+			summaries[i] = GroupContentSummary{
+				Key:         key,
+				Name:        a.translate(ctx, group.Name),
+				Description: a.translate(ctx, group.Description),
+				Shout:       a.translate(ctx, shout),
+			}
+
+			if summaries[i].Description == "" {
+				summaries[i].Description = "No description"
+			}
+
+			return nil
+		})
+	}
+
+	_ = p.Wait()
+
+	return summaries
+}
+
+// translate decodes and translates text, falling back to the original on failure.
+func (a *GroupAnalyzer) translate(ctx context.Context, text string) string {
+	if text == "" {
+		return ""
+	}
+
+	var translated string
+
+	err := utils.WithRetry(ctx, func() error {
+		var err error
+
+		translated, err = a.translator.Translate(ctx, text, "auto", "en")
+
+		return err
+	}, utils.GetAIRetryOptions())
+	if err != nil {
+		a.logger.Debug("Translation failed, using original text", zap.Error(err))
+		return text
+	}
+
+	return translated
+}
+
+// processBatch handles the AI analysis for a batch of group summaries.
+func (a *GroupAnalyzer) processBatch(ctx context.Context, summaries []GroupContentSummary) (*FlaggedGroups, error) {
+	result := &FlaggedGroups{}
+	minBatchSize := max(len(summaries)/4, 1)
+
+	err := utils.WithRetrySplitBatch(
+		ctx, summaries, len(summaries), minBatchSize, utils.GetAIRetryOptions(),
+		func(batch []GroupContentSummary) error {
+			flagged, err := a.processGroupBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+
+			result.Groups = append(result.Groups, flagged.Groups...)
+
+			return nil
+		},
+		func(batch []GroupContentSummary) {
+			// Log detailed content to text logger
+			a.textLogger.Warn("Content blocked in group analysis batch",
+				zap.Int("batch_size", len(batch)),
+				zap.Any("groups", batch))
+		},
+	)
+
+	return result, err
+}
+
+// processGroupBatch sends a single batch of group summaries to the AI.
+func (a *GroupAnalyzer) processGroupBatch(ctx context.Context, batch []GroupContentSummary) (*FlaggedGroups, error) {
+	// Convert to TOON format
+	toonData, err := gotoon.Encode(batch)
+	if err != nil {
+		return nil, fmt.Errorf("TOON marshal error: %w", err)
+	}
+
+	// Prepare chat completion parameters
+	params := openai.ChatCompletionNewParams{
+		Messages: []openai.ChatCompletionMessageParamUnion{
+			openai.SystemMessage(GroupContentSystemPrompt),
+			openai.UserMessage(GroupContentRequestPrompt + toonData),
+		},
+		ResponseFormat: openai.ChatCompletionNewParamsResponseFormatUnion{
+			OfJSONSchema: &openai.ResponseFormatJSONSchemaParam{
+				JSONSchema: openai.ResponseFormatJSONSchemaJSONSchemaParam{
+					Name:        "groupAnalysis",
+					Description: openai.String("Analysis of group content"),
+					Schema:      GroupContentAnalysisSchema,
+					Strict:      openai.Bool(true),
+				},
+			},
+		},
+		Model:               a.model,
+		Temperature:         openai.Float(0.0),
+		TopP:                openai.Float(0.2),
+		MaxCompletionTokens: openai.Int(8192),
+	}
+
+	// Make API request
+	var result FlaggedGroups
+
+	err = a.chat.NewWithRetryAndFallback(ctx, params, a.fallbackModel, func(resp *openai.ChatCompletion, err error) error {
+		// Handle API error
+		if err != nil {
+			return fmt.Errorf("openai API error: %w", err)
+		}
+
+		// Check for empty response
+		if len(resp.Choices) == 0 || len(resp.Choices[0].Message.Content) == 0 {
+			return fmt.Errorf("%w: no response from model", utils.ErrModelResponse)
+		}
+
+		// Parse response from AI
+		if err := sonic.Unmarshal([]byte(resp.Choices[0].Message.Content), &result); err != nil {
+			return fmt.Errorf("JSON unmarshal error: %w", err)
+		}
+
+		return nil
+	})
+
+	return &result, err
+}
+
+// createReasons converts an AI result into group reasons with the offending text as evidence.
+func (a *GroupAnalyzer) createReasons(
+	flagged FlaggedGroup, group *apiTypes.GroupResponse,
+) types.Reasons[enum.GroupReasonType] {
+	reasons := make(types.Reasons[enum.GroupReasonType])
+
+	if flagged.Confidence < 0.1 || flagged.Confidence > 1.0 {
+		return reasons
+	}
+
+	if flagged.HasNameViolation {
+		reasons.Add(enum.GroupReasonTypePurpose, &types.Reason{
+			Message:    "Group name indicates an inappropriate purpose: " + flagged.Hint,
+			Confidence: flagged.Confidence,
+			Evidence:   []string{truncateEvidence(group.Name)},
+		})
+	}
+
+	if flagged.HasDescriptionViolation && group.Description != "" {
+		reasons.Add(enum.GroupReasonTypeDescription, &types.Reason{
+			Message:    "Group description contains inappropriate content: " + flagged.Hint,
+			Confidence: flagged.Confidence,
+			Evidence:   []string{truncateEvidence(group.Description)},
+		})
+	}
+
+	if flagged.HasShoutViolation && group.Shout != nil && group.Shout.Body != "" {
+		reasons.Add(enum.GroupReasonTypeShout, &types.Reason{
+			Message:    "Group shout contains inappropriate content: " + flagged.Hint,
+			Confidence: flagged.Confidence,
+			Evidence:   []string{truncateEvidence(group.Shout.Body)},
+		})
+	}
+
+	return reasons
+}
+
+// truncateEvidence shortens long text so it can be stored as evidence.
+func truncateEvidence(text string) string {
+	runes := []rune(text)
+	if len(runes) <= MaxGroupEvidenceLength {
+		return text
+	}
+
+	return string(runes[:MaxGroupEvidenceLength]) + "..."
+}
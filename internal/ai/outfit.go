@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/HugoSmits86/nativewebp"
 	"github.com/bytedance/sonic"
@@ -25,15 +26,19 @@ import (
 	"github.com/robalyx/rotector/internal/database/types/enum"
 	"github.com/robalyx/rotector/internal/roblox/fetcher"
 	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/setup/telemetry/tracing"
 	"github.com/robalyx/rotector/pkg/utils"
 	"github.com/sourcegraph/conc/pool"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
-	"golang.org/x/sync/semaphore"
 )
 
 const (
 	InitialOutfitLimit = 20
 	MaxOutfits         = 100
+
+	// OutfitAnalysisMaxTokens is the maximum number of completion tokens for outfit analysis.
+	OutfitAnalysisMaxTokens = 8192
 )
 
 var (
@@ -71,13 +76,12 @@ type OutfitAnalyzer struct {
 	chat                 client.ChatCompletions
 	thumbnailFetcher     *fetcher.ThumbnailFetcher
 	outfitReasonAnalyzer *OutfitReasonAnalyzer
-	analysisSem          *semaphore.Weighted
+	controller           *utils.AIMDController
 	logger               *zap.Logger
 	imageLogger          *zap.Logger
 	imageDir             string
 	model                string
 	fallbackModel        string
-	batchSize            int
 	similarityThreshold  int
 }
 
@@ -125,18 +129,25 @@ func NewOutfitAnalyzer(app *setup.App, logger *zap.Logger) *OutfitAnalyzer {
 		imageLogger = logger
 	}
 
+	// Create adaptive batch controller
+	controller := newAdaptiveController(
+		&app.Config.Worker.AdaptiveBatching,
+		app.Config.Worker.BatchSizes.OutfitAnalysisBatch,
+		app.Config.Worker.BatchSizes.OutfitAnalysis,
+		OutfitAnalysisMaxTokens,
+	)
+
 	return &OutfitAnalyzer{
 		httpClient:           app.RoAPI.GetClient(),
 		chat:                 app.AIClient.Chat(),
 		thumbnailFetcher:     fetcher.NewThumbnailFetcher(app.RoAPI, logger),
 		outfitReasonAnalyzer: NewOutfitReasonAnalyzer(app, logger),
-		analysisSem:          semaphore.NewWeighted(int64(app.Config.Worker.BatchSizes.OutfitAnalysis)),
+		controller:           controller,
 		logger:               logger.Named("ai_outfit"),
 		imageLogger:          imageLogger,
 		imageDir:             imageDir,
 		model:                app.Config.Common.OpenAI.OutfitModel,
 		fallbackModel:        app.Config.Common.OpenAI.OutfitFallbackModel,
-		batchSize:            app.Config.Worker.BatchSizes.OutfitAnalysisBatch,
 		similarityThreshold:  app.Config.Worker.ThresholdLimits.ImageSimilarityThreshold,
 	}
 }
@@ -146,8 +157,12 @@ func NewOutfitAnalyzer(app *setup.App, logger *zap.Logger) *OutfitAnalyzer {
 func (a *OutfitAnalyzer) ProcessUsers(
 	ctx context.Context, params *OutfitAnalyzerParams,
 ) (map[int64]map[string]struct{}, map[int64]struct{}) {
+	ctx, span := tracing.Start(ctx, "OutfitAnalyzer.ProcessUsers", tracing.Model(a.model))
+	defer span.End()
+
 	// Filter users based on inappropriate outfit flags and existing reasons
 	usersToProcess := a.filterUsersForOutfitProcessing(params.Users, params.ReasonsMap, params.InappropriateOutfitFlags)
+	span.SetAttributes(tracing.BatchSize(len(usersToProcess)))
 
 	if len(usersToProcess) == 0 {
 		a.logger.Info("No users to process outfits for")
@@ -210,9 +225,13 @@ func (a *OutfitAnalyzer) ProcessUsers(
 	// Wait for all goroutines to complete
 	if err := p.Wait(); err != nil {
 		a.logger.Error("Error during outfit theme analysis", zap.Error(err))
+		tracing.RecordError(span, err)
+
 		return flaggedOutfits, furryUsers
 	}
 
+	span.SetAttributes(tracing.FlaggedCount(len(flaggedOutfits)))
+
 	a.logger.Info("Received AI outfit theme analysis",
 		zap.Int("processedUsers", len(usersToProcess)),
 		zap.Int("flaggedUsers", len(flaggedOutfits)),
@@ -252,7 +271,11 @@ func (a *OutfitAnalyzer) filterUsersForOutfitProcessing(
 func (a *OutfitAnalyzer) analyzeUserOutfits(
 	ctx context.Context, info *types.ReviewUser, mu *sync.Mutex, reasonsMap map[int64]types.Reasons[enum.UserReasonType],
 	outfits []*apiTypes.Outfit, thumbnailMap map[int64]string, inappropriateOutfitFlags map[int64]struct{},
-) (map[string]struct{}, bool, error) {
+) (flagged map[string]struct{}, isFurry bool, err error) {
+	ctx, span := tracing.Start(ctx, "OutfitAnalyzer.analyzeUserOutfits",
+		tracing.UserID(info.ID), attribute.Int("rotector.outfit_count", len(outfits)))
+	defer func() { tracing.End(span, err) }()
+
 	// Phase 1: Analyze initial outfits
 	result, err := a.analyzeOutfitRange(ctx, info, outfits, thumbnailMap, 0, InitialOutfitLimit)
 	if err != nil {
@@ -365,6 +388,52 @@ func (a *OutfitAnalyzer) analyzeOutfitRange(
 	return result, nil
 }
 
+// analyzeImages runs named images through the outfit theme analysis.
+// This allows other content such as uploaded clothing to reuse the vision path.
+func (a *OutfitAnalyzer) analyzeImages(
+	ctx context.Context, info *types.ReviewUser, images map[string]string,
+) (*OutfitAnalysisResult, error) {
+	var (
+		p         = pool.New().WithContext(ctx)
+		mu        sync.Mutex
+		downloads []DownloadResult
+	)
+
+	for name, url := range images {
+		p.Go(func(ctx context.Context) error {
+			img, hash, ok := a.downloadImage(ctx, url)
+			if !ok {
+				return nil
+			}
+
+			mu.Lock()
+
+			downloads = append(downloads, DownloadResult{
+				img:  img,
+				hash: hash,
+				name: name,
+			})
+
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	// Wait for all downloads to complete
+	if err := p.Wait(); err != nil {
+		a.logger.Error("Error during image downloads", zap.Error(err))
+	}
+
+	// Deduplicate similar images
+	downloads = a.deduplicateImages(downloads)
+	if len(downloads) == 0 {
+		return nil, ErrNoOutfits
+	}
+
+	return a.processOutfitDownloads(ctx, info, downloads), nil
+}
+
 // processOutfitDownloads processes a set of downloaded outfits and returns analysis results.
 func (a *OutfitAnalyzer) processOutfitDownloads(
 	ctx context.Context, info *types.ReviewUser, downloads []DownloadResult,
@@ -373,8 +442,10 @@ func (a *OutfitAnalyzer) processOutfitDownloads(
 		flaggedOutfits: make(map[string]struct{}),
 	}
 
-	for i := 0; i < len(downloads); i += a.batchSize {
-		end := min(i+a.batchSize, len(downloads))
+	batchSize := a.controller.BatchSize()
+
+	for i := 0; i < len(downloads); i += batchSize {
+		end := min(i+batchSize, len(downloads))
 		batch := downloads[i:end]
 
 		// Analyze the current batch
@@ -387,7 +458,7 @@ func (a *OutfitAnalyzer) processOutfitDownloads(
 			a.logger.Warn("Failed to analyze outfit batch",
 				zap.Error(err),
 				zap.Int("batchIndex", i),
-				zap.Int("batchSize", a.batchSize))
+				zap.Int("batchSize", batchSize))
 
 			continue
 		}
@@ -524,11 +595,15 @@ func (a *OutfitAnalyzer) processOutfitBatch(
 		Model:               a.model,
 		Temperature:         openai.Float(0.0),
 		TopP:                openai.Float(0.1),
-		MaxCompletionTokens: openai.Int(8192),
+		MaxCompletionTokens: openai.Int(OutfitAnalysisMaxTokens),
 	}
 
 	// Make API request
-	var analysis OutfitThemeAnalysis
+	var (
+		analysis         OutfitThemeAnalysis
+		completionTokens int64
+		start            = time.Now()
+	)
 
 	err := a.chat.NewWithRetryAndFallback(ctx, params, a.fallbackModel, func(resp *openai.ChatCompletion, err error) error {
 		// Handle API error
@@ -536,6 +611,8 @@ func (a *OutfitAnalyzer) processOutfitBatch(
 			return fmt.Errorf("openai API error: %w", err)
 		}
 
+		completionTokens = resp.Usage.CompletionTokens
+
 		// Check for empty response
 		if len(resp.Choices) == 0 || len(resp.Choices[0].Message.Content) == 0 {
 			return fmt.Errorf("%w: no response from model", utils.ErrModelResponse)
@@ -573,18 +650,25 @@ func (a *OutfitAnalyzer) processOutfitBatch(
 		return nil
 	})
 
+	observeAdaptive(a.controller, start, completionTokens, err)
+
 	return &analysis, err
 }
 
+// AdaptiveState returns the current batch size and concurrency of the analyzer.
+func (a *OutfitAnalyzer) AdaptiveState() string {
+	return formatAdaptiveState(a.controller)
+}
+
 // analyzeOutfitBatch processes a single batch of outfit images.
 func (a *OutfitAnalyzer) analyzeOutfitBatch(
 	ctx context.Context, info *types.ReviewUser, downloads []DownloadResult,
 ) (*OutfitThemeAnalysis, error) {
-	// Acquire semaphore
-	if err := a.analysisSem.Acquire(ctx, 1); err != nil {
-		return nil, fmt.Errorf("failed to acquire semaphore: %w", err)
+	// Acquire request slot
+	if err := a.controller.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("failed to acquire request slot: %w", err)
 	}
-	defer a.analysisSem.Release(1)
+	defer a.controller.Release()
 
 	// Handle content blocking
 	minBatchSize := max(len(downloads)/4, 1)
@@ -17,16 +17,19 @@ import (
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
 	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/setup/telemetry/tracing"
 	"github.com/robalyx/rotector/internal/translator"
 	"github.com/robalyx/rotector/pkg/utils"
 	"github.com/sourcegraph/conc/pool"
 	"go.uber.org/zap"
-	"golang.org/x/sync/semaphore"
 )
 
 const (
 	// UserAnalysisMaxRetries is the maximum number of retry attempts for user analysis.
 	UserAnalysisMaxRetries = 2
+
+	// UserAnalysisMaxTokens is the maximum number of completion tokens for user analysis.
+	UserAnalysisMaxTokens = 16384
 )
 
 // ProcessUsersParams contains all the parameters needed for user analysis processing.
@@ -75,13 +78,12 @@ type FlaggedUser struct {
 type UserAnalyzer struct {
 	chat          client.ChatCompletions
 	translator    *translator.Translator
-	analysisSem   *semaphore.Weighted
+	controller    *utils.AIMDController
 	logger        *zap.Logger
 	textLogger    *zap.Logger
 	textDir       string
 	model         string
 	fallbackModel string
-	batchSize     int
 }
 
 // UserAnalysisSchema is the JSON schema for the user analysis response.
@@ -96,24 +98,37 @@ func NewUserAnalyzer(app *setup.App, translator *translator.Translator, logger *
 		textLogger = logger
 	}
 
+	// Create adaptive batch controller
+	controller := newAdaptiveController(
+		&app.Config.Worker.AdaptiveBatching,
+		app.Config.Worker.BatchSizes.UserAnalysisBatch,
+		app.Config.Worker.BatchSizes.UserAnalysis,
+		UserAnalysisMaxTokens,
+	)
+
 	return &UserAnalyzer{
 		chat:          app.AIClient.Chat(),
 		translator:    translator,
-		analysisSem:   semaphore.NewWeighted(int64(app.Config.Worker.BatchSizes.UserAnalysis)),
+		controller:    controller,
 		logger:        logger.Named("ai_user"),
 		textLogger:    textLogger,
 		textDir:       textDir,
 		model:         app.Config.Common.OpenAI.UserModel,
 		fallbackModel: app.Config.Common.OpenAI.UserFallbackModel,
-		batchSize:     app.Config.Worker.BatchSizes.UserAnalysisBatch,
 	}
 }
 
 // ProcessUsers analyzes user content for a batch of users.
 func (a *UserAnalyzer) ProcessUsers(ctx context.Context, params *ProcessUsersParams) map[int64]UserReasonRequest {
+	ctx, span := tracing.Start(ctx, "UserAnalyzer.ProcessUsers",
+		tracing.Model(a.model), tracing.BatchSize(len(params.Users)))
+	defer span.End()
+
 	userReasonRequests := make(map[int64]UserReasonRequest)
 	a.processUsersWithRetry(ctx, params.Users, params, userReasonRequests, 0)
 
+	span.SetAttributes(tracing.FlaggedCount(len(userReasonRequests)))
+
 	return userReasonRequests
 }
 
@@ -136,7 +151,8 @@ func (a *UserAnalyzer) processUsersWithRetry(
 		return
 	}
 
-	numBatches := (len(users) + a.batchSize - 1) / a.batchSize
+	batchSize := a.controller.BatchSize()
+	numBatches := (len(users) + batchSize - 1) / batchSize
 
 	// Process batches concurrently
 	var (
@@ -147,17 +163,17 @@ func (a *UserAnalyzer) processUsersWithRetry(
 	)
 
 	for i := range numBatches {
-		start := i * a.batchSize
-		end := min(start+a.batchSize, len(users))
+		start := i * batchSize
+		end := min(start+batchSize, len(users))
 
 		infoBatch := users[start:end]
 
 		p.Go(func(ctx context.Context) error {
-			// Acquire semaphore
-			if err := a.analysisSem.Acquire(ctx, 1); err != nil {
-				return fmt.Errorf("failed to acquire semaphore: %w", err)
+			// Acquire request slot
+			if err := a.controller.Acquire(ctx); err != nil {
+				return fmt.Errorf("failed to acquire request slot: %w", err)
 			}
-			defer a.analysisSem.Release(1)
+			defer a.controller.Release()
 
 			// Process batch
 			if err := a.processBatch(
@@ -240,12 +256,16 @@ func (a *UserAnalyzer) processUserBatch(ctx context.Context, batch []UserSummary
 		Model:               a.model,
 		Temperature:         openai.Float(0.0),
 		TopP:                openai.Float(0.2),
-		MaxCompletionTokens: openai.Int(16384),
+		MaxCompletionTokens: openai.Int(UserAnalysisMaxTokens),
 		ReasoningEffort:     shared.ReasoningEffortMedium,
 	}
 
 	// Make API request
-	var result FlaggedUsers
+	var (
+		result           FlaggedUsers
+		completionTokens int64
+		start            = time.Now()
+	)
 
 	err = a.chat.NewWithRetryAndFallback(ctx, params, a.fallbackModel, func(resp *openai.ChatCompletion, err error) error {
 		// Handle API error
@@ -253,6 +273,8 @@ func (a *UserAnalyzer) processUserBatch(ctx context.Context, batch []UserSummary
 			return fmt.Errorf("openai API error: %w", err)
 		}
 
+		completionTokens = resp.Usage.CompletionTokens
+
 		// Check for empty response
 		if len(resp.Choices) == 0 || len(resp.Choices[0].Message.Content) == 0 {
 			return fmt.Errorf("%w: no response from model", utils.ErrModelResponse)
@@ -274,14 +296,65 @@ func (a *UserAnalyzer) processUserBatch(ctx context.Context, batch []UserSummary
 		return nil
 	})
 
+	observeAdaptive(a.controller, start, completionTokens, err)
+
 	return &result, err
 }
 
+// AnalyzeSummaries runs prepared summaries through the profile text analysis. Summary
+// names act as keys, so flagged results are returned under the name they were sent with.
+func (a *UserAnalyzer) AnalyzeSummaries(ctx context.Context, summaries []UserSummary) (*FlaggedUsers, error) {
+	// Acquire request slot
+	if err := a.controller.Acquire(ctx); err != nil {
+		return nil, fmt.Errorf("failed to acquire request slot: %w", err)
+	}
+	defer a.controller.Release()
+
+	result := &FlaggedUsers{}
+	minBatchSize := max(len(summaries)/4, 1)
+
+	err := utils.WithRetrySplitBatch(
+		ctx, summaries, len(summaries), minBatchSize, utils.GetAIRetryOptions(),
+		func(batch []UserSummary) error {
+			flagged, err := a.processUserBatch(ctx, batch)
+			if err != nil {
+				return err
+			}
+
+			result.Users = append(result.Users, flagged.Users...)
+
+			return nil
+		},
+		func(batch []UserSummary) {
+			// Log detailed content to text logger
+			a.textLogger.Warn("Content blocked in summary analysis batch",
+				zap.Int("batch_size", len(batch)),
+				zap.Any("summaries", batch))
+		},
+	)
+
+	return result, err
+}
+
+// AdaptiveState returns the current batch size and concurrency of the analyzer.
+func (a *UserAnalyzer) AdaptiveState() string {
+	return formatAdaptiveState(a.controller)
+}
+
 // processBatch handles the AI analysis for a batch of user summaries.
 func (a *UserAnalyzer) processBatch(
 	ctx context.Context, userInfos []*types.ReviewUser, params *ProcessUsersParams,
 	userReasonRequests map[int64]UserReasonRequest, mu *sync.Mutex,
-) error {
+) (err error) {
+	userIDs := make([]int64, 0, len(userInfos))
+	for _, userInfo := range userInfos {
+		userIDs = append(userIDs, userInfo.ID)
+	}
+
+	ctx, span := tracing.Start(ctx, "UserAnalyzer.processBatch",
+		tracing.Model(a.model), tracing.BatchSize(len(userInfos)), tracing.UserIDs(userIDs))
+	defer func() { tracing.End(span, err) }()
+
 	// Convert map to slice for AI request
 	userInfosWithoutID := make([]UserSummary, 0, len(userInfos))
 	for _, userInfo := range userInfos {
@@ -302,7 +375,7 @@ func (a *UserAnalyzer) processBatch(
 
 	var result *FlaggedUsers
 
-	err := utils.WithRetrySplitBatch(
+	err = utils.WithRetrySplitBatch(
 		ctx, userInfosWithoutID, len(userInfosWithoutID), minBatchSize, utils.GetAIRetryOptions(),
 		func(batch []UserSummary) error {
 			var err error
@@ -16,6 +16,7 @@ import (
 	disgoEvents "github.com/disgoorg/disgo/events"
 	"github.com/disgoorg/disgo/gateway"
 	"github.com/disgoorg/disgo/sharding"
+	"github.com/robalyx/rotector/internal/bot/commands"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/interaction"
 	"github.com/robalyx/rotector/internal/bot/core/session"
@@ -52,6 +53,7 @@ type Bot struct {
 	interactionManager  *interaction.Manager
 	guildEventHandler   *eventHandler.GuildEventHandler
 	verificationManager *verification.ServiceManager
+	commandHandler      *commands.Handler
 }
 
 // New initializes a Bot instance by creating all required managers and layouts.
@@ -106,6 +108,7 @@ func New(app *setup.App) (*Bot, error) {
 		interactionManager:  interactionManager,
 		guildEventHandler:   guildEventHandler,
 		verificationManager: verificationManager,
+		commandHandler:      commands.New(app),
 	}
 
 	// Create shard manager options
@@ -146,6 +149,7 @@ func New(app *setup.App) (*Bot, error) {
 		bot.WithEventListeners(&disgoEvents.ListenerAdapter{
 			OnApplicationCommandInteraction: b.handleApplicationCommandInteraction,
 			OnComponentInteraction:          b.handleComponentInteraction,
+			OnAutocompleteInteraction:       b.handleAutocompleteInteraction,
 			OnModalSubmit:                   b.handleModalSubmit,
 			OnGuildJoin:                     b.guildEventHandler.OnGuildJoin,
 		}),
@@ -194,13 +198,8 @@ func New(app *setup.App) (*Bot, error) {
 func (b *Bot) Start() error {
 	b.logger.Info("Registering commands")
 
-	// Register the dashboard command globally
-	_, err := b.client.Rest.SetGlobalCommands(b.client.ApplicationID, []discord.ApplicationCommandCreate{
-		discord.SlashCommandCreate{
-			Name:        constants.RotectorCommandName,
-			Description: "Open the moderation interface",
-		},
-	})
+	// Register the dashboard and quick action commands globally
+	_, err := b.client.Rest.SetGlobalCommands(b.client.ApplicationID, commands.Definitions())
 	if err != nil {
 		return fmt.Errorf("failed to register commands: %w", err)
 	}
@@ -242,7 +241,7 @@ func (b *Bot) handleApplicationCommandInteraction(event *disgoEvents.Application
 		defer func() {
 			if r := recover(); r != nil {
 				b.logger.Error("Application command interaction failed",
-					zap.String("command", event.SlashCommandInteractionData().CommandName()),
+					zap.String("command", event.Data.CommandName()),
 					zap.String("userID", wrappedEvent.User().ID.String()),
 					zap.Any("panic", r),
 				)
@@ -251,7 +250,7 @@ func (b *Bot) handleApplicationCommandInteraction(event *disgoEvents.Application
 
 			duration := time.Since(start)
 			b.logger.Debug("Application command interaction handled",
-				zap.String("command", event.SlashCommandInteractionData().CommandName()),
+				zap.String("command", event.Data.CommandName()),
 				zap.Duration("duration", duration))
 		}()
 
@@ -261,8 +260,14 @@ func (b *Bot) handleApplicationCommandInteraction(event *disgoEvents.Application
 			return
 		}
 
+		// Quick action commands respond without a session
+		if b.commandHandler.Handles(event.Data.CommandName()) {
+			b.commandHandler.HandleCommand(event)
+			return
+		}
+
 		// Only handle dashboard command
-		if event.SlashCommandInteractionData().CommandName() != constants.RotectorCommandName {
+		if event.Data.CommandName() != constants.RotectorCommandName {
 			b.interactionManager.RespondWithError(wrappedEvent, "This command is not available.")
 			return
 		}
@@ -304,6 +309,23 @@ func (b *Bot) handleApplicationCommandInteraction(event *disgoEvents.Application
 	}()
 }
 
+// handleAutocompleteInteraction suggests values for quick action command options.
+func (b *Bot) handleAutocompleteInteraction(event *disgoEvents.AutocompleteInteractionCreate) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				b.logger.Error("Autocomplete interaction failed",
+					zap.String("command", event.Data.CommandName),
+					zap.String("userID", event.User().ID.String()),
+					zap.Any("panic", r),
+				)
+			}
+		}()
+
+		b.commandHandler.HandleAutocomplete(event)
+	}()
+}
+
 // handleComponentInteraction processes button clicks and select menu choices.
 func (b *Bot) handleComponentInteraction(event *disgoEvents.ComponentInteractionCreate) {
 	go func() {
@@ -327,6 +349,18 @@ func (b *Bot) handleComponentInteraction(event *disgoEvents.ComponentInteraction
 				zap.Duration("duration", duration))
 		}()
 
+		// Quick action responses have no session
+		if b.commandHandler.HandlesComponent(event.Data.CustomID()) {
+			if err := event.DeferUpdateMessage(); err != nil {
+				b.logger.Error("Failed to defer update message", zap.Error(err))
+				return
+			}
+
+			b.commandHandler.HandleComponent(event)
+
+			return
+		}
+
 		// Initialize session
 		s, isNewSession, showSelector, err := b.initializeSession(wrappedEvent, &event.Message, true)
 		if err != nil {
@@ -0,0 +1,174 @@
+package commands
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	view "github.com/robalyx/rotector/internal/bot/views/command"
+	"github.com/robalyx/rotector/internal/cloudflare/manager"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// handleCheck shows the status of every Roblox user and group linked in a message.
+func (h *Handler) handleCheck(
+	ctx context.Context, event *events.ApplicationCommandInteractionCreate, botSettings *types.BotSetting,
+) *discord.MessageUpdateBuilder {
+	guildID := event.GuildID()
+	if guildID == nil {
+		return textMessage("This command can only be used in a server.")
+	}
+
+	isReviewer := h.isAllowed(botSettings, uint64(event.User().ID), permissionReviewer)
+	if !isReviewer && !h.allowGuild(h.checkCounters, uint64(*guildID), checkGuildLimit, 1) {
+		return textMessage("This server has checked too many messages recently. Please try again later.")
+	}
+
+	userIDs, groupIDs := extractTargets(event.MessageCommandInteractionData().TargetMessage())
+	if len(userIDs) == 0 && len(groupIDs) == 0 {
+		return textMessage("No Roblox profile or group links were found in this message.")
+	}
+
+	users, err := h.db.Service().User().GetUsersByIDs(ctx, userIDs,
+		types.UserFieldBasic|types.UserFieldReasons|types.UserFieldConfidence|types.UserFieldCategory)
+	if err != nil {
+		h.logger.Error("Failed to get users for check", zap.Error(err))
+		return textMessage("Failed to check users. Please try again.")
+	}
+
+	groups := make(map[int64]*types.ReviewGroup)
+	if len(groupIDs) > 0 {
+		groups, err = h.db.Model().Group().GetGroupsByIDs(ctx, groupIDs,
+			types.GroupFieldBasic|types.GroupFieldReasons|types.GroupFieldConfidence)
+		if err != nil {
+			h.logger.Error("Failed to get groups for check", zap.Error(err))
+			return textMessage("Failed to check groups. Please try again.")
+		}
+	}
+
+	return view.NewCheckBuilder(
+		userIDs, groupIDs, users, groups, isReviewer, h.privacyMode(ctx, uint64(event.User().ID)),
+	).Build()
+}
+
+// handleCheckQueue queues the unknown users offered by a message check.
+func (h *Handler) handleCheckQueue(
+	ctx context.Context, event *events.ComponentInteractionCreate, botSettings *types.BotSetting,
+) *discord.MessageUpdateBuilder {
+	guildID := event.GuildID()
+	if guildID == nil {
+		return textMessage("This button can only be used in a server.")
+	}
+
+	userIDs := parseCheckQueueCustomID(event.Data.CustomID())
+	if len(userIDs) == 0 {
+		return textMessage("No users to queue.")
+	}
+
+	// Partner servers share the lane of external reports
+	priority := manager.QueuePriorityExtension
+
+	if h.isAllowed(botSettings, uint64(event.User().ID), permissionReviewer) {
+		priority = manager.QueuePriorityReviewer
+	} else if !h.allowGuild(h.checkQueueCounters, uint64(*guildID), checkQueueGuildLimit, len(userIDs)) {
+		return textMessage("This server has queued too many users recently. Please try again later.")
+	}
+
+	results := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		result := h.queueUser(ctx, userID, uint64(event.User().ID), priority)
+		results = append(results, "`"+strconv.FormatInt(userID, 10)+"`: "+result)
+	}
+
+	return textMessage(strings.Join(results, "\n"))
+}
+
+// allowGuild checks if a guild is within a rate limit and counts the new uses.
+func (h *Handler) allowGuild(counters *utils.TTLMap[uint64, int], guildID uint64, limit, uses int) bool {
+	count, exists := counters.Get(guildID)
+	if !exists {
+		count = 0
+	}
+
+	// Check if we're under the limit
+	if count+uses > limit {
+		return false
+	}
+
+	// Increment the counter
+	counters.Set(guildID, count+uses)
+
+	return true
+}
+
+// extractTargets finds the Roblox users and groups linked in a message and its embeds.
+func extractTargets(message discord.Message) ([]int64, []int64) {
+	texts := []string{message.Content}
+	for _, embed := range message.Embeds {
+		texts = append(texts, embed.Title, embed.URL, embed.Description)
+		for _, field := range embed.Fields {
+			texts = append(texts, field.Value)
+		}
+	}
+
+	var userIDs, groupIDs []int64
+
+	add := func(ids []int64, rawID string) []int64 {
+		id, err := strconv.ParseInt(rawID, 10, 64)
+		if err != nil || id <= 0 || slices.Contains(ids, id) ||
+			len(userIDs)+len(groupIDs) >= constants.CheckMessageMaxTargets {
+			return ids
+		}
+
+		return append(ids, id)
+	}
+
+	for _, text := range texts {
+		// Check markdown formatted users from our own logs
+		for line := range strings.Lines(text) {
+			if robloxID, _, err := utils.ParseRobloxMarkdown(line); err == nil {
+				userIDs = add(userIDs, strconv.FormatInt(robloxID, 10))
+			}
+		}
+
+		// Only accept links since bare numbers could be either a user or a group
+		for _, word := range strings.Fields(text) {
+			switch {
+			case utils.IsRobloxProfileURL(word):
+				if rawID, err := utils.ExtractUserIDFromURL(word); err == nil {
+					userIDs = add(userIDs, rawID)
+				}
+			case utils.IsRobloxGroupURL(word):
+				if rawID, err := utils.ExtractGroupIDFromURL(word); err == nil {
+					groupIDs = add(groupIDs, rawID)
+				}
+			}
+		}
+	}
+
+	return userIDs, groupIDs
+}
+
+// parseCheckQueueCustomID reads the user IDs stored in the custom ID of the queue button.
+func parseCheckQueueCustomID(customID string) []int64 {
+	rawIDs, found := strings.CutPrefix(customID, constants.CheckQueueButtonCustomID+":")
+	if !found {
+		return nil
+	}
+
+	userIDs := make([]int64, 0, strings.Count(rawIDs, ",")+1)
+
+	for rawID := range strings.SplitSeq(rawIDs, ",") {
+		if userID, err := strconv.ParseInt(rawID, 10, 64); err == nil && userID > 0 {
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	return userIDs
+}
@@ -0,0 +1,333 @@
+package commands
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/disgoorg/disgo/bot"
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/omit"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/cloudflare"
+	"github.com/robalyx/rotector/internal/database"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/redis"
+	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/worker/core"
+	"github.com/robalyx/rotector/pkg/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// commandTimeout bounds how long a quick action command may take.
+	commandTimeout = 30 * time.Second
+
+	// checkGuildLimit is the maximum number of message checks per guild within the reset period.
+	checkGuildLimit = 30
+	// checkQueueGuildLimit is the maximum number of users a guild may queue within the reset period.
+	checkQueueGuildLimit = 20
+	// checkResetPeriod is how often the per-guild counters reset.
+	checkResetPeriod = 10 * time.Minute
+)
+
+// permission describes who may run a quick action command.
+type permission int
+
+const (
+	permissionEveryone permission = iota
+	permissionReviewer
+	permissionAdmin
+)
+
+// responder is an interaction whose deferred response can be replaced.
+type responder interface {
+	Client() *bot.Client
+	ApplicationID() snowflake.ID
+	Token() string
+}
+
+// Handler runs the quick action slash commands. Unlike the main command, these
+// respond with a single compact message and never create a session.
+type Handler struct {
+	db                 database.Client
+	cfClient           *cloudflare.Client
+	workerMonitor      *core.Monitor
+	checkCounters      *utils.TTLMap[uint64, int]
+	checkQueueCounters *utils.TTLMap[uint64, int]
+	logger             *zap.Logger
+}
+
+// New creates a Handler for the quick action slash commands.
+func New(app *setup.App) *Handler {
+	// Get Redis client for worker status
+	statusClient, err := app.RedisManager.GetClient(redis.WorkerStatusDBIndex)
+	if err != nil {
+		app.Logger.Fatal("Failed to get Redis client for worker status", zap.Error(err))
+	}
+
+	return &Handler{
+		db:                 app.DB,
+		cfClient:           app.CFClient,
+		workerMonitor:      core.NewMonitor(statusClient, app.Logger),
+		checkCounters:      utils.NewTTLMap[uint64, int](checkResetPeriod),
+		checkQueueCounters: utils.NewTTLMap[uint64, int](checkResetPeriod),
+		logger:             app.Logger.Named("commands"),
+	}
+}
+
+// Definitions returns every application command the bot registers.
+func Definitions() []discord.ApplicationCommandCreate {
+	return []discord.ApplicationCommandCreate{
+		discord.SlashCommandCreate{
+			Name:        constants.RotectorCommandName,
+			Description: "Open the moderation interface",
+		},
+		discord.SlashCommandCreate{
+			Name:        constants.LookupCommandName,
+			Description: "Look up a user or group without opening the menu",
+			Options: []discord.ApplicationCommandOption{
+				discord.ApplicationCommandOptionSubCommand{
+					Name:        constants.UserSubcommandName,
+					Description: "Look up a Roblox user",
+					Options: []discord.ApplicationCommandOption{
+						targetOption("Roblox user ID or UUID, or type a name to search"),
+					},
+				},
+				discord.ApplicationCommandOptionSubCommand{
+					Name:        constants.GroupSubcommandName,
+					Description: "Look up a Roblox group",
+					Options: []discord.ApplicationCommandOption{
+						targetOption("Roblox group ID or UUID, or type a name to search"),
+					},
+				},
+				discord.ApplicationCommandOptionSubCommand{
+					Name:        constants.DiscordSubcommandName,
+					Description: "Look up a Discord user's flagged server memberships",
+					Options: []discord.ApplicationCommandOption{
+						discord.ApplicationCommandOptionUser{
+							Name:        constants.CommandUserOptionName,
+							Description: "Discord user to look up",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+		discord.SlashCommandCreate{
+			Name:        constants.QueueCommandName,
+			Description: "Queue a Roblox user for processing",
+			Options: []discord.ApplicationCommandOption{
+				discord.ApplicationCommandOptionString{
+					Name:        constants.CommandIDOptionName,
+					Description: "Roblox user ID or profile URL",
+					Required:    true,
+				},
+			},
+		},
+		discord.SlashCommandCreate{
+			Name:        constants.StatusCommandName,
+			Description: "Show worker statuses",
+		},
+		discord.SlashCommandCreate{
+			Name:        constants.NoteCommandName,
+			Description: "Add a note to a user or group",
+			Options: []discord.ApplicationCommandOption{
+				discord.ApplicationCommandOptionSubCommand{
+					Name:        constants.UserSubcommandName,
+					Description: "Add a note to a Roblox user",
+					Options: []discord.ApplicationCommandOption{
+						targetOption("Roblox user ID or UUID, or type a name to search"), messageOption(),
+					},
+				},
+				discord.ApplicationCommandOptionSubCommand{
+					Name:        constants.GroupSubcommandName,
+					Description: "Add a note to a Roblox group",
+					Options: []discord.ApplicationCommandOption{
+						targetOption("Roblox group ID or UUID, or type a name to search"), messageOption(),
+					},
+				},
+			},
+		},
+		// Partner servers can change who sees this command in their integration settings
+		discord.MessageCommandCreate{
+			Name:                     constants.CheckMessageCommandName,
+			DefaultMemberPermissions: omit.NewPtr(discord.PermissionManageMessages),
+			Contexts:                 []discord.InteractionContextType{discord.InteractionContextTypeGuild},
+		},
+	}
+}
+
+// Handles checks if a command is a quick action command.
+func (h *Handler) Handles(commandName string) bool {
+	switch commandName {
+	case constants.LookupCommandName,
+		constants.QueueCommandName,
+		constants.StatusCommandName,
+		constants.NoteCommandName,
+		constants.CheckMessageCommandName:
+		return true
+	default:
+		return false
+	}
+}
+
+// HandleCommand runs a quick action command. The response must already be deferred.
+func (h *Handler) HandleCommand(event *events.ApplicationCommandInteractionCreate) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	commandName := event.Data.CommandName()
+	subcommand := ""
+
+	if data, ok := event.Data.(discord.SlashCommandInteractionData); ok && data.SubCommandName != nil {
+		subcommand = *data.SubCommandName
+	}
+
+	botSettings, err := h.db.Model().Setting().GetBotSettings(ctx)
+	if err != nil {
+		h.logger.Error("Failed to get bot settings", zap.Error(err))
+		h.respondText(event, "Failed to load bot settings. Please try again.")
+
+		return
+	}
+
+	if !h.isAllowed(botSettings, uint64(event.User().ID), requiredPermission(commandName, subcommand)) {
+		h.logger.Warn("Unauthorized quick action command",
+			zap.Uint64("userID", uint64(event.User().ID)),
+			zap.String("command", commandName),
+			zap.String("subcommand", subcommand))
+		h.respondText(event, "You do not have permission to use this command.")
+
+		return
+	}
+
+	var response *discord.MessageUpdateBuilder
+
+	switch commandName {
+	case constants.LookupCommandName:
+		response = h.handleLookup(ctx, event, botSettings, subcommand)
+	case constants.QueueCommandName:
+		response = h.handleQueue(ctx, event)
+	case constants.StatusCommandName:
+		response = h.handleStatus(ctx)
+	case constants.NoteCommandName:
+		response = h.handleNote(ctx, event, subcommand)
+	case constants.CheckMessageCommandName:
+		response = h.handleCheck(ctx, event, botSettings)
+	}
+
+	if response != nil {
+		h.respond(event, response)
+	}
+}
+
+// HandlesComponent checks if a component belongs to a quick action response.
+func (h *Handler) HandlesComponent(customID string) bool {
+	return strings.HasPrefix(customID, constants.CheckQueueButtonCustomID+":")
+}
+
+// HandleComponent runs a button on a quick action response. The response must already be deferred.
+func (h *Handler) HandleComponent(event *events.ComponentInteractionCreate) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	botSettings, err := h.db.Model().Setting().GetBotSettings(ctx)
+	if err != nil {
+		h.logger.Error("Failed to get bot settings", zap.Error(err))
+		h.respondText(event, "Failed to load bot settings. Please try again.")
+
+		return
+	}
+
+	h.respond(event, h.handleCheckQueue(ctx, event, botSettings))
+}
+
+// HandleAutocomplete suggests tracked users or groups by name for target options.
+func (h *Handler) HandleAutocomplete(event *events.AutocompleteInteractionCreate) {
+	ctx, cancel := context.WithTimeout(context.Background(), commandTimeout)
+	defer cancel()
+
+	data := event.Data
+	subcommand := ""
+
+	if data.SubCommandName != nil {
+		subcommand = *data.SubCommandName
+	}
+
+	choices := []discord.AutocompleteChoice{}
+
+	botSettings, err := h.db.Model().Setting().GetBotSettings(ctx)
+	if err == nil && h.isAllowed(botSettings, uint64(event.User().ID), requiredPermission(data.CommandName, subcommand)) {
+		choices = h.searchTargets(ctx, subcommand, data.String(data.Focused().Name))
+	}
+
+	if err := event.AutocompleteResult(choices); err != nil {
+		h.logger.Error("Failed to respond to autocomplete", zap.Error(err))
+	}
+}
+
+// isAllowed checks if a user holds the permission required by a command.
+func (h *Handler) isAllowed(botSettings *types.BotSetting, userID uint64, required permission) bool {
+	if required == permissionEveryone || botSettings.IsAdmin(userID) {
+		return true
+	}
+
+	return required == permissionReviewer && botSettings.IsReviewer(userID)
+}
+
+// respond replaces the deferred response with a compact message.
+func (h *Handler) respond(event responder, response *discord.MessageUpdateBuilder) {
+	_, err := event.Client().Rest.UpdateInteractionResponse(
+		event.ApplicationID(), event.Token(), response.AddFlags(discord.MessageFlagIsComponentsV2).Build(),
+	)
+	if err != nil {
+		h.logger.Error("Failed to update interaction response", zap.Error(err))
+	}
+}
+
+// respondText replaces the deferred response with a short text message.
+func (h *Handler) respondText(event responder, content string) {
+	h.respond(event, textMessage(content))
+}
+
+// requiredPermission returns the permission a command needs. Group lookups and notes
+// are limited to admins, matching group review in the menu. The message check is
+// open to partner servers and relies on the command's default member permissions.
+func requiredPermission(commandName, subcommand string) permission {
+	if commandName == constants.CheckMessageCommandName {
+		return permissionEveryone
+	}
+
+	if (commandName == constants.LookupCommandName || commandName == constants.NoteCommandName) &&
+		subcommand == constants.GroupSubcommandName {
+		return permissionAdmin
+	}
+
+	return permissionReviewer
+}
+
+// targetOption creates the autocompleted option for a user or group target.
+func targetOption(description string) discord.ApplicationCommandOptionString {
+	return discord.ApplicationCommandOptionString{
+		Name:         constants.CommandIDOptionName,
+		Description:  description,
+		Required:     true,
+		Autocomplete: true,
+	}
+}
+
+// messageOption creates the option for the text of a note.
+func messageOption() discord.ApplicationCommandOptionString {
+	minLength, maxLength := 10, 512
+
+	return discord.ApplicationCommandOptionString{
+		Name:        constants.CommandMessageOptionName,
+		Description: "Note to add",
+		Required:    true,
+		MinLength:   &minLength,
+		MaxLength:   &maxLength,
+	}
+}
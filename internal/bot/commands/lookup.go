@@ -0,0 +1,215 @@
+package commands
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/disgoorg/snowflake/v2"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	view "github.com/robalyx/rotector/internal/bot/views/command"
+	"github.com/robalyx/rotector/internal/database/types"
+	"go.uber.org/zap"
+)
+
+// handleLookup shows a compact summary of a Roblox user, Roblox group or Discord user.
+func (h *Handler) handleLookup(
+	ctx context.Context, event *events.ApplicationCommandInteractionCreate,
+	botSettings *types.BotSetting, subcommand string,
+) *discord.MessageUpdateBuilder {
+	data := event.SlashCommandInteractionData()
+
+	switch subcommand {
+	case constants.UserSubcommandName:
+		return h.lookupUser(ctx, event, botSettings, data.String(constants.CommandIDOptionName))
+	case constants.GroupSubcommandName:
+		return h.lookupGroup(ctx, event, botSettings, data.String(constants.CommandIDOptionName))
+	case constants.DiscordSubcommandName:
+		return h.lookupDiscord(ctx, uint64(data.User(constants.CommandUserOptionName).ID))
+	default:
+		return textMessage("Unknown lookup type.")
+	}
+}
+
+// lookupUser shows a compact summary of a Roblox user.
+func (h *Handler) lookupUser(
+	ctx context.Context, event *events.ApplicationCommandInteractionCreate,
+	botSettings *types.BotSetting, userID string,
+) *discord.MessageUpdateBuilder {
+	user, err := h.db.Service().User().GetUserByID(ctx, userID, types.UserFieldBasic|
+		types.UserFieldProfile|types.UserFieldStats|types.UserFieldReasons|types.UserFieldLastUpdated)
+	if err != nil {
+		if errors.Is(err, types.ErrUserNotFound) || errors.Is(err, types.ErrInvalidUserID) {
+			return textMessage("User `" + userID + "` is not in the database.")
+		}
+
+		h.logger.Error("Failed to get user for lookup", zap.String("userID", userID), zap.Error(err))
+
+		return textMessage("Failed to look up user. Please try again.")
+	}
+
+	comments, err := h.db.Model().Comment().GetUserComments(ctx, user.ID)
+	if err != nil {
+		h.logger.Error("Failed to get user comments", zap.Int64("userID", user.ID), zap.Error(err))
+
+		comments = []*types.Comment{} // Continue without comments - not critical
+	}
+
+	return view.NewUserBuilder(user, comments, botSettings, h.privacyMode(ctx, uint64(event.User().ID))).Build()
+}
+
+// lookupGroup shows a compact summary of a Roblox group.
+func (h *Handler) lookupGroup(
+	ctx context.Context, event *events.ApplicationCommandInteractionCreate,
+	botSettings *types.BotSetting, groupID string,
+) *discord.MessageUpdateBuilder {
+	group, err := h.db.Model().Group().GetGroupByID(ctx, groupID, types.GroupFieldAll)
+	if err != nil {
+		if errors.Is(err, types.ErrGroupNotFound) || errors.Is(err, types.ErrInvalidGroupID) {
+			return textMessage("Group `" + groupID + "` is not in the database.")
+		}
+
+		h.logger.Error("Failed to get group for lookup", zap.String("groupID", groupID), zap.Error(err))
+
+		return textMessage("Failed to look up group. Please try again.")
+	}
+
+	comments, err := h.db.Model().Comment().GetGroupComments(ctx, group.ID)
+	if err != nil {
+		h.logger.Error("Failed to get group comments", zap.Int64("groupID", group.ID), zap.Error(err))
+
+		comments = []*types.Comment{} // Continue without comments - not critical
+	}
+
+	return view.NewGroupBuilder(group, comments, botSettings, h.privacyMode(ctx, uint64(event.User().ID))).Build()
+}
+
+// lookupDiscord shows a compact summary of a Discord user's flagged server memberships.
+// Unlike the menu lookup, this only reads stored data and does not scan the user.
+func (h *Handler) lookupDiscord(ctx context.Context, discordUserID uint64) *discord.MessageUpdateBuilder {
+	isRedacted, _, err := h.db.Service().Sync().ShouldSkipUser(ctx, discordUserID)
+	if err != nil {
+		h.logger.Error("Failed to check user privacy status",
+			zap.Uint64("discordUserID", discordUserID),
+			zap.Error(err))
+	}
+
+	userGuilds, _, err := h.db.Model().Sync().GetDiscordUserGuildsByCursor(
+		ctx, discordUserID, nil, constants.GuildMembershipsPerPage,
+	)
+	if err != nil {
+		h.logger.Error("Failed to get Discord user guilds",
+			zap.Uint64("discordUserID", discordUserID),
+			zap.Error(err))
+
+		return textMessage("Failed to retrieve guild membership data. Please try again.")
+	}
+
+	totalGuilds, err := h.db.Model().Sync().GetDiscordUserGuildCount(ctx, discordUserID)
+	if err != nil {
+		h.logger.Error("Failed to get Discord user guild count",
+			zap.Uint64("discordUserID", discordUserID),
+			zap.Error(err))
+
+		totalGuilds = len(userGuilds)
+	}
+
+	// Get guild names
+	guildNames := make(map[uint64]string)
+
+	if len(userGuilds) > 0 {
+		guildIDs := make([]uint64, len(userGuilds))
+		for i, guild := range userGuilds {
+			guildIDs[i] = guild.ServerID
+		}
+
+		guildInfos, err := h.db.Model().Sync().GetServerInfo(ctx, guildIDs)
+		if err != nil {
+			h.logger.Error("Failed to get guild names", zap.Error(err))
+		}
+
+		for _, info := range guildInfos {
+			guildNames[info.ServerID] = info.Name
+		}
+	}
+
+	// Only get message summary if data isn't redacted
+	var messageSummary *types.InappropriateUserSummary
+
+	if !isRedacted {
+		messageSummary, err = h.db.Model().Message().GetUserInappropriateMessageSummary(ctx, discordUserID)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			h.logger.Error("Failed to get message summary",
+				zap.Uint64("discordUserID", discordUserID),
+				zap.Error(err))
+		}
+	}
+
+	return view.NewDiscordBuilder(
+		discordUserID, userGuilds, guildNames, messageSummary, totalGuilds, isRedacted,
+	).Build()
+}
+
+// searchTargets finds tracked users or groups whose name starts with the input.
+func (h *Handler) searchTargets(ctx context.Context, subcommand, input string) []discord.AutocompleteChoice {
+	if input == "" {
+		return []discord.AutocompleteChoice{}
+	}
+
+	choices := make([]discord.AutocompleteChoice, 0, constants.CommandAutocompleteLimit)
+
+	switch subcommand {
+	case constants.UserSubcommandName:
+		users, err := h.db.Model().User().SearchUsersByName(ctx, input, constants.CommandAutocompleteLimit)
+		if err != nil {
+			h.logger.Error("Failed to search users", zap.Error(err))
+			break
+		}
+
+		for _, user := range users {
+			choices = append(choices, targetChoice(user.Name, user.ID, user.Status.String()))
+		}
+	case constants.GroupSubcommandName:
+		groups, err := h.db.Model().Group().SearchGroupsByName(ctx, input, constants.CommandAutocompleteLimit)
+		if err != nil {
+			h.logger.Error("Failed to search groups", zap.Error(err))
+			break
+		}
+
+		for _, group := range groups {
+			choices = append(choices, targetChoice(group.Name, group.ID, group.Status.String()))
+		}
+	}
+
+	return choices
+}
+
+// privacyMode checks if the user enabled streamer mode in their settings.
+func (h *Handler) privacyMode(ctx context.Context, userID uint64) bool {
+	userSettings, err := h.db.Model().Setting().GetUserSettings(ctx, snowflake.ID(userID))
+	if err != nil {
+		h.logger.Error("Failed to get user settings", zap.Error(err))
+		return true // Hide details if the setting is unknown
+	}
+
+	return userSettings.StreamerMode
+}
+
+// targetChoice creates an autocomplete choice for a user or group.
+func targetChoice(name string, id int64, status string) discord.AutocompleteChoiceString {
+	return discord.AutocompleteChoiceString{
+		Name:  utils.TruncateString(fmt.Sprintf("%s (%d) • %s", name, id, status), 100),
+		Value: strconv.FormatInt(id, 10),
+	}
+}
+
+// textMessage creates a response with a single timestamped line of text.
+func textMessage(content string) *discord.MessageUpdateBuilder {
+	return discord.NewMessageUpdateBuilder().
+		AddComponents(utils.CreateTimestampedTextDisplay(content))
+}
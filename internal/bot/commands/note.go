@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/database/service"
+	"github.com/robalyx/rotector/internal/database/types"
+	"go.uber.org/zap"
+)
+
+// handleNote adds a note to a tracked user or group.
+func (h *Handler) handleNote(
+	ctx context.Context, event *events.ApplicationCommandInteractionCreate, subcommand string,
+) *discord.MessageUpdateBuilder {
+	data := event.SlashCommandInteractionData()
+	targetInput := data.String(constants.CommandIDOptionName)
+
+	targetID, err := strconv.ParseInt(targetInput, 10, 64)
+	if err != nil {
+		return textMessage("Please select a target from the suggestions or enter a numeric ID.")
+	}
+
+	comment := types.Comment{
+		TargetID:    targetID,
+		CommenterID: uint64(event.User().ID),
+		Message:     data.String(constants.CommandMessageOptionName),
+	}
+
+	switch subcommand {
+	case constants.UserSubcommandName:
+		_, err = h.db.Service().User().GetUserByID(ctx, targetInput, types.UserFieldID)
+		if err == nil {
+			err = h.db.Service().Comment().AddUserComment(ctx, &types.UserComment{Comment: comment})
+		}
+	case constants.GroupSubcommandName:
+		_, err = h.db.Model().Group().GetGroupByID(ctx, targetInput, types.GroupFieldID)
+		if err == nil {
+			err = h.db.Service().Comment().AddGroupComment(ctx, &types.GroupComment{Comment: comment})
+		}
+	default:
+		return textMessage("Unknown note target.")
+	}
+
+	switch {
+	case err == nil:
+		return textMessage("Successfully added note.")
+	case errors.Is(err, types.ErrUserNotFound), errors.Is(err, types.ErrGroupNotFound):
+		return textMessage("Target `" + targetInput + "` is not in the database.")
+	case errors.Is(err, service.ErrInvalidComment):
+		return textMessage("Note contains invalid characters.")
+	case errors.Is(err, service.ErrCommentTooSimilar):
+		return textMessage("Note is too similar to an existing note.")
+	default:
+		h.logger.Error("Failed to add note",
+			zap.String("target", subcommand),
+			zap.Int64("targetID", targetID),
+			zap.Error(err))
+
+		return textMessage("Failed to add note. Please try again.")
+	}
+}
@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/disgoorg/disgo/events"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	view "github.com/robalyx/rotector/internal/bot/views/command"
+	"github.com/robalyx/rotector/internal/cloudflare/manager"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+	"github.com/robalyx/rotector/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// handleQueue adds a single Roblox user to the processing queue.
+func (h *Handler) handleQueue(
+	ctx context.Context, event *events.ApplicationCommandInteractionCreate,
+) *discord.MessageUpdateBuilder {
+	input := strings.TrimSpace(event.SlashCommandInteractionData().String(constants.CommandIDOptionName))
+
+	// Parse profile URL if provided
+	if parsedURL, err := utils.ExtractUserIDFromURL(input); err == nil {
+		input = parsedURL
+	}
+
+	userID, err := strconv.ParseInt(input, 10, 64)
+	if err != nil || userID <= 0 {
+		return textMessage("Please provide a valid user ID or profile URL.")
+	}
+
+	result := h.queueUser(ctx, userID, uint64(event.User().ID), manager.QueuePriorityReviewer)
+
+	stats, err := h.cfClient.Queue.GetStats(ctx)
+	if err != nil {
+		h.logger.Error("Failed to get queue stats", zap.Error(err))
+	}
+
+	return view.NewQueueBuilder(userID, result, stats).Build()
+}
+
+// queueUser queues a user unless they are already tracked and describes the outcome.
+func (h *Handler) queueUser(
+	ctx context.Context, userID int64, reviewerID uint64, priority manager.QueuePriority,
+) string {
+	existing, err := h.db.Service().User().GetUsersByIDs(ctx, []int64{userID}, types.UserFieldBasic)
+	if err != nil {
+		h.logger.Error("Failed to check existing user in database", zap.Error(err))
+	}
+
+	if user, exists := existing[userID]; exists {
+		return fmt.Sprintf("User is already in the database with status `%s`.", user.Status.String())
+	}
+
+	queueErrors, err := h.cfClient.Queue.AddUsers(ctx, []int64{userID}, priority)
+	if err != nil {
+		h.logger.Error("Failed to queue user", zap.Int64("userID", userID), zap.Error(err))
+		return "Failed to queue user. Please try again."
+	}
+
+	if queueErr, failed := queueErrors[userID]; failed {
+		if errors.Is(queueErr, manager.ErrUserRecentlyQueued) {
+			return "User was recently queued and is waiting to be processed."
+		}
+
+		return "Failed to queue user. Please try again."
+	}
+
+	h.db.Model().Activity().Log(ctx, &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: userID,
+		},
+		ReviewerID:        reviewerID,
+		ActivityType:      enum.ActivityTypeUserQueued,
+		ActivityTimestamp: time.Now(),
+		Details: map[string]any{
+			"batch_size": 1,
+		},
+	})
+
+	return "Successfully queued user for processing."
+}
@@ -0,0 +1,29 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	view "github.com/robalyx/rotector/internal/bot/views/status"
+	"github.com/robalyx/rotector/internal/worker/core"
+	"go.uber.org/zap"
+)
+
+// handleStatus shows the worker statuses using the status menu's builder.
+func (h *Handler) handleStatus(ctx context.Context) *discord.MessageUpdateBuilder {
+	workerStatuses, err := h.workerMonitor.GetAllStatuses(ctx)
+	if err != nil {
+		h.logger.Error("Failed to get worker statuses", zap.Error(err))
+	}
+
+	history, err := h.db.Model().Worker().GetHistory(
+		ctx, time.Now().Add(-constants.WorkerHistoryWindow), core.CrashLoopWindow,
+	)
+	if err != nil {
+		h.logger.Error("Failed to get worker history", zap.Error(err))
+	}
+
+	return view.NewBuilderFromData(workerStatuses, history).BuildCompact()
+}
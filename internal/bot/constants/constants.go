@@ -7,6 +7,35 @@ const (
 	RotectorCommandName = "rotector"
 )
 
+// Quick action slash commands.
+const (
+	LookupCommandName = "lookup"
+	QueueCommandName  = "queue"
+	StatusCommandName = "status"
+	NoteCommandName   = "note"
+
+	UserSubcommandName    = "user"
+	GroupSubcommandName   = "group"
+	DiscordSubcommandName = "discord"
+
+	CommandIDOptionName      = "id"
+	CommandUserOptionName    = "user"
+	CommandMessageOptionName = "message"
+
+	CommandAutocompleteLimit = 25 // Maximum number of choices Discord accepts
+	CommandReasonLength      = 200
+)
+
+// Message context-menu check.
+const (
+	CheckMessageCommandName  = "Check with Rotector"
+	CheckQueueButtonCustomID = "check_queue"
+
+	CheckMessageMaxTargets = 10
+	CheckSectionLength     = 350 // Keeps every target within the message length limit
+	CheckCustomIDLength    = 100 // Maximum custom ID length Discord accepts
+)
+
 // Common.
 const (
 	UnknownServer            = "Unknown Server"
@@ -37,11 +66,16 @@ const (
 	UserOutfitsPageName  = "Outfits Menu"
 	UserCaesarPageName   = "Caesar Cipher Menu"
 	UserCommentsPageName = "User Comments"
+	UserComparePageName  = "Account Comparison"
 
 	QueuePageName = "Queue Management"
 
 	AdminPageName              = "Admin Menu"
 	AdminActionConfirmPageName = "Action Confirmation"
+	SuspectedGamesPageName     = "Suspected Games"
+	WorkerControlPageName      = "Worker Control"
+	ReviewerWorkloadPageName   = "Reviewer Workload"
+	RuleSuggestionsPageName    = "Rule Suggestions"
 
 	BotSettingsPageName   = "Bot Settings"
 	UserSettingsPageName  = "User Settings"
@@ -150,6 +184,9 @@ const (
 	// MaxReviewHistorySize caps the number of review history entries shown.
 	MaxReviewHistorySize = 10
 
+	// ReviewClaimAttempts caps how often a queue fetch retries after losing a claim race.
+	ReviewClaimAttempts = 3
+
 	// ReviewFriendsLimit caps the number of friends shown in the main review container.
 	ReviewFriendsLimit = 8
 
@@ -199,11 +236,48 @@ const (
 	CommentMessageInputCustomID = "comment_message_input"
 )
 
+// Common Review Menu - Filter Presets.
+const (
+	FilterPresetLimit = 10
+
+	FilterPresetSelectMenuCustomID = "filter_preset"
+	FilterPresetNoneOption         = "none"
+	FilterPresetCreateOption       = "create_preset" + ModalOpenSuffix
+	FilterPresetDeleteOption       = "delete_preset"
+
+	FilterPresetModalCustomID            = "filter_preset_modal"
+	FilterPresetNameInputCustomID        = "filter_preset_name"
+	FilterPresetCategoriesSelectCustomID = "filter_preset_categories"
+	FilterPresetReasonsSelectCustomID    = "filter_preset_reasons"
+	FilterPresetRangesInputCustomID      = "filter_preset_ranges"
+	FilterPresetFlagsSelectCustomID      = "filter_preset_flags"
+
+	FilterPresetHasSocialsFlag  = "has_socials"
+	FilterPresetNoSocialsFlag   = "no_socials"
+	FilterPresetTouchedByMeFlag = "touched_by_me"
+)
+
+// Common Review Menu - Rule Suggestions.
+const (
+	// RuleEvidenceOptionLimit caps the evidence snippets offered for promotion in the suggestion modal.
+	RuleEvidenceOptionLimit = 25
+
+	SuggestRuleButtonCustomID      = "suggest_rule" + ModalOpenSuffix
+	SuggestRuleModalCustomID       = "suggest_rule_modal"
+	SuggestRuleEvidenceCustomID    = "suggest_rule_evidence"
+	SuggestRuleTermInputCustomID   = "suggest_rule_term"
+	SuggestRuleScopeSelectCustomID = "suggest_rule_scope"
+	SuggestRuleNotesInputCustomID  = "suggest_rule_notes"
+)
+
 // User Review Menu.
 const (
 	CaesarCipherButtonCustomID    = "caesar_cipher"
 	ViewCommentsButtonCustomID    = "view_comments"
 	ViewUserLogsButtonCustomID    = "view_user_logs"
+	UndoDecisionButtonCustomID    = "undo_decision"
+	GoldMarkOptionPrefix          = "gold_mark_"
+	GoldRemoveButtonCustomID      = "gold_remove"
 	OpenOutfitsMenuButtonCustomID = "open_outfits_menu"
 	OpenFriendsMenuButtonCustomID = "open_friends_menu"
 	OpenGroupsMenuButtonCustomID  = "open_groups_menu"
@@ -238,6 +312,22 @@ const (
 	CaesarTranslationsPerPage = 5
 )
 
+// User Review Menu - Account Comparison.
+const (
+	// CompareMaxUsers is the maximum number of users compared side by side, including the reviewed user.
+	CompareMaxUsers = 4
+	// CompareFragmentMinWords is the minimum number of words a shared description fragment must have.
+	CompareFragmentMinWords = 3
+	// CompareListLimit caps the number of fragments, friends and groups listed in each section.
+	CompareListLimit = 8
+
+	CompareUsersButtonCustomID      = "compare_users" + ModalOpenSuffix
+	CompareUsersModalCustomID       = "compare_users_modal"
+	CompareUserIDsInputCustomID     = "compare_user_ids"
+	CompareConfirmAllButtonCustomID = "compare_confirm_all"
+	CompareClearAllButtonCustomID   = "compare_clear_all"
+)
+
 // Group Review Menu.
 const (
 	GroupViewMembersButtonCustomID = "group_view_members"
@@ -258,6 +348,12 @@ const (
 	QueueUserModalCustomID  = "queue_user_modal"
 	QueueUserInputCustomID  = "queue_user_input"
 
+	QueueGroupButtonCustomID         = "queue_group" + ModalOpenSuffix
+	QueueGroupModalCustomID          = "queue_group_modal"
+	QueueGroupInputCustomID          = "queue_group_input"
+	QueueGroupAlliesSelectCustomID   = "queue_group_allies"
+	QueueGroupPrioritySelectCustomID = "queue_group_priority"
+
 	ManualUserReviewButtonCustomID = "manual_user_review" + ModalOpenSuffix
 	ManualUserReviewModalCustomID  = "manual_user_review_modal"
 	ManualUserReviewInputCustomID  = "manual_user_review_input"
@@ -290,6 +386,11 @@ const (
 	WelcomeMessageOption      = "welcome_message"
 	AnnouncementTypeOption    = "announcement_type"
 	AnnouncementMessageOption = "announcement_message"
+	TraineePolicyOption       = "trainee_policy"
+	ReviewerPolicyOption      = "reviewer_policy"
+	AdminPolicyOption         = "admin_policy"
+
+	ReviewPolicyDefaultValue = "default"
 )
 
 // Logs Menu.
@@ -318,18 +419,15 @@ const (
 	CaptchaAnswerInputCustomID   = "captcha_answer_input"
 )
 
-// Timeout Menu.
-const (
-	MaxReviewsBeforeBreak = 40               // Maximum reviews before requiring a break
-	MinBreakDuration      = 10 * time.Minute // Minimum break duration
-	ReviewSessionWindow   = 1 * time.Hour    // Window to track review count
-)
-
 // Admin Menu.
 const (
-	BotSettingsButtonCustomID = "bot_settings"
-	DeleteUserButtonCustomID  = "delete_user" + ModalOpenSuffix
-	DeleteGroupButtonCustomID = "delete_group" + ModalOpenSuffix
+	BotSettingsButtonCustomID      = "bot_settings"
+	DeleteUserButtonCustomID       = "delete_user" + ModalOpenSuffix
+	DeleteGroupButtonCustomID      = "delete_group" + ModalOpenSuffix
+	SuspectedGamesButtonCustomID   = "suspected_games"
+	WorkerControlButtonCustomID    = "worker_control"
+	ReviewerWorkloadButtonCustomID = "reviewer_workload"
+	RuleSuggestionsButtonCustomID  = "rule_suggestions"
 
 	DeleteUserModalCustomID  = "delete_user_modal"
 	DeleteGroupModalCustomID = "delete_group_modal"
@@ -344,6 +442,49 @@ const (
 	DeleteGroupAction = "delete_group"
 )
 
+// Suspected Games Menu.
+const (
+	SuspectedGamesPerPage = 10
+)
+
+// Worker Status Menu.
+const (
+	WorkerHistoryWindow = 24 * time.Hour // Window of worker history shown on the status page
+)
+
+// Worker Control Menu.
+const (
+	WorkerControlTypeSelectMenuCustomID = "worker_control_type"
+	WorkerPauseButtonCustomID           = "worker_pause"
+	WorkerResumeButtonCustomID          = "worker_resume"
+	WorkerDrainButtonCustomID           = "worker_drain"
+	WorkerRunNowButtonCustomID          = "worker_run_now"
+	WorkerOverrideButtonCustomID        = "worker_override" + ModalOpenSuffix
+	WorkerClearOverridesButtonCustomID  = "worker_clear_overrides"
+
+	WorkerOverrideModalCustomID = "worker_override_modal"
+	WorkerOverrideKeyInputID    = "worker_override_key"
+	WorkerOverrideValueInputID  = "worker_override_value"
+)
+
+// Reviewer Workload Menu.
+const (
+	WorkloadReviewerSelectMenuCustomID = "workload_reviewer" + ModalOpenSuffix
+	WorkloadAssignModalCustomID        = "workload_assign_modal"
+	WorkloadCategoriesSelectCustomID   = "workload_categories"
+	WorkloadClustersInputCustomID      = "workload_clusters"
+)
+
+// Rule Suggestions Menu.
+const (
+	RuleSuggestionsPerPage           = 5
+	RuleSuggestionSelectMenuCustomID = "rule_suggestion_action"
+
+	RuleActionEnable = "enable"
+	RuleActionReject = "reject"
+	RuleActionRetest = "retest"
+)
+
 // Reviewer Stats Menu.
 const (
 	ReviewerStatsPerPage                  = 5
@@ -10,7 +10,6 @@ import (
 	"github.com/dchest/captcha"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/database"
-	"github.com/robalyx/rotector/internal/database/types/enum"
 	"go.uber.org/zap"
 )
 
@@ -56,8 +55,8 @@ func (m *Manager) GenerateImage() ([]byte, *bytes.Buffer, error) {
 
 // IncrementReviewCounter increments the review counter and updates settings.
 func (m *Manager) IncrementReviewCounter(s *session.Session) error {
-	// Only increment for non-reviewers in training mode
-	if !s.BotSettings().IsReviewer(session.UserID.Get(s)) && session.UserReviewMode.Get(s) == enum.ReviewModeTraining {
+	// Only increment if the policy of the user's role requires CAPTCHAs
+	if s.BotSettings().ReviewPolicy(session.UserID.Get(s)).CaptchaInterval > 0 {
 		reviewCount := session.UserCaptchaUsageCaptchaReviewCount.Get(s)
 		session.UserCaptchaUsageCaptchaReviewCount.Set(s, reviewCount+1)
 	}
@@ -65,9 +64,9 @@ func (m *Manager) IncrementReviewCounter(s *session.Session) error {
 	return nil
 }
 
-// IsRequired checks if CAPTCHA verification is needed.
+// IsRequired checks if CAPTCHA verification is needed under the review policy of the user's role.
 func (m *Manager) IsRequired(s *session.Session) bool {
-	return session.UserReviewMode.Get(s) == enum.ReviewModeTraining &&
-		!s.BotSettings().IsReviewer(session.UserID.Get(s)) &&
-		session.UserCaptchaUsageCaptchaReviewCount.Get(s) >= 10
+	interval := s.BotSettings().ReviewPolicy(session.UserID.Get(s)).CaptchaInterval
+
+	return interval > 0 && session.UserCaptchaUsageCaptchaReviewCount.Get(s) >= interval
 }
@@ -0,0 +1,217 @@
+package claim
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/rueidis"
+	"github.com/robalyx/rotector/internal/database/types"
+	"go.uber.org/zap"
+)
+
+const (
+	// TTL is how long a claim lasts without the reviewer interacting with their session.
+	TTL = 5 * time.Minute
+
+	// KeyPrefix is the prefix for the key holding the reviewer of a claimed target.
+	KeyPrefix = "review_claim"
+
+	// IndexKeyPrefix is the prefix for the sorted set of claimed targets by expiry.
+	IndexKeyPrefix = "review_claims"
+
+	// OwnerKeyPrefix is the prefix for the key holding the target a reviewer claimed.
+	OwnerKeyPrefix = "review_claim_owner"
+)
+
+var (
+	// acquireScript claims a target unless another reviewer holds it, releasing the
+	// previous target the reviewer held so each reviewer holds one claim per target type.
+	acquireScript = rueidis.NewLuaScript(`
+local owner = redis.call("GET", KEYS[1])
+if owner and owner ~= ARGV[1] then
+	return 0
+end
+local previous = redis.call("GET", KEYS[3])
+if previous and previous ~= ARGV[2] then
+	local previousKey = ARGV[5] .. previous
+	if redis.call("GET", previousKey) == ARGV[1] then
+		redis.call("DEL", previousKey)
+		redis.call("ZREM", KEYS[2], previous)
+	end
+end
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[3])
+redis.call("SET", KEYS[3], ARGV[2], "PX", ARGV[3])
+redis.call("ZADD", KEYS[2], ARGV[4], ARGV[2])
+return 1`)
+
+	// extendScript extends a claim only if it is still held by the reviewer.
+	extendScript = rueidis.NewLuaScript(`
+if redis.call("GET", KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+redis.call("PEXPIRE", KEYS[1], ARGV[3])
+redis.call("PEXPIRE", KEYS[3], ARGV[3])
+redis.call("ZADD", KEYS[2], ARGV[4], ARGV[2])
+return 1`)
+
+	// releaseScript removes a claim only if it is still held by the reviewer.
+	releaseScript = rueidis.NewLuaScript(`
+if redis.call("GET", KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+redis.call("DEL", KEYS[1])
+redis.call("ZREM", KEYS[2], ARGV[2])
+if redis.call("GET", KEYS[3]) == ARGV[2] then
+	redis.call("DEL", KEYS[3])
+end
+return 1`)
+)
+
+// Manager handles short-lived claims that stop reviewers from pulling the same
+// target from the review queue. Claims expire unless extended by session activity.
+type Manager struct {
+	client rueidis.Client
+	logger *zap.Logger
+}
+
+// NewManager creates a new claim manager.
+func NewManager(client rueidis.Client, logger *zap.Logger) *Manager {
+	return &Manager{
+		client: client,
+		logger: logger.Named("claim"),
+	}
+}
+
+// Acquire claims a target for a reviewer and releases the previous target the
+// reviewer claimed. Returns false if another reviewer holds the target.
+func (m *Manager) Acquire(
+	ctx context.Context, targetType types.DecisionTargetType, targetID int64, reviewerID uint64,
+) (bool, error) {
+	result, err := m.exec(ctx, acquireScript, targetType, targetID, reviewerID)
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire claim: %w", err)
+	}
+
+	return result == 1, nil
+}
+
+// Extend keeps a reviewer's claim on a target alive. Does nothing if the
+// reviewer does not hold the claim.
+func (m *Manager) Extend(
+	ctx context.Context, targetType types.DecisionTargetType, targetID int64, reviewerID uint64,
+) error {
+	if _, err := m.exec(ctx, extendScript, targetType, targetID, reviewerID); err != nil {
+		return fmt.Errorf("failed to extend claim: %w", err)
+	}
+
+	return nil
+}
+
+// Release removes a reviewer's claim on a target.
+func (m *Manager) Release(
+	ctx context.Context, targetType types.DecisionTargetType, targetID int64, reviewerID uint64,
+) error {
+	if _, err := m.exec(ctx, releaseScript, targetType, targetID, reviewerID); err != nil {
+		return fmt.Errorf("failed to release claim: %w", err)
+	}
+
+	return nil
+}
+
+// GetClaims retrieves the reviewer of every active claim on targets of a type.
+func (m *Manager) GetClaims(ctx context.Context, targetType types.DecisionTargetType) (map[int64]uint64, error) {
+	indexKey := fmt.Sprintf("%s:%s", IndexKeyPrefix, targetType)
+	now := strconv.FormatInt(time.Now().UnixMilli(), 10)
+
+	// Drop expired claims from the index
+	err := m.client.Do(ctx, m.client.B().Zremrangebyscore().Key(indexKey).Min("-inf").Max("("+now).Build()).Error()
+	if err != nil {
+		return nil, fmt.Errorf("failed to prune claims: %w", err)
+	}
+
+	members, err := m.client.Do(ctx, m.client.B().Zrange().Key(indexKey).Min(now).Max("+inf").Byscore().Build()).
+		AsStrSlice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get claims: %w", err)
+	}
+
+	if len(members) == 0 {
+		return map[int64]uint64{}, nil
+	}
+
+	keys := make([]string, len(members))
+	for i, member := range members {
+		keys[i] = fmt.Sprintf("%s:%s:%s", KeyPrefix, targetType, member)
+	}
+
+	owners, err := m.client.Do(ctx, m.client.B().Mget().Key(keys...).Build()).ToArray()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get claim owners: %w", err)
+	}
+
+	claims := make(map[int64]uint64, len(members))
+
+	for i, member := range members {
+		targetID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		owner, err := owners[i].AsUint64()
+		if err != nil {
+			continue // Claim expired between the two reads
+		}
+
+		claims[targetID] = owner
+	}
+
+	return claims, nil
+}
+
+// GetClaimedIDs retrieves the targets claimed by reviewers other than the given one.
+// Errors are logged and result in no targets being skipped.
+func (m *Manager) GetClaimedIDs(
+	ctx context.Context, targetType types.DecisionTargetType, reviewerID uint64,
+) []int64 {
+	claims, err := m.GetClaims(ctx, targetType)
+	if err != nil {
+		m.logger.Error("Failed to get claimed targets", zap.Error(err))
+		return nil
+	}
+
+	claimedIDs := make([]int64, 0, len(claims))
+
+	for targetID, owner := range claims {
+		if owner != reviewerID {
+			claimedIDs = append(claimedIDs, targetID)
+		}
+	}
+
+	return claimedIDs
+}
+
+// exec runs a claim script with the keys and arguments of a claim.
+func (m *Manager) exec(
+	ctx context.Context, script *rueidis.Lua, targetType types.DecisionTargetType, targetID int64, reviewerID uint64,
+) (int64, error) {
+	target := strconv.FormatInt(targetID, 10)
+	reviewer := strconv.FormatUint(reviewerID, 10)
+	claimPrefix := fmt.Sprintf("%s:%s:", KeyPrefix, targetType)
+
+	keys := []string{
+		claimPrefix + target,
+		fmt.Sprintf("%s:%s", IndexKeyPrefix, targetType),
+		fmt.Sprintf("%s:%s:%s", OwnerKeyPrefix, targetType, reviewer),
+	}
+	args := []string{
+		reviewer,
+		target,
+		strconv.FormatInt(TTL.Milliseconds(), 10),
+		strconv.FormatInt(time.Now().Add(TTL).UnixMilli(), 10),
+		claimPrefix,
+	}
+
+	return script.Exec(ctx, m.client, keys, args).AsInt64()
+}
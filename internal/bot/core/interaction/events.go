@@ -14,6 +14,8 @@ type ModalData interface {
 	Text(customID string) string
 	// OptText returns the value of a text input component and whether it exists.
 	OptText(customID string) (string, bool)
+	// StringValues returns the selected values of a string select menu component with the given custom ID.
+	StringValues(customID string) []string
 }
 
 // CommonEvent extracts shared functionality from different Discord event types.
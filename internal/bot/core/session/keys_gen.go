@@ -74,6 +74,7 @@ func main() {
 
 		// Status related keys
 		{Name: "StatusWorkers", Type: "[]core.Status", Doc: "StatusWorkers stores worker status information", Persist: false},
+		{Name: "StatusWorkerHistory", Type: "[]*types.WorkerHistory", Doc: "StatusWorkerHistory stores worker history summaries for the status window", Persist: false},
 
 		// Settings related keys
 		{Name: "SettingName", Type: "string", Doc: "SettingName stores the name of the current setting", Persist: true},
@@ -94,6 +95,8 @@ func main() {
 		{Name: "UserDuplicateOutfitNames", Type: "map[string]struct{}", Doc: "UserDuplicateOutfitNames stores outfit names that have multiple instances", Persist: true},
 		{Name: "UserReviewHistory", Type: "[]int64", Doc: "UserReviewHistory stores IDs of previously reviewed users", Persist: true},
 		{Name: "UserReviewHistoryIndex", Type: "int", Doc: "UserReviewHistoryIndex stores the current position in the review history", Persist: true},
+		{Name: "UserCompareIDs", Type: "[]int64", Doc: "UserCompareIDs stores the IDs of users being compared side by side", Persist: true},
+		{Name: "UserCompareUsers", Type: "[]*types.ReviewUser", Doc: "UserCompareUsers stores the users being compared side by side", Persist: true},
 
 		// Group related keys
 		{Name: "GroupTarget", Type: "*types.ReviewGroup", Doc: "GroupTarget stores the currently selected group", Persist: true},
@@ -114,6 +117,10 @@ func main() {
 		{Name: "ReviewLogs", Type: "[]*types.ActivityLog", Doc: "ReviewLogs stores the current review logs", Persist: true},
 		{Name: "ReviewLogsHasMore", Type: "bool", Doc: "ReviewLogsHasMore indicates if there are more logs available", Persist: true},
 		{Name: "ReviewComments", Type: "[]*types.Comment", Doc: "ReviewComments stores comments for the current user or group", Persist: true},
+		{Name: "UserLatestStatusChange", Type: "*types.UserStatusChange", Doc: "UserLatestStatusChange stores the latest decision made on the current user", Persist: true},
+		{Name: "GroupLatestStatusChange", Type: "*types.GroupStatusChange", Doc: "GroupLatestStatusChange stores the latest decision made on the current group", Persist: true},
+		{Name: "ReviewPendingDecision", Type: "*types.PendingDecision", Doc: "ReviewPendingDecision stores the open consensus decision of the current user or group", Persist: true},
+		{Name: "ReviewGoldItem", Type: "*types.GoldItem", Doc: "ReviewGoldItem stores the gold item of the current user or group for admins", Persist: true},
 
 		// Queue related keys
 		{Name: "QueueStats", Type: "*cloudflare.Stats", Doc: "QueueStats stores queue statistics", Persist: true},
@@ -174,10 +181,18 @@ func main() {
 		{Name: "AdminAction", Type: "string", Doc: "AdminAction stores the current admin action", Persist: true},
 		{Name: "AdminActionID", Type: "string", Doc: "AdminActionID stores the admin action ID", Persist: true},
 		{Name: "AdminReason", Type: "string", Doc: "AdminReason stores the admin action reason", Persist: true},
+		{Name: "AdminSuspectedGames", Type: "[]*types.GameReputation", Doc: "AdminSuspectedGames stores the current page of suspected games", Persist: true},
+		{Name: "AdminWorkerControls", Type: "[]*core.ControlState", Doc: "AdminWorkerControls stores the control state of each worker type", Persist: false},
+		{Name: "AdminWorkerControlType", Type: "string", Doc: "AdminWorkerControlType stores the worker type selected in the control menu", Persist: true},
+		{Name: "AdminReviewerWorkloads", Type: "[]*types.ReviewerWorkload", Doc: "AdminReviewerWorkloads stores the assignments and claims of each reviewer", Persist: true},
+		{Name: "AdminWorkloadReviewerID", Type: "uint64", Doc: "AdminWorkloadReviewerID stores the reviewer whose assignments are being edited", Persist: true},
+		{Name: "AdminRuleSuggestions", Type: "[]*types.DetectionRule", Doc: "AdminRuleSuggestions stores the current page of pending detection rules", Persist: true},
 
 		// Reviewer stats related keys
 		{Name: "ReviewerStats", Type: "map[uint64]*types.ReviewerStats", Doc: "ReviewerStats stores reviewer statistics", Persist: true},
 		{Name: "ReviewerUsernames", Type: "map[uint64]string", Doc: "ReviewerUsernames stores usernames for reviewers", Persist: true},
+		{Name: "ReviewerAccuracies", Type: "map[uint64]*types.ReviewerAccuracy", Doc: "ReviewerAccuracies stores gold item accuracy for reviewers", Persist: true},
+		{Name: "ReviewerFatigues", Type: "map[uint64]*types.ReviewerFatigue", Doc: "ReviewerFatigues stores the decision pace of reviewers", Persist: true},
 		{Name: "ReviewerStatsCursor", Type: "*types.ReviewerStatsCursor", Doc: "ReviewerStatsCursor stores the current reviewer stats cursor", Persist: true},
 		{Name: "ReviewerStatsNextCursor", Type: "*types.ReviewerStatsCursor", Doc: "ReviewerStatsNextCursor stores the next reviewer stats cursor", Persist: true},
 		{Name: "ReviewerStatsPrevCursors", Type: "[]*types.ReviewerStatsCursor", Doc: "ReviewerStatsPrevCursors stores previous reviewer stats cursors", Persist: true},
@@ -52,6 +52,8 @@ var (
 	StatsActiveUsers = NewKey[[]uint64]("StatsActiveUsers", true)
 	// StatusWorkers stores worker status information
 	StatusWorkers = NewKey[[]core.Status]("StatusWorkers", false)
+	// StatusWorkerHistory stores worker history summaries for the status window
+	StatusWorkerHistory = NewKey[[]*types.WorkerHistory]("StatusWorkerHistory", false)
 	// SettingName stores the name of the current setting
 	SettingName = NewKey[string]("SettingName", true)
 	// SettingType stores the type of the current setting
@@ -84,6 +86,10 @@ var (
 	UserReviewHistory = NewKey[[]int64]("UserReviewHistory", true)
 	// UserReviewHistoryIndex stores the current position in the review history
 	UserReviewHistoryIndex = NewKey[int]("UserReviewHistoryIndex", true)
+	// UserCompareIDs stores the IDs of users being compared side by side
+	UserCompareIDs = NewKey[[]int64]("UserCompareIDs", true)
+	// UserCompareUsers stores the users being compared side by side
+	UserCompareUsers = NewKey[[]*types.ReviewUser]("UserCompareUsers", true)
 	// GroupTarget stores the currently selected group
 	GroupTarget = NewKey[*types.ReviewGroup]("GroupTarget", true)
 	// GroupInfo stores additional group information
@@ -116,6 +122,14 @@ var (
 	ReviewLogsHasMore = NewKey[bool]("ReviewLogsHasMore", true)
 	// ReviewComments stores comments for the current user or group
 	ReviewComments = NewKey[[]*types.Comment]("ReviewComments", true)
+	// UserLatestStatusChange stores the latest decision made on the current user
+	UserLatestStatusChange = NewKey[*types.UserStatusChange]("UserLatestStatusChange", true)
+	// GroupLatestStatusChange stores the latest decision made on the current group
+	GroupLatestStatusChange = NewKey[*types.GroupStatusChange]("GroupLatestStatusChange", true)
+	// ReviewPendingDecision stores the open consensus decision of the current user or group
+	ReviewPendingDecision = NewKey[*types.PendingDecision]("ReviewPendingDecision", true)
+	// ReviewGoldItem stores the gold item of the current user or group for admins
+	ReviewGoldItem = NewKey[*types.GoldItem]("ReviewGoldItem", true)
 	// QueueStats stores queue statistics
 	QueueStats = NewKey[*cloudflare.Stats]("QueueStats", true)
 	// QueuedUserID stores the ID of the currently queued user
@@ -204,10 +218,26 @@ var (
 	AdminActionID = NewKey[string]("AdminActionID", true)
 	// AdminReason stores the admin action reason
 	AdminReason = NewKey[string]("AdminReason", true)
+	// AdminSuspectedGames stores the current page of suspected games
+	AdminSuspectedGames = NewKey[[]*types.GameReputation]("AdminSuspectedGames", true)
+	// AdminWorkerControls stores the control state of each worker type
+	AdminWorkerControls = NewKey[[]*core.ControlState]("AdminWorkerControls", false)
+	// AdminWorkerControlType stores the worker type selected in the control menu
+	AdminWorkerControlType = NewKey[string]("AdminWorkerControlType", true)
+	// AdminReviewerWorkloads stores the assignments and claims of each reviewer
+	AdminReviewerWorkloads = NewKey[[]*types.ReviewerWorkload]("AdminReviewerWorkloads", true)
+	// AdminWorkloadReviewerID stores the reviewer whose assignments are being edited
+	AdminWorkloadReviewerID = NewKey[uint64]("AdminWorkloadReviewerID", true)
+	// AdminRuleSuggestions stores the current page of pending detection rules
+	AdminRuleSuggestions = NewKey[[]*types.DetectionRule]("AdminRuleSuggestions", true)
 	// ReviewerStats stores reviewer statistics
 	ReviewerStats = NewKey[map[uint64]*types.ReviewerStats]("ReviewerStats", true)
 	// ReviewerUsernames stores usernames for reviewers
 	ReviewerUsernames = NewKey[map[uint64]string]("ReviewerUsernames", true)
+	// ReviewerAccuracies stores gold item accuracy for reviewers
+	ReviewerAccuracies = NewKey[map[uint64]*types.ReviewerAccuracy]("ReviewerAccuracies", true)
+	// ReviewerFatigues stores the decision pace of reviewers
+	ReviewerFatigues = NewKey[map[uint64]*types.ReviewerFatigue]("ReviewerFatigues", true)
 	// ReviewerStatsCursor stores the current reviewer stats cursor
 	ReviewerStatsCursor = NewKey[*types.ReviewerStatsCursor]("ReviewerStatsCursor", true)
 	// ReviewerStatsNextCursor stores the next reviewer stats cursor
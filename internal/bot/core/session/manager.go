@@ -14,6 +14,7 @@ import (
 	"github.com/redis/rueidis"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/database"
+	"github.com/robalyx/rotector/internal/metrics"
 	"github.com/robalyx/rotector/internal/redis"
 	"go.uber.org/zap"
 )
@@ -29,6 +30,9 @@ const (
 
 	// MaxSessionsPerUser is the maximum number of sessions allowed per user.
 	MaxSessionsPerUser = 3
+
+	// sessionCountTimeout bounds how long counting sessions for metrics may take.
+	sessionCountTimeout = 5 * time.Second
 )
 
 var (
@@ -62,11 +66,27 @@ func NewManager(db database.Client, redisManager *redis.Manager, logger *zap.Log
 		return nil, fmt.Errorf("failed to get Redis client: %w", err)
 	}
 
-	return &Manager{
+	m := &Manager{
 		db:     db,
 		redis:  redisClient,
 		logger: logger.Named("session_manager"),
-	}, nil
+	}
+
+	// Report the active session count whenever metrics are scraped
+	metrics.SetActiveSessionsSource(func() float64 {
+		ctx, cancel := context.WithTimeout(context.Background(), sessionCountTimeout)
+		defer cancel()
+
+		count, err := m.GetActiveSessionCount(ctx)
+		if err != nil {
+			m.logger.Warn("Failed to count active sessions for metrics", zap.Error(err))
+			return 0
+		}
+
+		return float64(count)
+	})
+
+	return m, nil
 }
 
 // GetUserSessions retrieves all active sessions for a given user.
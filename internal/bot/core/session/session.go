@@ -15,6 +15,7 @@ import (
 
 	"github.com/bytedance/sonic"
 	"github.com/redis/rueidis"
+	"github.com/robalyx/rotector/internal/bot/core/claim"
 	"github.com/robalyx/rotector/internal/database"
 	"github.com/robalyx/rotector/internal/database/types"
 	"go.uber.org/zap"
@@ -39,6 +40,7 @@ type Session struct {
 	botSettings        *types.BotSetting
 	db                 database.Client
 	redis              rueidis.Client
+	claims             *claim.Manager
 	logger             *zap.Logger
 	data               map[string]any
 	dataModified       map[string]bool
@@ -68,6 +70,7 @@ func NewSession(
 		botSettingsUpdate:  false,
 		db:                 db,
 		redis:              redis,
+		claims:             claim.NewManager(redis, logger),
 		key:                key,
 		data:               data,
 		dataModified:       make(map[string]bool),
@@ -133,6 +136,9 @@ func (s *Session) Touch(ctx context.Context) {
 		s.logger.Error("Failed to update session in Redis", zap.Error(err))
 	}
 
+	// Keep the claims on the current review targets alive
+	s.extendClaims(ctx)
+
 	// Only save user settings if they've been updated
 	if s.userSettingsUpdate {
 		if err := s.db.Model().Setting().SaveUserSettings(ctx, s.userSettings); err != nil {
@@ -154,6 +160,26 @@ func (s *Session) Touch(ctx context.Context) {
 	}
 }
 
+// extendClaims extends the reviewer's claims on the users and groups being reviewed.
+func (s *Session) extendClaims(ctx context.Context) {
+	reviewerID := UserID.Get(s)
+	if !s.botSettings.IsReviewer(reviewerID) {
+		return
+	}
+
+	if user := UserTarget.Get(s); user != nil {
+		if err := s.claims.Extend(ctx, types.DecisionTargetUser, user.ID, reviewerID); err != nil {
+			s.logger.Error("Failed to extend user claim", zap.Error(err))
+		}
+	}
+
+	if group := GroupTarget.Get(s); group != nil {
+		if err := s.claims.Extend(ctx, types.DecisionTargetGroup, group.ID, reviewerID); err != nil {
+			s.logger.Error("Failed to extend group claim", zap.Error(err))
+		}
+	}
+}
+
 // UserSettings returns the current user settings.
 func (s *Session) UserSettings() *types.UserSetting {
 	return s.userSettings
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/robalyx/rotector/internal/bot/constants"
@@ -141,6 +143,12 @@ func (r *SettingRegistry) registerBotSettings() {
 	r.BotSettings[constants.WelcomeMessageOption] = r.createWelcomeMessageSetting()
 	r.BotSettings[constants.AnnouncementTypeOption] = r.createAnnouncementTypeSetting()
 	r.BotSettings[constants.AnnouncementMessageOption] = r.createAnnouncementMessageSetting()
+	r.BotSettings[constants.TraineePolicyOption] = r.createReviewPolicySetting(
+		constants.TraineePolicyOption, "Trainee Review Policy", types.ReviewerRoleTrainee)
+	r.BotSettings[constants.ReviewerPolicyOption] = r.createReviewPolicySetting(
+		constants.ReviewerPolicyOption, "Reviewer Review Policy", types.ReviewerRoleReviewer)
+	r.BotSettings[constants.AdminPolicyOption] = r.createReviewPolicySetting(
+		constants.AdminPolicyOption, "Admin Review Policy", types.ReviewerRoleAdmin)
 }
 
 // createStreamerModeSetting creates the streamer mode setting.
@@ -584,6 +592,68 @@ func (r *SettingRegistry) createAnnouncementMessageSetting() *Setting {
 	}
 }
 
+// createReviewPolicySetting creates the break and CAPTCHA policy setting of a reviewer role.
+func (r *SettingRegistry) createReviewPolicySetting(key, name string, role types.ReviewerRole) *Setting {
+	return &Setting{
+		Key:  key,
+		Name: name,
+		Description: fmt.Sprintf("Set break and CAPTCHA limits for %ss as key=value pairs, or %q to reset",
+			role, constants.ReviewPolicyDefaultValue),
+		Type:         enum.SettingTypeText,
+		DefaultValue: types.DefaultReviewPolicy(role).String(),
+		Validators: []Validator{
+			func(value string, _ uint64) error {
+				if strings.TrimSpace(value) == constants.ReviewPolicyDefaultValue {
+					return nil
+				}
+
+				_, err := types.ParseReviewPolicy(value, types.DefaultReviewPolicy(role))
+
+				return err
+			},
+		},
+		ValueGetter: func(s *Session) string {
+			return s.BotSettings().PolicyForRole(role).String()
+		},
+		ValueUpdater: func(_ string, inputs []string, s *Session) error {
+			if len(inputs) < 1 {
+				return ErrMissingInput
+			}
+
+			// Get old value for logging
+			oldPolicy := s.BotSettings().PolicyForRole(role)
+
+			policies := maps.Clone(BotReviewPolicies.Get(s))
+			if policies == nil {
+				policies = make(types.ReviewPolicies)
+			}
+
+			// Reset to the default policy or apply the changes on top of the current one
+			if strings.TrimSpace(inputs[0]) == constants.ReviewPolicyDefaultValue {
+				delete(policies, role)
+			} else {
+				policy, err := types.ParseReviewPolicy(inputs[0], oldPolicy)
+				if err != nil {
+					return err
+				}
+
+				policies[role] = policy
+			}
+
+			// Update the setting
+			BotReviewPolicies.Set(s, policies)
+
+			// Log the change
+			r.logBotSettingChange(context.Background(), s.db, UserID.Get(s),
+				key,
+				oldPolicy.String(),
+				s.BotSettings().PolicyForRole(role).String())
+
+			return nil
+		},
+	}
+}
+
 // logBotSettingChange is a helper method to handle logging changes to bot settings.
 func (r *SettingRegistry) logBotSettingChange(
 	ctx context.Context, db database.Client, reviewerID uint64, settingKey, oldValue, newValue string,
@@ -17,6 +17,7 @@ package session
 import (
 	"time"
 	
+	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
 )
 
@@ -77,6 +78,8 @@ func main() {
 		{Name: "ReviewMode", Type: "enum.ReviewMode", Doc: "ReviewMode sets the review mode"},
 		{Name: "ReviewTargetMode", Type: "enum.ReviewTargetMode", Doc: "ReviewTargetMode sets the review target mode"},
 		{Name: "ReviewerStatsPeriod", Type: "enum.ReviewerStatsPeriod", Doc: "ReviewerStatsPeriod sets the reviewer stats time period"},
+		{Name: "FilterPresets", Type: "[]types.ReviewFilterPreset", Doc: "FilterPresets stores saved review queue filters"},
+		{Name: "ActiveFilterPreset", Type: "string", Doc: "ActiveFilterPreset sets the name of the review filter preset in use"},
 
 		// Chat message usage settings
 		{Name: "ChatMessageUsage.FirstMessageTime", Type: "time.Time", Doc: "ChatMessageUsageFirstMessageTime tracks first message time in 24h period"},
@@ -97,6 +100,7 @@ func main() {
 		{Name: "AdminIDs", Type: "[]uint64", Doc: "AdminIDs stores authorized admin IDs"},
 		{Name: "SessionLimit", Type: "uint64", Doc: "SessionLimit sets maximum concurrent sessions"},
 		{Name: "WelcomeMessage", Type: "string", Doc: "WelcomeMessage sets the welcome message"},
+		{Name: "ReviewPolicies", Type: "types.ReviewPolicies", Doc: "ReviewPolicies sets the break and CAPTCHA policy of each reviewer role"},
 
 		// Announcement settings
 		{Name: "Announcement.Type", Type: "enum.AnnouncementType", Doc: "AnnouncementType sets the announcement type"},
@@ -4,6 +4,7 @@ package session
 import (
 	"time"
 
+	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
 )
 
@@ -65,6 +66,22 @@ var (
 		s.userSettingsUpdate = true
 	})
 
+	// FilterPresets stores saved review queue filters
+	UserFilterPresets = NewUserSettingKey("FilterPresets", func(s *Session) []types.ReviewFilterPreset {
+		return s.userSettings.FilterPresets
+	}, func(s *Session, value []types.ReviewFilterPreset) {
+		s.userSettings.FilterPresets = value
+		s.userSettingsUpdate = true
+	})
+
+	// ActiveFilterPreset sets the name of the review filter preset in use
+	UserActiveFilterPreset = NewUserSettingKey("ActiveFilterPreset", func(s *Session) string {
+		return s.userSettings.ActiveFilterPreset
+	}, func(s *Session, value string) {
+		s.userSettings.ActiveFilterPreset = value
+		s.userSettingsUpdate = true
+	})
+
 	// ChatMessageUsageFirstMessageTime tracks first message time in 24h period
 	UserChatMessageUsageFirstMessageTime = NewUserSettingKey("ChatMessageUsage.FirstMessageTime", func(s *Session) time.Time {
 		return s.userSettings.ChatMessageUsage.FirstMessageTime
@@ -147,6 +164,14 @@ var (
 		s.botSettingsUpdate = true
 	})
 
+	// ReviewPolicies sets the break and CAPTCHA policy of each reviewer role
+	BotReviewPolicies = NewBotSettingKey("ReviewPolicies", func(s *Session) types.ReviewPolicies {
+		return s.botSettings.ReviewPolicies
+	}, func(s *Session, value types.ReviewPolicies) {
+		s.botSettings.ReviewPolicies = value
+		s.botSettingsUpdate = true
+	})
+
 	// AnnouncementType sets the announcement type
 	BotAnnouncementType = NewBotSettingKey("Announcement.Type", func(s *Session) enum.AnnouncementType {
 		return s.botSettings.Announcement.Type
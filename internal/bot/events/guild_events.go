@@ -3,9 +3,8 @@ package events
 import (
 	"fmt"
 
-	"github.com/disgoorg/disgo/discord"
 	"github.com/disgoorg/disgo/events"
-	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/commands"
 	"go.uber.org/zap"
 )
 
@@ -39,12 +38,7 @@ func (h *GuildEventHandler) OnGuildJoin(event *events.GuildJoin) {
 // registerGuildCommands registers the bot's commands for a specific guild.
 func (h *GuildEventHandler) registerGuildCommands(event *events.GuildJoin) error {
 	_, err := event.Client().Rest.SetGuildCommands(event.Client().ApplicationID, event.Guild.ID,
-		[]discord.ApplicationCommandCreate{
-			discord.SlashCommandCreate{
-				Name:        constants.RotectorCommandName,
-				Description: "Open the moderation interface",
-			},
-		},
+		commands.Definitions(),
 	)
 	if err != nil {
 		return fmt.Errorf("failed to register guild commands: %w", err)
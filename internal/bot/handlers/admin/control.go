@@ -0,0 +1,160 @@
+package admin
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/interaction"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	builder "github.com/robalyx/rotector/internal/bot/views/admin"
+	"github.com/robalyx/rotector/internal/worker/core"
+	"go.uber.org/zap"
+)
+
+// ControlMenu handles pausing, draining and reconfiguring workers.
+type ControlMenu struct {
+	layout *Layout
+	page   *interaction.Page
+}
+
+// NewControlMenu creates a ControlMenu and sets up its page.
+func NewControlMenu(layout *Layout) *ControlMenu {
+	m := &ControlMenu{layout: layout}
+	m.page = &interaction.Page{
+		Name: constants.WorkerControlPageName,
+		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
+			return builder.NewControlBuilder(s).Build()
+		},
+		ShowHandlerFunc:   m.Show,
+		SelectHandlerFunc: m.handleSelectMenu,
+		ButtonHandlerFunc: m.handleButton,
+		ModalHandlerFunc:  m.handleModal,
+	}
+
+	return m
+}
+
+// Show prepares and displays the worker control state.
+func (m *ControlMenu) Show(ctx *interaction.Context, s *session.Session) {
+	states, err := m.layout.control.GetAllStates(ctx.Context())
+	if err != nil {
+		m.layout.logger.Error("Failed to get worker control states", zap.Error(err))
+		ctx.Error("Failed to retrieve worker control states. Please try again.")
+
+		return
+	}
+
+	if !core.IsWorkerType(session.AdminWorkerControlType.Get(s)) {
+		session.AdminWorkerControlType.Set(s, core.WorkerTypes[0])
+	}
+
+	session.AdminWorkerControls.Set(s, states)
+}
+
+// handleSelectMenu processes worker type selection.
+func (m *ControlMenu) handleSelectMenu(ctx *interaction.Context, s *session.Session, customID, option string) {
+	if customID != constants.WorkerControlTypeSelectMenuCustomID {
+		return
+	}
+
+	session.AdminWorkerControlType.Set(s, option)
+	ctx.Reload("")
+}
+
+// handleButton processes button interactions.
+func (m *ControlMenu) handleButton(ctx *interaction.Context, s *session.Session, customID string) {
+	workerType := session.AdminWorkerControlType.Get(s)
+	actor := strconv.FormatUint(uint64(ctx.Event().User().ID), 10)
+
+	var err error
+
+	switch customID {
+	case constants.BackButtonCustomID:
+		ctx.NavigateBack("")
+		return
+	case constants.RefreshButtonCustomID:
+		ctx.Reload("")
+		return
+	case constants.WorkerOverrideButtonCustomID:
+		m.handleOverrideModal(ctx, workerType)
+		return
+	case constants.WorkerPauseButtonCustomID:
+		err = m.layout.control.Pause(ctx.Context(), workerType, actor)
+	case constants.WorkerResumeButtonCustomID:
+		err = m.layout.control.Resume(ctx.Context(), workerType, actor)
+	case constants.WorkerDrainButtonCustomID:
+		err = m.layout.control.Drain(ctx.Context(), workerType, actor)
+	case constants.WorkerRunNowButtonCustomID:
+		err = m.layout.control.RunNow(ctx.Context(), workerType, actor)
+	case constants.WorkerClearOverridesButtonCustomID:
+		err = m.layout.control.ClearOverrides(ctx.Context(), workerType, actor)
+	default:
+		return
+	}
+
+	if err != nil {
+		m.layout.logger.Error("Failed to update worker control state",
+			zap.String("workerType", workerType),
+			zap.String("action", customID),
+			zap.Error(err))
+		ctx.Error("Failed to update worker control state. Please try again.")
+
+		return
+	}
+
+	ctx.Reload("Updated " + workerType + " workers")
+}
+
+// handleOverrideModal opens a modal for entering a config override.
+func (m *ControlMenu) handleOverrideModal(ctx *interaction.Context, workerType string) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.WorkerOverrideModalCustomID).
+		SetTitle("Override "+workerType+" Config").
+		AddLabel(
+			"Config Key",
+			discord.NewTextInput(constants.WorkerOverrideKeyInputID, discord.TextInputStyleShort).
+				WithRequired(true).
+				WithPlaceholder("e.g. batch_sizes.friend_users"),
+		).
+		AddLabel(
+			"Value",
+			discord.NewTextInput(constants.WorkerOverrideValueInputID, discord.TextInputStyleShort).
+				WithRequired(true).
+				WithPlaceholder("Enter a non-negative number..."),
+		)
+
+	ctx.Modal(modal)
+}
+
+// handleModal processes modal submissions.
+func (m *ControlMenu) handleModal(ctx *interaction.Context, s *session.Session) {
+	if ctx.Event().CustomID() != constants.WorkerOverrideModalCustomID {
+		return
+	}
+
+	data := ctx.Event().ModalData()
+	key := data.Text(constants.WorkerOverrideKeyInputID)
+	value := data.Text(constants.WorkerOverrideValueInputID)
+	workerType := session.AdminWorkerControlType.Get(s)
+	actor := strconv.FormatUint(uint64(ctx.Event().User().ID), 10)
+
+	err := m.layout.control.SetOverride(ctx.Context(), workerType, key, value, actor)
+	if errors.Is(err, core.ErrInvalidOverride) {
+		ctx.Cancel(err.Error())
+		return
+	}
+
+	if err != nil {
+		m.layout.logger.Error("Failed to set worker override",
+			zap.String("workerType", workerType),
+			zap.String("key", key),
+			zap.Error(err))
+		ctx.Error("Failed to set worker override. Please try again.")
+
+		return
+	}
+
+	ctx.Reload("Override saved for " + workerType + " workers")
+}
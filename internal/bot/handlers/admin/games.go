@@ -0,0 +1,74 @@
+package admin
+
+import (
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/interaction"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	builder "github.com/robalyx/rotector/internal/bot/views/admin"
+	"go.uber.org/zap"
+)
+
+// GamesMenu handles the display of suspected games.
+type GamesMenu struct {
+	layout *Layout
+	page   *interaction.Page
+}
+
+// NewGamesMenu creates a GamesMenu and sets up its page.
+func NewGamesMenu(layout *Layout) *GamesMenu {
+	m := &GamesMenu{layout: layout}
+	m.page = &interaction.Page{
+		Name: constants.SuspectedGamesPageName,
+		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
+			return builder.NewGamesBuilder(s).Build()
+		},
+		ShowHandlerFunc:   m.Show,
+		ButtonHandlerFunc: m.handleButton,
+	}
+
+	return m
+}
+
+// Show prepares and displays the suspected games list.
+func (m *GamesMenu) Show(ctx *interaction.Context, s *session.Session) {
+	page := session.PaginationPage.Get(s)
+	offset := page * constants.SuspectedGamesPerPage
+
+	games, total, err := m.layout.db.Model().Tracking().GetSuspectedGameList(
+		ctx.Context(), offset, constants.SuspectedGamesPerPage,
+	)
+	if err != nil {
+		m.layout.logger.Error("Failed to get suspected games", zap.Error(err))
+		ctx.Error("Failed to retrieve suspected games. Please try again.")
+
+		return
+	}
+
+	session.AdminSuspectedGames.Set(s, games)
+	session.PaginationOffset.Set(s, offset)
+	session.PaginationTotalItems.Set(s, total)
+	session.PaginationTotalPages.Set(s, max((total-1)/constants.SuspectedGamesPerPage, 0))
+}
+
+// handleButton processes button interactions.
+func (m *GamesMenu) handleButton(ctx *interaction.Context, s *session.Session, customID string) {
+	action := session.ViewerAction(customID)
+	switch action {
+	case session.ViewerFirstPage, session.ViewerPrevPage, session.ViewerNextPage, session.ViewerLastPage:
+		totalPages := session.PaginationTotalPages.Get(s)
+		page := action.ParsePageAction(s, totalPages)
+
+		session.PaginationPage.Set(s, page)
+		ctx.Reload("")
+
+		return
+	}
+
+	switch customID {
+	case constants.BackButtonCustomID:
+		ctx.NavigateBack("")
+	case constants.RefreshButtonCustomID:
+		ctx.Reload("")
+	}
+}
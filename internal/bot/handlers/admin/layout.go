@@ -1,35 +1,56 @@
 package admin
 
 import (
+	"github.com/robalyx/rotector/internal/bot/core/claim"
 	"github.com/robalyx/rotector/internal/bot/core/interaction"
 	"github.com/robalyx/rotector/internal/cloudflare"
 	"github.com/robalyx/rotector/internal/database"
+	"github.com/robalyx/rotector/internal/redis"
 	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/worker/core"
 	"go.uber.org/zap"
 )
 
 // Layout handles the admin menu and its submenus.
 type Layout struct {
-	db          database.Client
-	cfClient    *cloudflare.Client
-	logger      *zap.Logger
-	mainMenu    *MainMenu
-	confirmMenu *ConfirmMenu
+	db           database.Client
+	cfClient     *cloudflare.Client
+	control      *core.ControlClient
+	claims       *claim.Manager
+	logger       *zap.Logger
+	mainMenu     *MainMenu
+	confirmMenu  *ConfirmMenu
+	gamesMenu    *GamesMenu
+	controlMenu  *ControlMenu
+	workloadMenu *WorkloadMenu
+	rulesMenu    *RulesMenu
 }
 
 // New creates a Layout by initializing all admin menus and registering their
 // pages with the pagination manager.
 func New(app *setup.App) *Layout {
+	// Get Redis client for review claims
+	claimClient, err := app.RedisManager.GetClient(redis.SessionDBIndex)
+	if err != nil {
+		app.Logger.Fatal("Failed to get Redis client for review claims", zap.Error(err))
+	}
+
 	// Initialize layout
 	l := &Layout{
 		db:       app.DB,
 		cfClient: app.CFClient,
+		control:  core.NewControlClient(app.StatusClient, app.Logger.Named("worker_control")),
+		claims:   claim.NewManager(claimClient, app.Logger),
 		logger:   app.Logger.Named("admin_menu"),
 	}
 
 	// Initialize menus with reference to this layout
 	l.mainMenu = NewMainMenu(l)
 	l.confirmMenu = NewConfirmMenu(l)
+	l.gamesMenu = NewGamesMenu(l)
+	l.controlMenu = NewControlMenu(l)
+	l.workloadMenu = NewWorkloadMenu(l)
+	l.rulesMenu = NewRulesMenu(l)
 
 	return l
 }
@@ -39,5 +60,9 @@ func (l *Layout) Pages() []*interaction.Page {
 	return []*interaction.Page{
 		l.mainMenu.page,
 		l.confirmMenu.page,
+		l.gamesMenu.page,
+		l.controlMenu.page,
+		l.workloadMenu.page,
+		l.rulesMenu.page,
 	}
 }
@@ -32,10 +32,20 @@ func NewMainMenu(layout *Layout) *MainMenu {
 }
 
 // handleSelectMenu processes select menu interactions.
-func (m *MainMenu) handleSelectMenu(ctx *interaction.Context, _ *session.Session, _, option string) {
+func (m *MainMenu) handleSelectMenu(ctx *interaction.Context, s *session.Session, _, option string) {
 	switch option {
 	case constants.BotSettingsButtonCustomID:
 		ctx.Show(constants.BotSettingsPageName, "")
+	case constants.SuspectedGamesButtonCustomID:
+		session.PaginationPage.Set(s, 0)
+		ctx.Show(constants.SuspectedGamesPageName, "")
+	case constants.WorkerControlButtonCustomID:
+		ctx.Show(constants.WorkerControlPageName, "")
+	case constants.ReviewerWorkloadButtonCustomID:
+		ctx.Show(constants.ReviewerWorkloadPageName, "")
+	case constants.RuleSuggestionsButtonCustomID:
+		session.PaginationPage.Set(s, 0)
+		ctx.Show(constants.RuleSuggestionsPageName, "")
 	case constants.DeleteUserButtonCustomID:
 		m.handleDeleteUserModal(ctx)
 	case constants.DeleteGroupButtonCustomID:
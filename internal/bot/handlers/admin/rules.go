@@ -0,0 +1,155 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/interaction"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	builder "github.com/robalyx/rotector/internal/bot/views/admin"
+	"github.com/robalyx/rotector/internal/database/types"
+	"go.uber.org/zap"
+)
+
+// RulesMenu handles the approval queue of reviewer-suggested detection rules.
+type RulesMenu struct {
+	layout *Layout
+	page   *interaction.Page
+}
+
+// NewRulesMenu creates a RulesMenu and sets up its page.
+func NewRulesMenu(layout *Layout) *RulesMenu {
+	m := &RulesMenu{layout: layout}
+	m.page = &interaction.Page{
+		Name: constants.RuleSuggestionsPageName,
+		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
+			return builder.NewRulesBuilder(s).Build()
+		},
+		ShowHandlerFunc:   m.Show,
+		SelectHandlerFunc: m.handleSelectMenu,
+		ButtonHandlerFunc: m.handleButton,
+		CleanupHandlerFunc: func(s *session.Session) {
+			session.AdminRuleSuggestions.Delete(s)
+		},
+	}
+
+	return m
+}
+
+// Show prepares and displays the pending rule suggestions.
+func (m *RulesMenu) Show(ctx *interaction.Context, s *session.Session) {
+	page := session.PaginationPage.Get(s)
+	offset := page * constants.RuleSuggestionsPerPage
+
+	rules, total, err := m.layout.db.Service().Rule().GetPendingRules(
+		ctx.Context(), offset, constants.RuleSuggestionsPerPage,
+	)
+	if err != nil {
+		m.layout.logger.Error("Failed to get pending detection rules", zap.Error(err))
+		ctx.Error("Failed to retrieve rule suggestions. Please try again.")
+
+		return
+	}
+
+	// Step back if the last rule of the final page was decided
+	if len(rules) == 0 && page > 0 {
+		session.PaginationPage.Set(s, page-1)
+		m.Show(ctx, s)
+
+		return
+	}
+
+	session.AdminRuleSuggestions.Set(s, rules)
+	session.PaginationOffset.Set(s, offset)
+	session.PaginationTotalItems.Set(s, total)
+	session.PaginationTotalPages.Set(s, max((total-1)/constants.RuleSuggestionsPerPage, 0))
+}
+
+// handleSelectMenu processes the enable, reject and re-test actions on a rule.
+func (m *RulesMenu) handleSelectMenu(ctx *interaction.Context, s *session.Session, customID, option string) {
+	if customID != constants.RuleSuggestionSelectMenuCustomID {
+		return
+	}
+
+	action, rawID, found := strings.Cut(option, ":")
+	ruleID, err := strconv.ParseInt(rawID, 10, 64)
+	if !found || err != nil {
+		ctx.Error("Invalid rule selected.")
+		return
+	}
+
+	var rule *types.DetectionRule
+	for _, pending := range session.AdminRuleSuggestions.Get(s) {
+		if pending.ID == ruleID {
+			rule = pending
+			break
+		}
+	}
+
+	if rule == nil {
+		ctx.Cancel("This rule is no longer on the current page.")
+		return
+	}
+
+	adminID := uint64(ctx.Event().User().ID)
+
+	switch action {
+	case constants.RuleActionRetest:
+		if err := m.layout.db.Service().Rule().Backtest(ctx.Context(), rule); err != nil {
+			m.layout.logger.Error("Failed to back-test detection rule", zap.Error(err), zap.Int64("ruleID", ruleID))
+			ctx.Error("Failed to back-test the rule. Please try again.")
+
+			return
+		}
+
+		ctx.Reload(fmt.Sprintf("Rule #%d matches %d flagged and %d cleared targets.",
+			rule.ID, rule.FlaggedHits, rule.ClearedHits))
+	case constants.RuleActionEnable, constants.RuleActionReject:
+		enable := action == constants.RuleActionEnable
+
+		if err := m.layout.db.Service().Rule().ReviewRule(ctx.Context(), ruleID, enable, adminID); err != nil {
+			if errors.Is(err, types.ErrRuleNotPending) {
+				ctx.Reload("This rule was already reviewed by another admin.")
+				return
+			}
+
+			m.layout.logger.Error("Failed to review detection rule", zap.Error(err), zap.Int64("ruleID", ruleID))
+			ctx.Error("Failed to review the rule. Please try again.")
+
+			return
+		}
+
+		verb := "Rejected"
+		if enable {
+			verb = "Enabled"
+		}
+
+		ctx.Reload(fmt.Sprintf("%s rule #%d for the %s scope.", verb, rule.ID, rule.Scope))
+	}
+}
+
+// handleButton processes button interactions.
+func (m *RulesMenu) handleButton(ctx *interaction.Context, s *session.Session, customID string) {
+	action := session.ViewerAction(customID)
+	switch action {
+	case session.ViewerFirstPage, session.ViewerPrevPage, session.ViewerNextPage, session.ViewerLastPage:
+		totalPages := session.PaginationTotalPages.Get(s)
+		page := action.ParsePageAction(s, totalPages)
+
+		session.PaginationPage.Set(s, page)
+		ctx.Reload("")
+
+		return
+	}
+
+	switch customID {
+	case constants.BackButtonCustomID:
+		ctx.NavigateBack("")
+	case constants.RefreshButtonCustomID:
+		ctx.Reload("")
+	}
+}
@@ -0,0 +1,262 @@
+package admin
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/interaction"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	builder "github.com/robalyx/rotector/internal/bot/views/admin"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+	"go.uber.org/zap"
+)
+
+// WorkloadMenu handles the reviewer workload view and category and cluster assignments.
+type WorkloadMenu struct {
+	layout *Layout
+	page   *interaction.Page
+}
+
+// NewWorkloadMenu creates a WorkloadMenu and sets up its page.
+func NewWorkloadMenu(layout *Layout) *WorkloadMenu {
+	m := &WorkloadMenu{layout: layout}
+	m.page = &interaction.Page{
+		Name: constants.ReviewerWorkloadPageName,
+		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
+			return builder.NewWorkloadBuilder(s).Build()
+		},
+		ShowHandlerFunc:   m.Show,
+		SelectHandlerFunc: m.handleSelectMenu,
+		ButtonHandlerFunc: m.handleButton,
+		ModalHandlerFunc:  m.handleModal,
+	}
+
+	return m
+}
+
+// Show prepares and displays the assignments and active claims of each reviewer.
+func (m *WorkloadMenu) Show(ctx *interaction.Context, s *session.Session) {
+	reviewerIDs := s.BotSettings().ReviewerIDs
+
+	reviewerInfos, err := m.layout.db.Model().Reviewer().GetReviewerInfos(ctx.Context(), reviewerIDs)
+	if err != nil {
+		m.layout.logger.Error("Failed to get reviewer infos", zap.Error(err))
+		ctx.Error("Failed to retrieve reviewer information. Please try again.")
+
+		return
+	}
+
+	assignments, err := m.layout.db.Service().Reviewer().GetAssignments(ctx.Context())
+	if err != nil {
+		m.layout.logger.Error("Failed to get reviewer assignments", zap.Error(err))
+		ctx.Error("Failed to retrieve reviewer assignments. Please try again.")
+
+		return
+	}
+
+	clusters, err := m.layout.db.Service().Reviewer().GetClusterAssignments(ctx.Context())
+	if err != nil {
+		m.layout.logger.Error("Failed to get reviewer cluster assignments", zap.Error(err))
+		ctx.Error("Failed to retrieve reviewer assignments. Please try again.")
+
+		return
+	}
+
+	pendingCounts, err := m.layout.db.Model().User().GetFlaggedCountsByCategory(ctx.Context())
+	if err != nil {
+		m.layout.logger.Error("Failed to get flagged counts by category", zap.Error(err))
+		ctx.Error("Failed to retrieve pending counts. Please try again.")
+
+		return
+	}
+
+	userClaims, err := m.layout.claims.GetClaims(ctx.Context(), types.DecisionTargetUser)
+	if err != nil {
+		m.layout.logger.Error("Failed to get user claims", zap.Error(err))
+
+		userClaims = map[int64]uint64{} // Continue without claims - not critical
+	}
+
+	groupClaims, err := m.layout.claims.GetClaims(ctx.Context(), types.DecisionTargetGroup)
+	if err != nil {
+		m.layout.logger.Error("Failed to get group claims", zap.Error(err))
+
+		groupClaims = map[int64]uint64{} // Continue without claims - not critical
+	}
+
+	// Build the workload of each reviewer
+	workloads := make([]*types.ReviewerWorkload, 0, len(reviewerIDs))
+	for _, reviewerID := range reviewerIDs {
+		workload := &types.ReviewerWorkload{
+			ReviewerID: reviewerID,
+			Username:   "Unknown",
+			Categories: assignments[reviewerID],
+			Clusters:   clusters[reviewerID],
+		}
+
+		if info, exists := reviewerInfos[reviewerID]; exists {
+			workload.Username = info.Username
+		}
+
+		slices.Sort(workload.Categories)
+
+		for _, category := range workload.Categories {
+			workload.PendingUsers += pendingCounts[category]
+		}
+
+		for targetID, owner := range userClaims {
+			if owner == reviewerID {
+				workload.ClaimedUserID = targetID
+			}
+		}
+
+		for targetID, owner := range groupClaims {
+			if owner == reviewerID {
+				workload.ClaimedGroupID = targetID
+			}
+		}
+
+		workloads = append(workloads, workload)
+	}
+
+	session.AdminReviewerWorkloads.Set(s, workloads)
+}
+
+// handleSelectMenu opens the assignment modal for the selected reviewer.
+func (m *WorkloadMenu) handleSelectMenu(ctx *interaction.Context, s *session.Session, customID, option string) {
+	if customID != constants.WorkloadReviewerSelectMenuCustomID {
+		return
+	}
+
+	reviewerID, err := strconv.ParseUint(option, 10, 64)
+	if err != nil {
+		ctx.Error("Invalid reviewer ID.")
+		return
+	}
+
+	var (
+		current         []enum.UserCategoryType
+		currentClusters []string
+	)
+
+	for _, workload := range session.AdminReviewerWorkloads.Get(s) {
+		if workload.ReviewerID == reviewerID {
+			current = workload.Categories
+
+			for _, groupID := range workload.Clusters {
+				currentClusters = append(currentClusters, strconv.FormatInt(groupID, 10))
+			}
+		}
+	}
+
+	session.AdminWorkloadReviewerID.Set(s, reviewerID)
+
+	// Create category options with the current assignments selected
+	categories := enum.UserCategoryTypeValues()
+	options := make([]discord.StringSelectMenuOption, 0, len(categories))
+
+	for _, category := range categories {
+		options = append(options,
+			discord.NewStringSelectMenuOption(category.String(), strconv.Itoa(int(category))).
+				WithDefault(slices.Contains(current, category)))
+	}
+
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.WorkloadAssignModalCustomID).
+		SetTitle("Assign Categories and Clusters").
+		AddLabel(
+			"Categories",
+			discord.NewStringSelectMenu(constants.WorkloadCategoriesSelectCustomID, "Select categories", options...).
+				WithMinValues(0).
+				WithMaxValues(len(options)).
+				WithRequired(false),
+		).
+		AddLabel(
+			"Clusters",
+			discord.NewTextInput(constants.WorkloadClustersInputCustomID, discord.TextInputStyleShort).
+				WithRequired(false).
+				WithPlaceholder("Group IDs whose members to assign, separated by commas").
+				WithValue(strings.Join(currentClusters, ", ")),
+		)
+
+	ctx.Modal(modal)
+}
+
+// handleButton processes button interactions.
+func (m *WorkloadMenu) handleButton(ctx *interaction.Context, _ *session.Session, customID string) {
+	switch customID {
+	case constants.BackButtonCustomID:
+		ctx.NavigateBack("")
+	case constants.RefreshButtonCustomID:
+		ctx.Reload("")
+	}
+}
+
+// handleModal saves the category and cluster assignments of the selected reviewer.
+func (m *WorkloadMenu) handleModal(ctx *interaction.Context, s *session.Session) {
+	if ctx.Event().CustomID() != constants.WorkloadAssignModalCustomID {
+		return
+	}
+
+	data := ctx.Event().ModalData()
+	values := data.StringValues(constants.WorkloadCategoriesSelectCustomID)
+
+	categories := make([]enum.UserCategoryType, 0, len(values))
+	for _, value := range values {
+		category, err := strconv.Atoi(value)
+		if err != nil {
+			ctx.Error("Invalid category selected.")
+			return
+		}
+
+		categories = append(categories, enum.UserCategoryType(category))
+	}
+
+	var groupIDs []int64
+
+	for field := range strings.SplitSeq(data.Text(constants.WorkloadClustersInputCustomID), ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+
+		groupID, err := strconv.ParseInt(field, 10, 64)
+		if err != nil || groupID <= 0 {
+			ctx.Cancel("Invalid group ID: " + field)
+			return
+		}
+
+		if !slices.Contains(groupIDs, groupID) {
+			groupIDs = append(groupIDs, groupID)
+		}
+	}
+
+	reviewerID := session.AdminWorkloadReviewerID.Get(s)
+	adminID := uint64(ctx.Event().User().ID)
+
+	err := m.layout.db.Service().Reviewer().SetAssignments(ctx.Context(), reviewerID, categories, adminID)
+	if err != nil {
+		m.layout.logger.Error("Failed to set reviewer assignments",
+			zap.Uint64("reviewerID", reviewerID),
+			zap.Error(err))
+		ctx.Error("Failed to save reviewer assignments. Please try again.")
+
+		return
+	}
+
+	err = m.layout.db.Service().Reviewer().SetClusterAssignments(ctx.Context(), reviewerID, groupIDs, adminID)
+	if err != nil {
+		m.layout.logger.Error("Failed to set reviewer cluster assignments",
+			zap.Uint64("reviewerID", reviewerID),
+			zap.Error(err))
+		ctx.Error("Failed to save reviewer cluster assignments. Please try again.")
+
+		return
+	}
+
+	ctx.Reload("Updated category and cluster assignments")
+}
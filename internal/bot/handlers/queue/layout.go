@@ -11,24 +11,28 @@ import (
 
 // Layout handles the display and interaction logic for the queue menu.
 type Layout struct {
-	db               database.Client
-	cfClient         *cloudflare.Client
-	userFetcher      *fetcher.UserFetcher
-	groupFetcher     *fetcher.GroupFetcher
-	thumbnailFetcher *fetcher.ThumbnailFetcher
-	menu             *Menu
-	logger           *zap.Logger
+	db                   database.Client
+	cfClient             *cloudflare.Client
+	userFetcher          *fetcher.UserFetcher
+	groupFetcher         *fetcher.GroupFetcher
+	groupMemberFetcher   *fetcher.GroupMemberFetcher
+	thumbnailFetcher     *fetcher.ThumbnailFetcher
+	maxGroupMembersTrack int64
+	menu                 *Menu
+	logger               *zap.Logger
 }
 
 // New creates a Layout by initializing the queue menu.
 func New(app *setup.App) *Layout {
 	l := &Layout{
-		db:               app.DB,
-		cfClient:         app.CFClient,
-		userFetcher:      fetcher.NewUserFetcher(app, app.Logger),
-		groupFetcher:     fetcher.NewGroupFetcher(app.RoAPI, app.Logger),
-		thumbnailFetcher: fetcher.NewThumbnailFetcher(app.RoAPI, app.Logger),
-		logger:           app.Logger.Named("queue_menu"),
+		db:                   app.DB,
+		cfClient:             app.CFClient,
+		userFetcher:          fetcher.NewUserFetcher(app, app.Logger),
+		groupFetcher:         fetcher.NewGroupFetcher(app.RoAPI, app.Logger),
+		groupMemberFetcher:   fetcher.NewGroupMemberFetcher(app.RoAPI, app.Logger),
+		thumbnailFetcher:     fetcher.NewThumbnailFetcher(app.RoAPI, app.Logger),
+		maxGroupMembersTrack: app.Config.Worker.ThresholdLimits.MaxGroupMembersTrack,
+		logger:               app.Logger.Named("queue_menu"),
 	}
 	l.menu = NewMenu(l)
 
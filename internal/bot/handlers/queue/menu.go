@@ -3,6 +3,8 @@ package queue
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -16,10 +18,16 @@ import (
 	"github.com/robalyx/rotector/internal/cloudflare/manager"
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
+	"github.com/robalyx/rotector/internal/roblox/fetcher"
 	"github.com/robalyx/rotector/pkg/utils"
 	"go.uber.org/zap"
 )
 
+// maxQueueGroupMembers caps the members queued from the bot in one request, including allies,
+// since the members are enumerated and queued while the reviewer waits. Larger groups
+// can be imported with the queue CLI.
+const maxQueueGroupMembers = 2000
+
 var (
 	// ErrEmptyLine indicates an empty line was provided.
 	ErrEmptyLine = errors.New("empty line")
@@ -89,6 +97,42 @@ func (m *Menu) handleQueueUser(ctx *interaction.Context) {
 	ctx.Modal(modal)
 }
 
+// handleQueueGroup opens a modal for entering a Roblox group whose members should be queued.
+func (m *Menu) handleQueueGroup(ctx *interaction.Context) {
+	priorityOptions := make([]discord.StringSelectMenuOption, 0, len(manager.QueuePriorities))
+	for _, priority := range manager.QueuePriorities {
+		value := string(priority)
+		priorityOptions = append(priorityOptions,
+			discord.NewStringSelectMenuOption(strings.ToUpper(value[:1])+value[1:], value).
+				WithDefault(priority == manager.QueuePriorityBulk))
+	}
+
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.QueueGroupModalCustomID).
+		SetTitle("Queue Group Members").
+		AddLabel(
+			"Group ID",
+			discord.NewTextInput(constants.QueueGroupInputCustomID, discord.TextInputStyleShort).
+				WithRequired(true).
+				WithPlaceholder("Enter the Roblox group ID or URL..."),
+		).
+		AddLabel(
+			"Allied Groups",
+			discord.NewStringSelectMenu(constants.QueueGroupAlliesSelectCustomID, "Include allied groups?",
+				discord.NewStringSelectMenuOption("Group only", "no").WithDefault(true),
+				discord.NewStringSelectMenuOption(
+					fmt.Sprintf("Include up to %d allies", fetcher.MaxAlliesExpanded), "yes"),
+			),
+		).
+		AddLabel(
+			"Priority",
+			discord.NewStringSelectMenu(constants.QueueGroupPrioritySelectCustomID, "Queue priority",
+				priorityOptions...),
+		)
+
+	ctx.Modal(modal)
+}
+
 // handleManualUserReview opens a modal for entering a Roblox user ID to manually review.
 func (m *Menu) handleManualUserReview(ctx *interaction.Context) {
 	modal := discord.NewModalCreateBuilder().
@@ -124,6 +168,8 @@ func (m *Menu) handleModal(ctx *interaction.Context, s *session.Session) {
 	switch ctx.Event().CustomID() {
 	case constants.QueueUserModalCustomID:
 		m.handleQueueUserModalSubmit(ctx, s)
+	case constants.QueueGroupModalCustomID:
+		m.handleQueueGroupModalSubmit(ctx, s)
 	case constants.ManualUserReviewModalCustomID:
 		m.handleManualUserReviewModalSubmit(ctx, s)
 	case constants.ManualGroupReviewModalCustomID:
@@ -235,6 +281,174 @@ func (m *Menu) handleQueueUserModalSubmit(ctx *interaction.Context, s *session.S
 	ctx.Reload(msg.String())
 }
 
+// handleQueueGroupModalSubmit expands the group's members, and optionally its allies,
+// and queues them at the chosen priority with the group they were found in.
+func (m *Menu) handleQueueGroupModalSubmit(ctx *interaction.Context, s *session.Session) {
+	if !s.BotSettings().IsAdmin(uint64(ctx.Event().User().ID)) {
+		ctx.Error("You do not have permission to perform this action.")
+		return
+	}
+
+	data := ctx.Event().ModalData()
+
+	// Parse group URL if provided
+	groupIDStr := strings.TrimSpace(data.Text(constants.QueueGroupInputCustomID))
+	if parsedURL, err := utils.ExtractGroupIDFromURL(groupIDStr); err == nil {
+		groupIDStr = parsedURL
+	}
+
+	groupID, err := strconv.ParseInt(groupIDStr, 10, 64)
+	if err != nil || groupID <= 0 {
+		ctx.Cancel("Please provide a valid group ID.")
+		return
+	}
+
+	includeAllies := slices.Contains(data.StringValues(constants.QueueGroupAlliesSelectCustomID), "yes")
+
+	priority := manager.QueuePriorityBulk
+	if values := data.StringValues(constants.QueueGroupPrioritySelectCustomID); len(values) > 0 {
+		priority = manager.QueuePriority(values[0])
+	}
+
+	// Enumerate the members, capped so the request finishes while the reviewer waits
+	maxMembers := int64(maxQueueGroupMembers)
+	if m.layout.maxGroupMembersTrack > 0 {
+		maxMembers = min(m.layout.maxGroupMembersTrack, maxMembers)
+	}
+
+	expansion, err := m.layout.groupMemberFetcher.Expand(
+		ctx.Context(), groupID, includeAllies, m.layout.maxGroupMembersTrack, maxQueueGroupMembers,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, fetcher.ErrGroupTooLarge):
+			ctx.Cancel(fmt.Sprintf(
+				"Group has more than %d members and cannot be queued here. Use the queue CLI instead.", maxMembers))
+		case errors.Is(err, fetcher.ErrGroupLocked):
+			ctx.Cancel("Group is locked and its members cannot be listed.")
+		default:
+			m.layout.logger.Error("Failed to expand group members", zap.Error(err), zap.Int64("groupID", groupID))
+			ctx.Error("Failed to fetch group members. Please try again.")
+		}
+
+		return
+	}
+
+	userIDs := slices.Sorted(maps.Keys(expansion.Members))
+
+	// Queue members in batches
+	var queuedCount, existingCount, recentCount, failedCount int
+
+	for i := 0; i < len(userIDs); i += manager.MaxQueueBatchSize {
+		end := min(i+manager.MaxQueueBatchSize, len(userIDs))
+		batch := userIDs[i:end]
+
+		queued, existing, recent, failed, err := m.queueGroupBatch(ctx, batch, priority, expansion.Members)
+		if err != nil {
+			m.layout.logger.Error("Failed to queue group members",
+				zap.Error(err),
+				zap.Int64("groupID", groupID),
+				zap.Int("queued", queuedCount))
+			ctx.Error(fmt.Sprintf("Failed to queue group members after queueing %d. Please try again.", queuedCount))
+
+			return
+		}
+
+		queuedCount += queued
+		existingCount += existing
+		recentCount += recent
+		failedCount += failed
+	}
+
+	// Log the group queue action
+	m.layout.db.Model().Activity().Log(ctx.Context(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			GroupID: groupID,
+		},
+		ReviewerID:        uint64(ctx.Event().User().ID),
+		ActivityType:      enum.ActivityTypeGroupQueued,
+		ActivityTimestamp: time.Now(),
+		Details: map[string]any{
+			"members":  len(userIDs),
+			"queued":   queuedCount,
+			"groups":   expansion.Groups,
+			"priority": string(priority),
+		},
+	})
+
+	// Get updated queue stats
+	stats, err := m.layout.cfClient.Queue.GetStats(ctx.Context())
+	if err != nil {
+		m.layout.logger.Error("Failed to get queue stats", zap.Error(err))
+	} else {
+		session.QueueStats.Set(s, stats)
+	}
+
+	// Build response message
+	var msg strings.Builder
+
+	msg.WriteString(fmt.Sprintf("Queued %d of %d members from %d group(s) at %s priority. ",
+		queuedCount, len(userIDs), len(expansion.Groups), priority))
+
+	if existingCount > 0 || recentCount > 0 {
+		msg.WriteString(fmt.Sprintf("Skipped %d already in the system and %d recently queued. ", existingCount, recentCount))
+	}
+
+	if failedCount > 0 {
+		msg.WriteString(fmt.Sprintf("Failed to queue %d. ", failedCount))
+	}
+
+	if len(expansion.Skipped) > 0 {
+		msg.WriteString(fmt.Sprintf(
+			"Skipped %d allied group(s) that were too large, locked or over the %d member limit.",
+			len(expansion.Skipped), maxQueueGroupMembers))
+	}
+
+	ctx.Reload(msg.String())
+}
+
+// queueGroupBatch queues a batch of group members that are not already in the system.
+// Returns the number of users queued, already in the system, recently queued and failed.
+func (m *Menu) queueGroupBatch(
+	ctx *interaction.Context, batch []int64, priority manager.QueuePriority, sourceGroups map[int64]int64,
+) (int, int, int, int, error) {
+	existingUsers, err := m.layout.db.Service().User().GetUsersByIDs(ctx.Context(), batch, types.UserFieldID)
+	if err != nil {
+		return 0, 0, 0, 0, fmt.Errorf("failed to check existing users in database: %w", err)
+	}
+
+	usersToQueue := make([]int64, 0, len(batch))
+
+	for _, userID := range batch {
+		if _, exists := existingUsers[userID]; !exists {
+			usersToQueue = append(usersToQueue, userID)
+		}
+	}
+
+	existingCount := len(batch) - len(usersToQueue)
+	if len(usersToQueue) == 0 {
+		return 0, existingCount, 0, 0, nil
+	}
+
+	queueErrors, err := m.layout.cfClient.Queue.AddUsersFromGroups(ctx.Context(), usersToQueue, priority, sourceGroups)
+	if err != nil {
+		m.layout.logger.Error("Failed to queue group batch", zap.Error(err))
+		return 0, existingCount, 0, len(usersToQueue), nil
+	}
+
+	var recentCount, failedCount int
+
+	for _, queueErr := range queueErrors {
+		if errors.Is(queueErr, manager.ErrUserRecentlyQueued) {
+			recentCount++
+		} else {
+			failedCount++
+		}
+	}
+
+	return len(usersToQueue) - len(queueErrors), existingCount, recentCount, failedCount, nil
+}
+
 // handleManualUserReviewModalSubmit processes the user ID input and adds the user to the review system.
 func (m *Menu) handleManualUserReviewModalSubmit(ctx *interaction.Context, s *session.Session) {
 	// Get the user ID input
@@ -438,7 +652,7 @@ func (m *Menu) handleButton(ctx *interaction.Context, s *session.Session, custom
 		m.handleQueueUser(ctx)
 	case constants.ManualUserReviewButtonCustomID:
 		m.handleManualUserReview(ctx)
-	case constants.ManualGroupReviewButtonCustomID:
+	case constants.ManualGroupReviewButtonCustomID, constants.QueueGroupButtonCustomID:
 		if !s.BotSettings().IsAdmin(uint64(ctx.Event().User().ID)) {
 			m.layout.logger.Error("Non-admin attempted restricted action",
 				zap.Uint64("userID", uint64(ctx.Event().User().ID)),
@@ -448,6 +662,11 @@ func (m *Menu) handleButton(ctx *interaction.Context, s *session.Session, custom
 			return
 		}
 
+		if customID == constants.QueueGroupButtonCustomID {
+			m.handleQueueGroup(ctx)
+			return
+		}
+
 		m.handleManualGroupReview(ctx)
 	case constants.ReviewQueuedUserButtonCustomID:
 		m.handleReviewQueuedUser(ctx, s)
@@ -600,7 +819,7 @@ func (m *Menu) queueUserBatch(ctx *interaction.Context, batch []int64) (int, int
 	queueErrors := make(map[int64]error)
 
 	if len(usersToQueue) > 0 {
-		queueErrors, err = m.layout.cfClient.Queue.AddUsers(ctx.Context(), usersToQueue)
+		queueErrors, err = m.layout.cfClient.Queue.AddUsers(ctx.Context(), usersToQueue, manager.QueuePriorityReviewer)
 		if err != nil {
 			m.layout.logger.Error("Failed to queue batch", zap.Error(err))
 
@@ -4,11 +4,13 @@ import (
 	"github.com/jaxron/roapi.go/pkg/api"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/captcha"
+	"github.com/robalyx/rotector/internal/bot/core/claim"
 	"github.com/robalyx/rotector/internal/bot/core/interaction"
 	"github.com/robalyx/rotector/internal/bot/handlers/review/shared"
 	sharedView "github.com/robalyx/rotector/internal/bot/views/review/shared"
 	"github.com/robalyx/rotector/internal/cloudflare"
 	"github.com/robalyx/rotector/internal/database"
+	"github.com/robalyx/rotector/internal/redis"
 	"github.com/robalyx/rotector/internal/roblox/fetcher"
 	"github.com/robalyx/rotector/internal/setup"
 	"go.uber.org/zap"
@@ -26,11 +28,18 @@ type Layout struct {
 	presenceFetcher  *fetcher.PresenceFetcher
 	imageStreamer    *interaction.ImageStreamer
 	captcha          *captcha.Manager
+	claims           *claim.Manager
 	logger           *zap.Logger
 }
 
 // New creates a Layout by initializing all review menus.
 func New(app *setup.App, interactionManager *interaction.Manager) *Layout {
+	// Get Redis client for review claims
+	claimClient, err := app.RedisManager.GetClient(redis.SessionDBIndex)
+	if err != nil {
+		app.Logger.Fatal("Failed to get Redis client for review claims", zap.Error(err))
+	}
+
 	// Initialize layout
 	l := &Layout{
 		db:               app.DB,
@@ -40,6 +49,7 @@ func New(app *setup.App, interactionManager *interaction.Manager) *Layout {
 		presenceFetcher:  fetcher.NewPresenceFetcher(app.RoAPI, app.Logger),
 		imageStreamer:    interaction.NewImageStreamer(interactionManager, app.Logger, app.RoAPI.GetClient()),
 		captcha:          captcha.NewManager(app.DB, app.Logger),
+		claims:           claim.NewManager(claimClient, app.Logger),
 		logger:           app.Logger.Named("group_menu"),
 	}
 
@@ -3,6 +3,7 @@ package group
 import (
 	"errors"
 	"fmt"
+	"maps"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/robalyx/rotector/internal/bot/handlers/review/shared"
 	view "github.com/robalyx/rotector/internal/bot/views/review/group"
 	viewShared "github.com/robalyx/rotector/internal/bot/views/review/shared"
+	"github.com/robalyx/rotector/internal/database/service"
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
 	"github.com/robalyx/rotector/pkg/utils"
@@ -34,7 +36,7 @@ type ReviewMenu struct {
 // NewReviewMenu creates a new review menu.
 func NewReviewMenu(layout *Layout) *ReviewMenu {
 	m := &ReviewMenu{
-		BaseReviewMenu: *shared.NewBaseReviewMenu(layout.logger, layout.captcha, layout.db),
+		BaseReviewMenu: *shared.NewBaseReviewMenu(layout.logger, layout.captcha, layout.claims, layout.db),
 		layout:         layout,
 	}
 	m.page = &interaction.Page{
@@ -53,6 +55,9 @@ func NewReviewMenu(layout *Layout) *ReviewMenu {
 
 // Show prepares and displays the review interface.
 func (m *ReviewMenu) Show(ctx *interaction.Context, s *session.Session) {
+	// Force training mode if the reviewer's accuracy on gold items is too low
+	m.EnforceAccuracy(ctx, s)
+
 	// If no group is set in session, fetch a new one
 	group := session.GroupTarget.Get(s)
 	if group == nil {
@@ -122,6 +127,25 @@ func (m *ReviewMenu) Show(ctx *interaction.Context, s *session.Session) {
 	}
 
 	session.ReviewComments.Set(s, comments)
+
+	// Fetch the open consensus decision for the group
+	m.LoadPendingDecision(ctx, s, types.DecisionTargetGroup, group.ID)
+
+	// Fetch the gold item of the group for admins
+	m.LoadGoldItem(ctx, s, types.DecisionTargetGroup, group.ID)
+
+	// Fetch the latest decision for undo
+	change, err := m.layout.db.Model().Group().GetLatestStatusChange(ctx.Context(), group.ID)
+	if err != nil && !errors.Is(err, types.ErrStatusChangeNotFound) {
+		m.layout.logger.Error("Failed to fetch latest status change", zap.Error(err))
+	}
+
+	if change == nil {
+		session.GroupLatestStatusChange.Delete(s)
+		return
+	}
+
+	session.GroupLatestStatusChange.Set(s, change)
 }
 
 // handleSelectMenu processes select menu interactions.
@@ -133,6 +157,8 @@ func (m *ReviewMenu) handleSelectMenu(ctx *interaction.Context, s *session.Sessi
 	switch customID {
 	case constants.SortOrderSelectMenuCustomID:
 		m.handleSortOrderSelection(ctx, s, option)
+	case constants.FilterPresetSelectMenuCustomID:
+		m.HandleFilterPresetSelection(ctx, s, option, viewShared.TargetTypeGroup)
 	case constants.ActionSelectMenuCustomID:
 		m.handleActionSelection(ctx, s, option)
 	case constants.ReasonSelectMenuCustomID:
@@ -165,7 +191,9 @@ func (m *ReviewMenu) handleActionSelection(ctx *interaction.Context, s *session.
 	// Check reviewer-only options
 	switch option {
 	case constants.GroupViewLogsButtonCustomID,
-		constants.ReviewModeOption:
+		constants.SuggestRuleButtonCustomID,
+		constants.ReviewModeOption,
+		constants.UndoDecisionButtonCustomID:
 		if !isReviewer {
 			m.layout.logger.Error("Non-reviewer attempted restricted action",
 				zap.Uint64("userID", userID),
@@ -184,6 +212,12 @@ func (m *ReviewMenu) handleActionSelection(ctx *interaction.Context, s *session.
 		}
 	}
 
+	// Handle gold item options
+	if strings.HasPrefix(option, constants.GoldMarkOptionPrefix) || option == constants.GoldRemoveButtonCustomID {
+		m.HandleGoldOption(ctx, s, types.DecisionTargetGroup, session.GroupTarget.Get(s).ID, option)
+		return
+	}
+
 	// Process selected option
 	switch option {
 	case constants.GroupViewMembersButtonCustomID:
@@ -198,8 +232,12 @@ func (m *ReviewMenu) handleActionSelection(ctx *interaction.Context, s *session.
 		m.HandleDeleteComment(ctx, s, viewShared.TargetTypeGroup)
 	case constants.GroupViewLogsButtonCustomID:
 		m.handleViewGroupLogs(ctx, s)
+	case constants.SuggestRuleButtonCustomID:
+		m.HandleSuggestRule(ctx, s, viewShared.TargetTypeGroup)
 	case constants.GroupDeleteButtonCustomID:
 		m.handleDeleteGroup(ctx, s)
+	case constants.UndoDecisionButtonCustomID:
+		m.handleUndoDecision(ctx, s)
 	case constants.ReviewModeOption:
 		session.SettingType.Set(s, constants.UserSettingPrefix)
 		session.SettingCustomID.Set(s, constants.ReviewModeOption)
@@ -253,6 +291,10 @@ func (m *ReviewMenu) handleModal(ctx *interaction.Context, s *session.Session) {
 		)
 	case constants.AddCommentModalCustomID:
 		m.HandleCommentModalSubmit(ctx, s, viewShared.TargetTypeGroup)
+	case constants.FilterPresetModalCustomID:
+		m.HandleFilterPresetModalSubmit(ctx, s, viewShared.TargetTypeGroup)
+	case constants.SuggestRuleModalCustomID:
+		m.HandleSuggestRuleModalSubmit(ctx, s, viewShared.TargetTypeGroup)
 	}
 }
 
@@ -392,6 +434,28 @@ func (m *ReviewMenu) handleConfirmGroup(ctx *interaction.Context, s *session.Ses
 		return
 	}
 
+	// Grade the answer without applying it if the group is a gold item
+	if m.RecordGoldAnswer(ctx, s, types.DecisionTargetGroup, group.ID, types.DecisionActionConfirm) {
+		m.UpdateCounters(s)
+		m.navigateAfterAction(ctx, s, "Group confirmed.")
+
+		return
+	}
+
+	// Record the vote if the decision needs a second reviewer
+	consensus := m.layout.db.Service().Consensus()
+
+	vote := m.CastVote(ctx, s, types.DecisionTargetGroup, group.ID,
+		consensus.GroupConsensusReason(group), types.DecisionActionConfirm)
+	if vote == nil {
+		return
+	}
+
+	if !vote.ShouldApply() {
+		m.handleDecisionVote(ctx, s, group, vote)
+		return
+	}
+
 	// Confirm the group
 	if err := m.layout.db.Service().Group().ConfirmGroup(ctx.Context(), group, reviewerID); err != nil {
 		m.layout.logger.Error("Failed to confirm group", zap.Error(err))
@@ -411,7 +475,12 @@ func (m *ReviewMenu) handleConfirmGroup(ctx *interaction.Context, s *session.Ses
 			zap.Int64("groupID", group.ID))
 	}
 
-	// Log the confirm action
+	// Log the confirm action along with any consensus votes
+	details := map[string]any{
+		"reasons": group.Reasons.Messages(),
+	}
+	maps.Copy(details, vote.LogDetails())
+
 	m.layout.db.Model().Activity().Log(ctx.Context(), &types.ActivityLog{
 		ActivityTarget: types.ActivityTarget{
 			GroupID: group.ID,
@@ -419,9 +488,7 @@ func (m *ReviewMenu) handleConfirmGroup(ctx *interaction.Context, s *session.Ses
 		ReviewerID:        reviewerID,
 		ActivityType:      enum.ActivityTypeGroupConfirmed,
 		ActivityTimestamp: time.Now(),
-		Details: map[string]any{
-			"reasons": group.Reasons.Messages(),
-		},
+		Details:           details,
 	})
 }
 
@@ -440,6 +507,28 @@ func (m *ReviewMenu) handleMixGroup(ctx *interaction.Context, s *session.Session
 		return
 	}
 
+	// Grade the answer without applying it if the group is a gold item
+	if m.RecordGoldAnswer(ctx, s, types.DecisionTargetGroup, group.ID, types.DecisionActionMix) {
+		m.UpdateCounters(s)
+		m.navigateAfterAction(ctx, s, "Group marked as mixed.")
+
+		return
+	}
+
+	// Record the vote if the decision needs a second reviewer
+	consensus := m.layout.db.Service().Consensus()
+
+	vote := m.CastVote(ctx, s, types.DecisionTargetGroup, group.ID,
+		consensus.GroupConsensusReason(group), types.DecisionActionMix)
+	if vote == nil {
+		return
+	}
+
+	if !vote.ShouldApply() {
+		m.handleDecisionVote(ctx, s, group, vote)
+		return
+	}
+
 	// Mark the group as mixed
 	if err := m.layout.db.Service().Group().MixGroup(ctx.Context(), group, reviewerID); err != nil {
 		m.layout.logger.Error("Failed to mark group as mixed", zap.Error(err))
@@ -467,7 +556,123 @@ func (m *ReviewMenu) handleMixGroup(ctx *interaction.Context, s *session.Session
 		ReviewerID:        reviewerID,
 		ActivityType:      enum.ActivityTypeGroupMixed,
 		ActivityTimestamp: time.Now(),
-		Details:           map[string]any{},
+		Details:           vote.LogDetails(),
+	})
+}
+
+// handleUndoDecision reverts the latest decision on the group and syncs the restored state.
+func (m *ReviewMenu) handleUndoDecision(ctx *interaction.Context, s *session.Session) {
+	change := session.GroupLatestStatusChange.Get(s)
+	if change == nil {
+		ctx.Cancel("There is no decision to undo for this group.")
+		return
+	}
+
+	reviewerID := uint64(ctx.Event().User().ID)
+	isAdmin := s.BotSettings().IsAdmin(reviewerID)
+
+	// Revert the decision
+	revert, err := m.layout.db.Service().Group().RevertDecision(ctx.Context(), change.ID, reviewerID, isAdmin)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotDecisionOwner):
+			ctx.Cancel("You can only undo your own decisions.")
+		case errors.Is(err, service.ErrUndoWindowExpired):
+			ctx.Cancel(fmt.Sprintf("Decisions can only be undone within %s. Ask an admin to revert it.",
+				service.DecisionUndoWindow))
+		case errors.Is(err, types.ErrStatusChangeReverted):
+			ctx.Cancel("This decision was already reverted.")
+		case errors.Is(err, types.ErrStatusChangeSuperseded):
+			ctx.Cancel("A newer decision was made on this group. Please reload and try again.")
+		case errors.Is(err, types.ErrStatusChangeStale):
+			ctx.Cancel("This group was updated since the decision. Please reload and try again.")
+		default:
+			m.layout.logger.Error("Failed to revert decision",
+				zap.Error(err),
+				zap.Int64("changeID", change.ID))
+			ctx.Error("Failed to undo the decision. Please try again.")
+		}
+
+		return
+	}
+
+	// Reload the group with the restored state
+	group, err := m.layout.db.Model().Group().GetGroupByID(
+		ctx.Context(), strconv.FormatInt(revert.GroupID, 10), types.GroupFieldAll,
+	)
+	if err != nil {
+		m.layout.logger.Error("Failed to reload reverted group", zap.Error(err))
+		ctx.Error("The decision was undone but the group could not be reloaded.")
+
+		return
+	}
+
+	session.GroupTarget.Set(s, group)
+	session.OriginalGroupReasons.Set(s, group.Reasons)
+	session.UnsavedGroupReasons.Delete(s)
+	session.ReasonsChanged.Delete(s)
+
+	// Bring the D1 database in line with the restored state
+	message := fmt.Sprintf("Decision undone. Group restored to %s.", group.Status.String())
+
+	if err := m.layout.cfClient.GroupFlags.Revert(ctx.Context(), group); err != nil {
+		m.layout.logger.Error("Failed to sync reverted group to D1 database",
+			zap.Error(err),
+			zap.Int64("groupID", group.ID))
+
+		message += " Warning: the public flags could not be updated and may be out of date."
+	}
+
+	ctx.Reload(message)
+
+	// Log the revert action
+	m.layout.db.Model().Activity().Log(ctx.Context(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			GroupID: group.ID,
+		},
+		ReviewerID:        reviewerID,
+		ActivityType:      enum.ActivityTypeGroupDecisionReverted,
+		ActivityTimestamp: time.Now(),
+		Details: map[string]any{
+			"changeId":         revert.ID,
+			"revertedChangeId": change.ID,
+			"revertedReviewer": change.ReviewerID,
+			"fromStatus":       revert.Before.Status.String(),
+			"toStatus":         revert.After.Status.String(),
+		},
+	})
+}
+
+// handleDecisionVote moves on after a vote that could not be applied yet and logs the vote.
+func (m *ReviewMenu) handleDecisionVote(
+	ctx *interaction.Context, s *session.Session, group *types.ReviewGroup, vote *service.VoteResult,
+) {
+	activityType := enum.ActivityTypeGroupDecisionVoted
+	message := "Vote recorded. Another admin must agree before the decision is applied."
+
+	if vote.Outcome == service.VoteOutcomeEscalated {
+		activityType = enum.ActivityTypeGroupDecisionEscalated
+		message = "Your vote disagrees with the first admin. The decision was escalated to the other admins."
+	}
+
+	// Navigate to next group in history or fetch new one
+	m.UpdateCounters(s)
+	m.navigateAfterAction(ctx, s, message)
+
+	// Log the vote
+	details := map[string]any{
+		"reasons": group.Reasons.Messages(),
+	}
+	maps.Copy(details, vote.LogDetails())
+
+	m.layout.db.Model().Activity().Log(ctx.Context(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			GroupID: group.ID,
+		},
+		ReviewerID:        uint64(ctx.Event().User().ID),
+		ActivityType:      activityType,
+		ActivityTimestamp: time.Now(),
+		Details:           details,
 	})
 }
 
@@ -659,12 +864,24 @@ func (m *ReviewMenu) fetchNewTarget(ctx *interaction.Context, s *session.Session
 
 	// Get the next group to review
 	reviewerID := uint64(ctx.Event().User().ID)
+	isAdmin := s.BotSettings().IsAdmin(reviewerID)
 	defaultSort := session.UserGroupDefaultSort.Get(s)
 	reviewTargetMode := session.UserReviewTargetMode.Get(s)
 
-	group, err := m.layout.db.Service().Group().GetGroupToReview(
-		ctx.Context(), defaultSort, reviewTargetMode, reviewerID,
-	)
+	var group *types.ReviewGroup
+
+	err := m.ClaimNextTarget(ctx, s, types.DecisionTargetGroup, func(claimedIDs []int64) (int64, error) {
+		var err error
+
+		group, err = m.layout.db.Service().Group().GetGroupToReview(
+			ctx.Context(), defaultSort, reviewTargetMode, reviewerID, isAdmin, claimedIDs, shared.ActiveFilterPreset(s),
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		return group.ID, nil
+	})
 	if err != nil {
 		return nil, err
 	}
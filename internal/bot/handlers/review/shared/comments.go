@@ -22,7 +22,7 @@ type CommentsMenu struct {
 // NewCommentsMenu creates a new comments menu.
 func NewCommentsMenu(logger *zap.Logger, db database.Client, targetType view.TargetType, pageName string) *CommentsMenu {
 	m := &CommentsMenu{
-		BaseReviewMenu: *NewBaseReviewMenu(logger, nil, db),
+		BaseReviewMenu: *NewBaseReviewMenu(logger, nil, nil, db),
 		targetType:     targetType,
 	}
 	m.page = &interaction.Page{
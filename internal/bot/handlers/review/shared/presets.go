@@ -0,0 +1,243 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/interaction"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	view "github.com/robalyx/rotector/internal/bot/views/review/shared"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+)
+
+// ActiveFilterPreset returns the filter preset selected by the reviewer, or nil if none is active.
+func ActiveFilterPreset(s *session.Session) *types.ReviewFilterPreset {
+	name := session.UserActiveFilterPreset.Get(s)
+	if name == "" {
+		return nil
+	}
+
+	presets := session.UserFilterPresets.Get(s)
+	for i := range presets {
+		if presets[i].Name == name {
+			return &presets[i]
+		}
+	}
+
+	return nil
+}
+
+// HandleFilterPresetSelection switches the active filter preset or manages saved presets.
+func (m *BaseReviewMenu) HandleFilterPresetSelection(
+	ctx *interaction.Context, s *session.Session, option string, targetType view.TargetType,
+) {
+	presets := session.UserFilterPresets.Get(s)
+
+	switch option {
+	case constants.FilterPresetNoneOption:
+		session.UserActiveFilterPreset.Set(s, "")
+		ctx.Reload("Cleared filter preset. Will take effect for the next " + string(targetType) + ".")
+	case constants.FilterPresetCreateOption:
+		if len(presets) >= constants.FilterPresetLimit {
+			ctx.Cancel(fmt.Sprintf("Cannot save more than %d filter presets.", constants.FilterPresetLimit))
+			return
+		}
+
+		ctx.Modal(buildFilterPresetModal(targetType))
+	case constants.FilterPresetDeleteOption:
+		name := session.UserActiveFilterPreset.Get(s)
+		if name == "" {
+			ctx.Cancel("Select the preset to delete first.")
+			return
+		}
+
+		presets = slices.DeleteFunc(presets, func(preset types.ReviewFilterPreset) bool {
+			return preset.Name == name
+		})
+
+		session.UserFilterPresets.Set(s, presets)
+		session.UserActiveFilterPreset.Set(s, "")
+		ctx.Reload(fmt.Sprintf("Deleted filter preset %q.", name))
+	default:
+		index, err := strconv.Atoi(option)
+		if err != nil || index < 0 || index >= len(presets) {
+			ctx.Error("Invalid filter preset selected.")
+			return
+		}
+
+		session.UserActiveFilterPreset.Set(s, presets[index].Name)
+		ctx.Reload(fmt.Sprintf("Switched to filter preset %q. Will take effect for the next %s.",
+			presets[index].Name, targetType))
+	}
+}
+
+// HandleFilterPresetModalSubmit saves a new filter preset and makes it active.
+func (m *BaseReviewMenu) HandleFilterPresetModalSubmit(
+	ctx *interaction.Context, s *session.Session, targetType view.TargetType,
+) {
+	data := ctx.Event().ModalData()
+
+	name := strings.TrimSpace(data.Text(constants.FilterPresetNameInputCustomID))
+	if name == "" {
+		ctx.Cancel("Preset name cannot be empty.")
+		return
+	}
+
+	presets := session.UserFilterPresets.Get(s)
+	if slices.ContainsFunc(presets, func(preset types.ReviewFilterPreset) bool { return preset.Name == name }) {
+		ctx.Cancel(fmt.Sprintf("A filter preset named %q already exists.", name))
+		return
+	}
+
+	preset := types.ReviewFilterPreset{Name: name}
+
+	if err := preset.ParseRanges(data.Text(constants.FilterPresetRangesInputCustomID)); err != nil {
+		if errors.Is(err, types.ErrInvalidFilterRange) {
+			ctx.Cancel(fmt.Sprintf("Invalid range filters: %v", err))
+			return
+		}
+
+		ctx.Error("Failed to parse range filters. Please try again.")
+
+		return
+	}
+
+	// Read the selected categories and reasons
+	var err error
+
+	if targetType == view.TargetTypeUser {
+		preset.Categories, err = parseEnumValues[enum.UserCategoryType](
+			data.StringValues(constants.FilterPresetCategoriesSelectCustomID))
+		if err == nil {
+			preset.UserReasonTypes, err = parseEnumValues[enum.UserReasonType](
+				data.StringValues(constants.FilterPresetReasonsSelectCustomID))
+		}
+	} else {
+		preset.GroupReasonTypes, err = parseEnumValues[enum.GroupReasonType](
+			data.StringValues(constants.FilterPresetReasonsSelectCustomID))
+	}
+
+	if err != nil {
+		ctx.Error("Invalid option selected.")
+		return
+	}
+
+	// Read the remaining flags
+	flags := data.StringValues(constants.FilterPresetFlagsSelectCustomID)
+	hasSocials := slices.Contains(flags, constants.FilterPresetHasSocialsFlag)
+	noSocials := slices.Contains(flags, constants.FilterPresetNoSocialsFlag)
+
+	if hasSocials != noSocials {
+		preset.HasSocials = &hasSocials
+	}
+
+	preset.TouchedByMe = slices.Contains(flags, constants.FilterPresetTouchedByMeFlag)
+
+	session.UserFilterPresets.Set(s, append(presets, preset))
+	session.UserActiveFilterPreset.Set(s, name)
+	ctx.Reload(fmt.Sprintf("Saved and switched to filter preset %q. Will take effect for the next %s.",
+		name, targetType))
+}
+
+// buildFilterPresetModal creates the modal for saving a new filter preset.
+func buildFilterPresetModal(targetType view.TargetType) *discord.ModalCreateBuilder {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.FilterPresetModalCustomID).
+		SetTitle("Save Filter Preset").
+		AddLabel("Name",
+			discord.NewTextInput(constants.FilterPresetNameInputCustomID, discord.TextInputStyleShort).
+				WithRequired(true).
+				WithMaxLength(50).
+				WithPlaceholder("e.g. New accounts"),
+		)
+
+	// Categories and user-only flags only apply to the user queue
+	flagOptions := []discord.StringSelectMenuOption{
+		discord.NewStringSelectMenuOption("Touched by me", constants.FilterPresetTouchedByMeFlag).
+			WithDescription("Only targets you have reviewed or acted on before"),
+	}
+
+	var reasonOptions []discord.StringSelectMenuOption
+
+	if targetType == view.TargetTypeUser {
+		categoryOptions := make([]discord.StringSelectMenuOption, 0, len(enum.UserCategoryTypeValues()))
+		for _, category := range enum.UserCategoryTypeValues() {
+			categoryOptions = append(categoryOptions,
+				discord.NewStringSelectMenuOption(category.String(), strconv.Itoa(int(category))))
+		}
+
+		modal.AddLabel("Categories",
+			discord.NewStringSelectMenu(
+				constants.FilterPresetCategoriesSelectCustomID, "Any category", categoryOptions...,
+			).
+				WithMinValues(0).
+				WithMaxValues(len(categoryOptions)).
+				WithRequired(false),
+		)
+
+		for _, reasonType := range enum.UserReasonTypeValues() {
+			reasonOptions = append(reasonOptions,
+				discord.NewStringSelectMenuOption(reasonType.String(), strconv.Itoa(int(reasonType))))
+		}
+
+		flagOptions = append(flagOptions,
+			discord.NewStringSelectMenuOption("Has socials", constants.FilterPresetHasSocialsFlag).
+				WithDescription("Only users with social media links"),
+			discord.NewStringSelectMenuOption("No socials", constants.FilterPresetNoSocialsFlag).
+				WithDescription("Only users without social media links"),
+		)
+	} else {
+		for _, reasonType := range enum.GroupReasonTypeValues() {
+			reasonOptions = append(reasonOptions,
+				discord.NewStringSelectMenuOption(reasonType.String(), strconv.Itoa(int(reasonType))))
+		}
+	}
+
+	rangesPlaceholder := "confidence=0.7-1"
+	if targetType == view.TargetTypeUser {
+		rangesPlaceholder = "confidence=0.7-1 age=0-30 engine=2.1.0"
+	}
+
+	modal.AddLabel("Reasons",
+		discord.NewStringSelectMenu(constants.FilterPresetReasonsSelectCustomID, "Any reason", reasonOptions...).
+			WithMinValues(0).
+			WithMaxValues(len(reasonOptions)).
+			WithRequired(false),
+	)
+	modal.AddLabel("Ranges",
+		discord.NewTextInput(constants.FilterPresetRangesInputCustomID, discord.TextInputStyleShort).
+			WithRequired(false).
+			WithMaxLength(100).
+			WithPlaceholder(rangesPlaceholder),
+	)
+	modal.AddLabel("Other Filters",
+		discord.NewStringSelectMenu(constants.FilterPresetFlagsSelectCustomID, "No other filters", flagOptions...).
+			WithMinValues(0).
+			WithMaxValues(len(flagOptions)).
+			WithRequired(false),
+	)
+
+	return modal
+}
+
+// parseEnumValues converts the values of a multi-select back into enum values.
+func parseEnumValues[T ~int](values []string) ([]T, error) {
+	result := make([]T, 0, len(values))
+
+	for _, value := range values {
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, T(parsed))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,172 @@
+package shared
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/interaction"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	view "github.com/robalyx/rotector/internal/bot/views/review/shared"
+	"github.com/robalyx/rotector/internal/database/types"
+	"go.uber.org/zap"
+)
+
+// HandleSuggestRule opens the modal for promoting evidence or a term to a candidate detection rule.
+func (m *BaseReviewMenu) HandleSuggestRule(ctx *interaction.Context, s *session.Session, targetType view.TargetType) {
+	_, _, evidence := ruleSource(s, targetType)
+	ctx.Modal(buildSuggestRuleModal(evidence, targetType))
+}
+
+// HandleSuggestRuleModalSubmit saves the suggested rule for admin approval.
+func (m *BaseReviewMenu) HandleSuggestRuleModalSubmit(
+	ctx *interaction.Context, s *session.Session, targetType view.TargetType,
+) {
+	data := ctx.Event().ModalData()
+	sourceType, sourceID, evidence := ruleSource(s, targetType)
+
+	// A typed term takes priority over the selected evidence
+	term := strings.TrimSpace(data.Text(constants.SuggestRuleTermInputCustomID))
+	if values := data.StringValues(constants.SuggestRuleEvidenceCustomID); term == "" && len(values) > 0 {
+		index, err := strconv.Atoi(values[0])
+		if err != nil || index < 0 || index >= len(evidence) {
+			ctx.Error("Invalid evidence selected.")
+			return
+		}
+
+		term = evidence[index]
+	}
+
+	if term == "" {
+		ctx.Cancel("Enter a term or select an evidence snippet to promote.")
+		return
+	}
+
+	var scope types.RuleScope
+	if values := data.StringValues(constants.SuggestRuleScopeSelectCustomID); len(values) > 0 {
+		scope = types.RuleScope(values[0])
+	}
+
+	rule := &types.DetectionRule{
+		Term:        term,
+		Scope:       scope,
+		Notes:       strings.TrimSpace(data.Text(constants.SuggestRuleNotesInputCustomID)),
+		SourceType:  sourceType,
+		SourceID:    sourceID,
+		SuggestedBy: uint64(ctx.Event().User().ID),
+	}
+
+	if err := m.db.Service().Rule().SuggestRule(ctx.Context(), rule); err != nil {
+		switch {
+		case errors.Is(err, types.ErrInvalidRuleTerm), errors.Is(err, types.ErrInvalidRuleScope):
+			ctx.Cancel(fmt.Sprintf("Invalid rule: %v", err))
+		case errors.Is(err, types.ErrRuleExists):
+			ctx.Cancel(fmt.Sprintf("This term was already suggested for the %s scope.", scope))
+		default:
+			m.logger.Error("Failed to suggest rule", zap.Error(err))
+			ctx.Error("Failed to suggest the rule. Please try again.")
+		}
+
+		return
+	}
+
+	ctx.Reload(fmt.Sprintf(
+		"Suggested rule #%d for admin approval. It will be back-tested against existing %ss shortly.",
+		rule.ID, targetType))
+}
+
+// ruleSource returns the current review target and its evidence snippets in a stable order.
+func ruleSource(s *session.Session, targetType view.TargetType) (types.DecisionTargetType, int64, []string) {
+	if targetType == view.TargetTypeUser {
+		user := session.UserTarget.Get(s)
+		return types.DecisionTargetUser, user.ID, collectEvidence(user.Reasons)
+	}
+
+	group := session.GroupTarget.Get(s)
+
+	return types.DecisionTargetGroup, group.ID, collectEvidence(group.Reasons)
+}
+
+// collectEvidence flattens the evidence of all reasons ordered by reason type.
+func collectEvidence[T types.ReasonType](reasons types.Reasons[T]) []string {
+	var evidence []string
+
+	for _, reasonType := range slices.Sorted(maps.Keys(reasons)) {
+		for _, snippet := range reasons[reasonType].Evidence {
+			if snippet = strings.TrimSpace(snippet); snippet != "" {
+				evidence = append(evidence, snippet)
+			}
+		}
+	}
+
+	return evidence
+}
+
+// buildSuggestRuleModal creates the modal for suggesting a detection rule.
+func buildSuggestRuleModal(evidence []string, targetType view.TargetType) *discord.ModalCreateBuilder {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.SuggestRuleModalCustomID).
+		SetTitle("Suggest Detection Rule")
+
+	// Offer the evidence of the target for promotion
+	if len(evidence) > 0 {
+		options := make([]discord.StringSelectMenuOption, 0, min(len(evidence), constants.RuleEvidenceOptionLimit))
+		for i, snippet := range evidence[:min(len(evidence), constants.RuleEvidenceOptionLimit)] {
+			label := utils.TruncateString(utils.NormalizeString(snippet), 100)
+			options = append(options, discord.NewStringSelectMenuOption(label, strconv.Itoa(i)))
+		}
+
+		modal.AddLabel("Evidence to promote",
+			discord.NewStringSelectMenu(constants.SuggestRuleEvidenceCustomID, "Select an evidence snippet", options...).
+				WithMinValues(0).
+				WithMaxValues(1).
+				WithRequired(false),
+		)
+	}
+
+	defaultScope := types.RuleScopeProfile
+	if targetType == view.TargetTypeGroup {
+		defaultScope = types.RuleScopeGroup
+	}
+
+	scopeDescriptions := map[types.RuleScope]string{
+		types.RuleScopeProfile: "Match user display names and descriptions",
+		types.RuleScopeGroup:   "Match group names and descriptions",
+		types.RuleScopeMessage: "Match Discord messages",
+	}
+
+	scopeOptions := make([]discord.StringSelectMenuOption, 0, len(types.RuleScopes))
+	for _, scope := range types.RuleScopes {
+		scopeOptions = append(scopeOptions,
+			discord.NewStringSelectMenuOption(string(scope), string(scope)).
+				WithDescription(scopeDescriptions[scope]).
+				WithDefault(scope == defaultScope))
+	}
+
+	modal.AddLabel("Term",
+		discord.NewTextInput(constants.SuggestRuleTermInputCustomID, discord.TextInputStyleShort).
+			WithRequired(false).
+			WithMaxLength(100).
+			WithPlaceholder("Leave empty to use the selected evidence"),
+	)
+	modal.AddLabel("Scope",
+		discord.NewStringSelectMenu(constants.SuggestRuleScopeSelectCustomID, "Select a scope", scopeOptions...).
+			WithMinValues(1).
+			WithMaxValues(1).
+			WithRequired(true),
+	)
+	modal.AddLabel("Notes",
+		discord.NewTextInput(constants.SuggestRuleNotesInputCustomID, discord.TextInputStyleParagraph).
+			WithRequired(false).
+			WithMaxLength(512).
+			WithPlaceholder("Why this term indicates inappropriate content..."),
+	)
+
+	return modal
+}
@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -9,10 +10,12 @@ import (
 	"github.com/disgoorg/disgo/discord"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/captcha"
+	"github.com/robalyx/rotector/internal/bot/core/claim"
 	"github.com/robalyx/rotector/internal/bot/core/interaction"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	view "github.com/robalyx/rotector/internal/bot/views/review/shared"
 	"github.com/robalyx/rotector/internal/database"
+	"github.com/robalyx/rotector/internal/database/service"
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
 	"github.com/robalyx/rotector/pkg/utils"
@@ -23,14 +26,18 @@ import (
 type BaseReviewMenu struct {
 	logger  *zap.Logger
 	captcha *captcha.Manager
+	claims  *claim.Manager
 	db      database.Client
 }
 
 // NewBaseReviewMenu creates a new base review menu.
-func NewBaseReviewMenu(logger *zap.Logger, captcha *captcha.Manager, db database.Client) *BaseReviewMenu {
+func NewBaseReviewMenu(
+	logger *zap.Logger, captcha *captcha.Manager, claims *claim.Manager, db database.Client,
+) *BaseReviewMenu {
 	return &BaseReviewMenu{
 		logger:  logger,
 		captcha: captcha,
+		claims:  claims,
 		db:      db,
 	}
 }
@@ -150,7 +157,7 @@ func (m *BaseReviewMenu) HandleCommentModalSubmit(ctx *interaction.Context, s *s
 	ctx.Reload("Successfully added note")
 }
 
-// CheckBreakRequired checks if a break is needed.
+// CheckBreakRequired checks if a break is needed under the review policy of the user's role.
 func (m *BaseReviewMenu) CheckBreakRequired(ctx *interaction.Context, s *session.Session) bool {
 	// Check if user needs a break
 	nextReviewTime := session.UserReviewBreakNextReviewTime.Get(s)
@@ -162,12 +169,37 @@ func (m *BaseReviewMenu) CheckBreakRequired(ctx *interaction.Context, s *session
 		return true
 	}
 
+	reviewerID := uint64(ctx.Event().User().ID)
+	policy := s.BotSettings().ReviewPolicy(reviewerID)
+
+	// Check for a streak of fast decisions since the last break
+	if policy.MaxFastStreak > 0 {
+		since := nextReviewTime
+		if windowStart := time.Now().Add(-policy.Window); policy.Window > 0 && windowStart.After(since) {
+			since = windowStart
+		}
+
+		trainingMode := session.UserReviewMode.Get(s) == enum.ReviewModeTraining
+
+		streak, err := m.db.Service().Reviewer().GetFastStreak(ctx.Context(), reviewerID, since, policy, trainingMode)
+		if err != nil {
+			m.logger.Error("Failed to check fast decision streak", zap.Error(err))
+		} else if streak >= policy.MaxFastStreak {
+			m.startBreak(ctx, s, policy)
+			return true
+		}
+	}
+
+	if policy.MaxReviews <= 0 {
+		return false
+	}
+
 	// Check review count
 	windowStartTime := session.UserReviewBreakWindowStartTime.Get(s)
 	reviewCount := session.UserReviewBreakReviewCount.Get(s)
 
 	// Reset count if outside window
-	if time.Since(windowStartTime) > constants.ReviewSessionWindow {
+	if time.Since(windowStartTime) > policy.Window {
 		reviewCount = 0
 		windowStartTime = time.Now()
 		session.UserReviewBreakWindowStartTime.Set(s, windowStartTime)
@@ -175,15 +207,8 @@ func (m *BaseReviewMenu) CheckBreakRequired(ctx *interaction.Context, s *session
 	}
 
 	// Check if break needed
-	if reviewCount >= constants.MaxReviewsBeforeBreak {
-		nextTime := time.Now().Add(constants.MinBreakDuration)
-		session.UserReviewBreakNextReviewTime.Set(s, nextTime)
-		session.UserReviewBreakWindowStartTime.Set(s, nextTime)
-		session.UserReviewBreakReviewCount.Set(s, 0) // Reset count
-
-		ctx.UpdatePage(constants.DashboardPageName)
-		ctx.Show(constants.TimeoutPageName, "")
-
+	if reviewCount >= policy.MaxReviews {
+		m.startBreak(ctx, s, policy)
 		return true
 	}
 
@@ -193,6 +218,17 @@ func (m *BaseReviewMenu) CheckBreakRequired(ctx *interaction.Context, s *session
 	return false
 }
 
+// startBreak sends the user on a break and resets the review window.
+func (m *BaseReviewMenu) startBreak(ctx *interaction.Context, s *session.Session, policy types.ReviewPolicy) {
+	nextTime := time.Now().Add(policy.BreakDuration)
+	session.UserReviewBreakNextReviewTime.Set(s, nextTime)
+	session.UserReviewBreakWindowStartTime.Set(s, nextTime)
+	session.UserReviewBreakReviewCount.Set(s, 0) // Reset count
+
+	ctx.UpdatePage(constants.DashboardPageName)
+	ctx.Show(constants.TimeoutPageName, "")
+}
+
 // CheckCaptchaRequired checks if CAPTCHA verification is needed.
 func (m *BaseReviewMenu) CheckCaptchaRequired(ctx *interaction.Context, s *session.Session) bool {
 	if m.captcha.IsRequired(s) {
@@ -210,6 +246,224 @@ func (m *BaseReviewMenu) UpdateCounters(s *session.Session) {
 	}
 }
 
+// ClaimNextTarget fetches targets from the review queue until one can be claimed by the
+// reviewer. The fetch receives the targets claimed by other reviewers to skip them and
+// returns the ID of the fetched target. If the claim keeps being lost to other reviewers,
+// the last fetched target is reviewed without a claim. Non-reviewers skip claimed targets
+// but never claim one, as their sessions do not keep claims alive or release them.
+func (m *BaseReviewMenu) ClaimNextTarget(
+	ctx *interaction.Context, s *session.Session, targetType types.DecisionTargetType,
+	fetch func(claimedIDs []int64) (int64, error),
+) error {
+	reviewerID := uint64(ctx.Event().User().ID)
+	claimedIDs := m.claims.GetClaimedIDs(ctx.Context(), targetType, reviewerID)
+
+	if !s.BotSettings().IsReviewer(reviewerID) {
+		_, err := fetch(claimedIDs)
+		return err
+	}
+
+	for range constants.ReviewClaimAttempts {
+		targetID, err := fetch(claimedIDs)
+		if err != nil {
+			return err
+		}
+
+		acquired, err := m.claims.Acquire(ctx.Context(), targetType, targetID, reviewerID)
+		if err != nil {
+			m.logger.Error("Failed to claim review target", zap.Error(err))
+			return nil
+		}
+
+		if acquired {
+			return nil
+		}
+
+		claimedIDs = append(claimedIDs, targetID)
+	}
+
+	m.logger.Warn("Reviewing target without a claim after losing repeated claim races",
+		zap.String("targetType", string(targetType)),
+		zap.Uint64("reviewerID", reviewerID))
+
+	return nil
+}
+
+// ClaimedByOthers returns the targets of a type claimed by reviewers other than the current one.
+func (m *BaseReviewMenu) ClaimedByOthers(
+	ctx *interaction.Context, targetType types.DecisionTargetType,
+) map[int64]struct{} {
+	claimedIDs := m.claims.GetClaimedIDs(ctx.Context(), targetType, uint64(ctx.Event().User().ID))
+
+	claimed := make(map[int64]struct{}, len(claimedIDs))
+	for _, targetID := range claimedIDs {
+		claimed[targetID] = struct{}{}
+	}
+
+	return claimed
+}
+
+// LoadPendingDecision stores the open consensus decision of the target in the session.
+func (m *BaseReviewMenu) LoadPendingDecision(
+	ctx *interaction.Context, s *session.Session, targetType types.DecisionTargetType, targetID int64,
+) {
+	decision, err := m.db.Service().Consensus().GetOpenDecision(ctx.Context(), targetType, targetID)
+	if err != nil {
+		m.logger.Error("Failed to fetch pending decision", zap.Error(err))
+	}
+
+	if decision == nil {
+		session.ReviewPendingDecision.Delete(s)
+		return
+	}
+
+	session.ReviewPendingDecision.Set(s, decision)
+}
+
+// LoadGoldItem stores the gold item of the target in the session for admins.
+// Other viewers never see whether the target is a gold item.
+func (m *BaseReviewMenu) LoadGoldItem(
+	ctx *interaction.Context, s *session.Session, targetType types.DecisionTargetType, targetID int64,
+) {
+	var item *types.GoldItem
+
+	if s.BotSettings().IsAdmin(uint64(ctx.Event().User().ID)) {
+		var err error
+
+		item, err = m.db.Service().Gold().GetGoldItem(ctx.Context(), targetType, targetID)
+		if err != nil {
+			m.logger.Error("Failed to fetch gold item", zap.Error(err))
+		}
+	}
+
+	if item == nil {
+		session.ReviewGoldItem.Delete(s)
+		return
+	}
+
+	session.ReviewGoldItem.Set(s, item)
+}
+
+// EnforceAccuracy moves reviewers whose recent gold item accuracy fell below the
+// threshold to training mode. Admins are never demoted.
+func (m *BaseReviewMenu) EnforceAccuracy(ctx *interaction.Context, s *session.Session) {
+	reviewerID := uint64(ctx.Event().User().ID)
+	if session.UserReviewMode.Get(s) == enum.ReviewModeTraining || s.BotSettings().IsAdmin(reviewerID) {
+		return
+	}
+
+	demote, err := m.db.Service().Gold().ShouldDemote(ctx.Context(), reviewerID)
+	if err != nil {
+		m.logger.Error("Failed to check reviewer accuracy", zap.Error(err))
+		return
+	}
+
+	if demote {
+		session.UserReviewMode.Set(s, enum.ReviewModeTraining)
+
+		m.logger.Info("Demoted reviewer to training mode due to low accuracy",
+			zap.Uint64("reviewerID", reviewerID))
+	}
+}
+
+// RecordGoldAnswer grades the reviewer's action if the target is a gold item.
+// Returns true if the action was only graded and must not be applied. Admin actions
+// are never graded so that their decisions always apply.
+func (m *BaseReviewMenu) RecordGoldAnswer(
+	ctx *interaction.Context, s *session.Session, targetType types.DecisionTargetType, targetID int64,
+	action types.DecisionAction,
+) bool {
+	reviewerID := uint64(ctx.Event().User().ID)
+	if s.BotSettings().IsAdmin(reviewerID) {
+		return false
+	}
+
+	isGold, err := m.db.Service().Gold().RecordAnswer(
+		ctx.Context(), targetType, targetID, reviewerID, action,
+	)
+	if err != nil {
+		m.logger.Error("Failed to record gold answer",
+			zap.Error(err),
+			zap.String("targetType", string(targetType)),
+			zap.Int64("targetID", targetID))
+	}
+
+	return isGold
+}
+
+// HandleGoldOption marks the target as a gold item with the selected verdict or removes it.
+func (m *BaseReviewMenu) HandleGoldOption(
+	ctx *interaction.Context, s *session.Session, targetType types.DecisionTargetType, targetID int64, option string,
+) {
+	adminID := uint64(ctx.Event().User().ID)
+	if !s.BotSettings().IsAdmin(adminID) {
+		m.logger.Error("Non-admin attempted to manage gold items", zap.Uint64("userID", adminID))
+		ctx.Error("You do not have permission to manage gold items.")
+
+		return
+	}
+
+	gold := m.db.Service().Gold()
+
+	if option == constants.GoldRemoveButtonCustomID {
+		if err := gold.RemoveGoldItem(ctx.Context(), targetType, targetID); err != nil {
+			m.logger.Error("Failed to remove gold item", zap.Error(err))
+			ctx.Error("Failed to remove the gold item. Please try again.")
+
+			return
+		}
+
+		ctx.Reload(fmt.Sprintf("Removed this %s from the gold set.", targetType))
+
+		return
+	}
+
+	action := types.DecisionAction(strings.TrimPrefix(option, constants.GoldMarkOptionPrefix))
+
+	if err := gold.MarkGoldItem(ctx.Context(), targetType, targetID, action, adminID); err != nil {
+		m.logger.Error("Failed to mark gold item", zap.Error(err))
+		ctx.Error("Failed to mark the gold item. Please try again.")
+
+		return
+	}
+
+	ctx.Reload(fmt.Sprintf("Marked this %s as a gold item. Reviewers will be graded against **%s**.",
+		targetType, action))
+}
+
+// CastVote records the reviewer's decision on a target that may need a second reviewer.
+// Returns nil if the vote was rejected, in which case the reviewer was already notified.
+func (m *BaseReviewMenu) CastVote(
+	ctx *interaction.Context, s *session.Session, targetType types.DecisionTargetType,
+	targetID int64, reason string, action types.DecisionAction,
+) *service.VoteResult {
+	reviewerID := uint64(ctx.Event().User().ID)
+
+	result, err := m.db.Service().Consensus().Vote(
+		ctx.Context(), targetType, targetID, reason, reviewerID, s.BotSettings().IsAdmin(reviewerID), action,
+	)
+
+	switch {
+	case err == nil:
+		return result
+	case errors.Is(err, service.ErrAlreadyVoted):
+		ctx.Cancel(fmt.Sprintf("You already voted on this %s. Another reviewer must make the decision.", targetType))
+	case errors.Is(err, service.ErrAwaitingAdmin):
+		ctx.Cancel(fmt.Sprintf("Reviewers disagreed on this %s. An admin must make the final decision.", targetType))
+	case errors.Is(err, service.ErrDecisionRacing):
+		ctx.Cancel(fmt.Sprintf("Another reviewer voted on this %s at the same time. Please try again.", targetType))
+	default:
+		m.logger.Error("Failed to record vote",
+			zap.Error(err),
+			zap.String("targetType", string(targetType)),
+			zap.Int64("targetID", targetID),
+			zap.Uint64("reviewerID", reviewerID))
+		ctx.Error("Failed to record your decision. Please try again.")
+	}
+
+	return nil
+}
+
 // HandleEditReason handles the edit reason button click for review menus.
 func HandleEditReason[T types.ReasonType](
 	ctx *interaction.Context, s *session.Session, logger *zap.Logger,
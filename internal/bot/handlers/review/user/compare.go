@@ -0,0 +1,270 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/interaction"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	view "github.com/robalyx/rotector/internal/bot/views/review/user"
+	"github.com/robalyx/rotector/internal/database/service"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+	"go.uber.org/zap"
+)
+
+// compareOutcome describes what a decision on one of the compared users led to.
+type compareOutcome int
+
+const (
+	compareOutcomeApplied compareOutcome = iota
+	compareOutcomeVoted
+	compareOutcomeSkipped
+	compareOutcomeClaimed
+	compareOutcomeFailed
+)
+
+// CompareMenu handles the side-by-side comparison of suspected alts and duplicates.
+type CompareMenu struct {
+	layout *Layout
+	page   *interaction.Page
+}
+
+// NewCompareMenu creates a new comparison menu.
+func NewCompareMenu(layout *Layout) *CompareMenu {
+	m := &CompareMenu{layout: layout}
+	m.page = &interaction.Page{
+		Name: constants.UserComparePageName,
+		Message: func(s *session.Session) *discord.MessageUpdateBuilder {
+			return view.NewCompareBuilder(s).Build()
+		},
+		ShowHandlerFunc:   m.Show,
+		ButtonHandlerFunc: m.handleButton,
+		CleanupHandlerFunc: func(s *session.Session) {
+			session.UserCompareIDs.Delete(s)
+			session.UserCompareUsers.Delete(s)
+		},
+	}
+
+	return m
+}
+
+// Show loads the compared users in the order they were requested.
+func (m *CompareMenu) Show(ctx *interaction.Context, s *session.Session) {
+	userIDs := session.UserCompareIDs.Get(s)
+
+	users, err := m.layout.db.Service().User().GetUsersByIDs(ctx.Context(), userIDs, types.UserFieldAll)
+	if err != nil {
+		m.layout.logger.Error("Failed to get users for comparison", zap.Error(err))
+		ctx.Error("Failed to load the users to compare. Please try again.")
+
+		return
+	}
+
+	compared := make([]*types.ReviewUser, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if user, exists := users[userID]; exists {
+			compared = append(compared, user)
+		}
+	}
+
+	if len(compared) < 2 {
+		ctx.Cancel("At least two of the users must be in the database to compare them.")
+		return
+	}
+
+	session.UserCompareUsers.Set(s, compared)
+}
+
+// handleButton processes button interactions.
+func (m *CompareMenu) handleButton(ctx *interaction.Context, s *session.Session, customID string) {
+	if m.layout.reviewMenu.CheckCaptchaRequired(ctx, s) {
+		return
+	}
+
+	switch customID {
+	case constants.BackButtonCustomID:
+		ctx.NavigateBack("")
+	case constants.CompareConfirmAllButtonCustomID:
+		m.handleDecideAll(ctx, s, types.DecisionActionConfirm)
+	case constants.CompareClearAllButtonCustomID:
+		m.handleDecideAll(ctx, s, types.DecisionActionClear)
+	}
+}
+
+// handleDecideAll applies the same decision to every compared user.
+// Each user goes through consensus and is logged on its own.
+func (m *CompareMenu) handleDecideAll(ctx *interaction.Context, s *session.Session, action types.DecisionAction) {
+	reviewerID := uint64(ctx.Event().User().ID)
+	if !s.BotSettings().IsReviewer(reviewerID) {
+		m.layout.logger.Error("Non-reviewer attempted to decide on compared users",
+			zap.Uint64("userID", reviewerID))
+		ctx.Error("You do not have permission to decide on users.")
+
+		return
+	}
+
+	if session.UserReviewMode.Get(s) == enum.ReviewModeTraining {
+		ctx.Cancel("Decisions cannot be applied to compared users in training mode.")
+		return
+	}
+
+	users := session.UserCompareUsers.Get(s)
+	counts := make(map[compareOutcome]int)
+	targetDecided := false
+	target := session.UserTarget.Get(s)
+	claimed := m.layout.reviewMenu.ClaimedByOthers(ctx, types.DecisionTargetUser)
+
+	for _, user := range users {
+		// Leave users being reviewed by someone else to that reviewer
+		if _, ok := claimed[user.ID]; ok {
+			counts[compareOutcomeClaimed]++
+			continue
+		}
+
+		outcome := m.decideUser(ctx, s, user, users, action)
+		counts[outcome]++
+
+		if outcome == compareOutcomeApplied || outcome == compareOutcomeVoted {
+			m.layout.reviewMenu.UpdateCounters(s)
+
+			if target != nil && target.ID == user.ID {
+				targetDecided = true
+			}
+		}
+	}
+
+	verb := "Confirmed"
+	if action == types.DecisionActionClear {
+		verb = "Cleared"
+	}
+
+	message := fmt.Sprintf(
+		"%s %d users. %d awaiting another reviewer, %d claimed by another reviewer, %d skipped, %d failed.",
+		verb, counts[compareOutcomeApplied], counts[compareOutcomeVoted], counts[compareOutcomeClaimed],
+		counts[compareOutcomeSkipped], counts[compareOutcomeFailed])
+
+	// Move on from the reviewed user if it was part of the decision
+	if targetDecided {
+		session.UserTarget.Delete(s)
+		session.UnsavedUserReasons.Delete(s)
+		ctx.NavigateBack(message)
+
+		return
+	}
+
+	ctx.Reload(message)
+}
+
+// decideUser applies the decision to one compared user and logs it.
+func (m *CompareMenu) decideUser(
+	ctx *interaction.Context, s *session.Session, user *types.ReviewUser,
+	compared []*types.ReviewUser, action types.DecisionAction,
+) compareOutcome {
+	reviewerID := uint64(ctx.Event().User().ID)
+
+	// Skip users that already have the requested status
+	if (action == types.DecisionActionConfirm && user.Status == enum.UserTypeConfirmed) ||
+		(action == types.DecisionActionClear && user.Status == enum.UserTypeCleared) {
+		return compareOutcomeSkipped
+	}
+
+	// Leave gold items out as bulk decisions must not be graded or applied to them
+	goldItem, err := m.layout.db.Service().Gold().GetGoldItem(ctx.Context(), types.DecisionTargetUser, user.ID)
+	if err != nil {
+		m.layout.logger.Error("Failed to check gold item of compared user",
+			zap.Error(err),
+			zap.Int64("userID", user.ID))
+
+		return compareOutcomeFailed
+	}
+
+	if goldItem != nil {
+		return compareOutcomeSkipped
+	}
+
+	// Record the vote if the decision needs a second reviewer
+	consensus := m.layout.db.Service().Consensus()
+
+	vote, err := consensus.Vote(ctx.Context(), types.DecisionTargetUser, user.ID,
+		consensus.UserConsensusReason(user), reviewerID, s.BotSettings().IsAdmin(reviewerID), action)
+	if err != nil {
+		if errors.Is(err, service.ErrAlreadyVoted) || errors.Is(err, service.ErrAwaitingAdmin) ||
+			errors.Is(err, service.ErrDecisionRacing) {
+			return compareOutcomeSkipped
+		}
+
+		m.layout.logger.Error("Failed to record vote on compared user",
+			zap.Error(err),
+			zap.Int64("userID", user.ID),
+			zap.Uint64("reviewerID", reviewerID))
+
+		return compareOutcomeFailed
+	}
+
+	// Note which accounts were decided together
+	details := map[string]any{
+		"reasons":      user.Reasons.Messages(),
+		"confidence":   user.Confidence,
+		"comparedWith": comparedIDs(compared, user.ID),
+	}
+	maps.Copy(details, vote.LogDetails())
+
+	if !vote.ShouldApply() {
+		activityType := enum.ActivityTypeUserDecisionVoted
+		if vote.Outcome == service.VoteOutcomeEscalated {
+			activityType = enum.ActivityTypeUserDecisionEscalated
+		}
+
+		m.logDecision(ctx, user.ID, activityType, details)
+
+		return compareOutcomeVoted
+	}
+
+	apply := m.layout.reviewMenu.applyConfirm
+	if action == types.DecisionActionClear {
+		apply = m.layout.reviewMenu.applyClear
+	}
+
+	if err := apply(ctx, user, details); err != nil {
+		m.layout.logger.Error("Failed to apply decision to compared user",
+			zap.Error(err),
+			zap.Int64("userID", user.ID),
+			zap.String("action", string(action)))
+
+		return compareOutcomeFailed
+	}
+
+	return compareOutcomeApplied
+}
+
+// logDecision logs a decision on a single compared user.
+func (m *CompareMenu) logDecision(
+	ctx *interaction.Context, userID int64, activityType enum.ActivityType, details map[string]any,
+) {
+	m.layout.db.Model().Activity().Log(ctx.Context(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: userID,
+		},
+		ReviewerID:        uint64(ctx.Event().User().ID),
+		ActivityType:      activityType,
+		ActivityTimestamp: time.Now(),
+		Details:           details,
+	})
+}
+
+// comparedIDs returns the IDs of the other users in the comparison.
+func comparedIDs(users []*types.ReviewUser, excludeID int64) []int64 {
+	ids := make([]int64, 0, len(users))
+	for _, user := range users {
+		if user.ID != excludeID {
+			ids = append(ids, user.ID)
+		}
+	}
+
+	return ids
+}
@@ -5,11 +5,13 @@ import (
 	"github.com/robalyx/rotector/internal/ai"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/captcha"
+	"github.com/robalyx/rotector/internal/bot/core/claim"
 	"github.com/robalyx/rotector/internal/bot/core/interaction"
 	"github.com/robalyx/rotector/internal/bot/handlers/review/shared"
 	sharedView "github.com/robalyx/rotector/internal/bot/views/review/shared"
 	"github.com/robalyx/rotector/internal/cloudflare"
 	"github.com/robalyx/rotector/internal/database"
+	"github.com/robalyx/rotector/internal/redis"
 	"github.com/robalyx/rotector/internal/roblox/checker"
 	"github.com/robalyx/rotector/internal/roblox/fetcher"
 	"github.com/robalyx/rotector/internal/setup"
@@ -28,6 +30,7 @@ type Layout struct {
 	friendsMenu          *FriendsMenu
 	groupsMenu           *GroupsMenu
 	caesarMenu           *CaesarMenu
+	compareMenu          *CompareMenu
 	commentsMenu         *shared.CommentsMenu
 	thumbnailFetcher     *fetcher.ThumbnailFetcher
 	presenceFetcher      *fetcher.PresenceFetcher
@@ -39,27 +42,38 @@ type Layout struct {
 	categoryAnalyzer     *ai.CategoryAnalyzer
 	imageStreamer        *interaction.ImageStreamer
 	captcha              *captcha.Manager
+	claims               *claim.Manager
 	logger               *zap.Logger
 }
 
 // New creates a Layout by initializing all review menus.
 func New(app *setup.App, interactionManager *interaction.Manager) *Layout {
+	// Get Redis client for review claims
+	claimClient, err := app.RedisManager.GetClient(redis.SessionDBIndex)
+	if err != nil {
+		app.Logger.Fatal("Failed to get Redis client for review claims", zap.Error(err))
+	}
+
+	// Translator is shared by the layout and its group checker
+	trans := translator.New(app.RoAPI.GetClient())
+
 	// Initialize layout
 	l := &Layout{
 		db:                   app.DB,
 		roAPI:                app.RoAPI,
 		cfClient:             app.CFClient,
-		translator:           translator.New(app.RoAPI.GetClient()),
+		translator:           trans,
 		thumbnailFetcher:     fetcher.NewThumbnailFetcher(app.RoAPI, app.Logger),
 		presenceFetcher:      fetcher.NewPresenceFetcher(app.RoAPI, app.Logger),
 		friendChecker:        checker.NewFriendChecker(app, app.Logger),
-		groupChecker:         checker.NewGroupChecker(app, app.Logger),
+		groupChecker:         checker.NewGroupChecker(app, trans, app.Logger),
 		friendReasonAnalyzer: ai.NewFriendReasonAnalyzer(app, app.Logger),
 		groupReasonAnalyzer:  ai.NewGroupReasonAnalyzer(app, app.Logger),
 		userReasonAnalyzer:   ai.NewUserReasonAnalyzer(app, app.Logger),
 		categoryAnalyzer:     ai.NewCategoryAnalyzer(app, app.Logger),
 		imageStreamer:        interaction.NewImageStreamer(interactionManager, app.Logger, app.RoAPI.GetClient()),
 		captcha:              captcha.NewManager(app.DB, app.Logger),
+		claims:               claim.NewManager(claimClient, app.Logger),
 		logger:               app.Logger.Named("user_menu"),
 	}
 
@@ -69,6 +83,7 @@ func New(app *setup.App, interactionManager *interaction.Manager) *Layout {
 	l.friendsMenu = NewFriendsMenu(l)
 	l.groupsMenu = NewGroupsMenu(l)
 	l.caesarMenu = NewCaesarMenu(l)
+	l.compareMenu = NewCompareMenu(l)
 	l.commentsMenu = shared.NewCommentsMenu(l.logger, l.db, sharedView.TargetTypeUser, constants.UserCommentsPageName)
 
 	return l
@@ -82,6 +97,7 @@ func (l *Layout) Pages() []*interaction.Page {
 		l.friendsMenu.page,
 		l.groupsMenu.page,
 		l.caesarMenu.page,
+		l.compareMenu.page,
 		l.commentsMenu.Page(),
 	}
 }
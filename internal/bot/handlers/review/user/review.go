@@ -3,9 +3,12 @@ package user
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/robalyx/rotector/internal/ai"
@@ -15,6 +18,7 @@ import (
 	"github.com/robalyx/rotector/internal/bot/handlers/log"
 	viewShared "github.com/robalyx/rotector/internal/bot/views/review/shared"
 	view "github.com/robalyx/rotector/internal/bot/views/review/user"
+	"github.com/robalyx/rotector/internal/database/service"
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
 	"github.com/robalyx/rotector/internal/roblox/checker"
@@ -37,7 +41,7 @@ type ReviewMenu struct {
 // NewReviewMenu creates a new review menu.
 func NewReviewMenu(layout *Layout) *ReviewMenu {
 	m := &ReviewMenu{
-		BaseReviewMenu: *shared.NewBaseReviewMenu(layout.logger, layout.captcha, layout.db),
+		BaseReviewMenu: *shared.NewBaseReviewMenu(layout.logger, layout.captcha, layout.claims, layout.db),
 		layout:         layout,
 	}
 	m.page = &interaction.Page{
@@ -61,6 +65,9 @@ func (m *ReviewMenu) Show(ctx *interaction.Context, s *session.Session) {
 		session.UserReviewMode.Set(s, enum.ReviewModeTraining)
 	}
 
+	// Force training mode if the reviewer's accuracy on gold items is too low
+	m.EnforceAccuracy(ctx, s)
+
 	// If no user is set in session, fetch a new one
 	user := session.UserTarget.Get(s)
 	if user == nil {
@@ -169,6 +176,25 @@ func (m *ReviewMenu) Show(ctx *interaction.Context, s *session.Session) {
 	}
 
 	session.ReviewComments.Set(s, comments)
+
+	// Fetch the open consensus decision for the user
+	m.LoadPendingDecision(ctx, s, types.DecisionTargetUser, user.ID)
+
+	// Fetch the gold item of the user for admins
+	m.LoadGoldItem(ctx, s, types.DecisionTargetUser, user.ID)
+
+	// Fetch the latest decision for undo
+	change, err := m.layout.db.Model().User().GetLatestStatusChange(ctx.Context(), user.ID)
+	if err != nil && !errors.Is(err, types.ErrStatusChangeNotFound) {
+		m.layout.logger.Error("Failed to fetch latest status change", zap.Error(err))
+	}
+
+	if change == nil {
+		session.UserLatestStatusChange.Delete(s)
+		return
+	}
+
+	session.UserLatestStatusChange.Set(s, change)
 }
 
 // handleSelectMenu processes select menu interactions.
@@ -180,6 +206,8 @@ func (m *ReviewMenu) handleSelectMenu(ctx *interaction.Context, s *session.Sessi
 	switch customID {
 	case constants.SortOrderSelectMenuCustomID:
 		m.handleSortOrderSelection(ctx, s, option)
+	case constants.FilterPresetSelectMenuCustomID:
+		m.HandleFilterPresetSelection(ctx, s, option, viewShared.TargetTypeUser)
 	case constants.ActionSelectMenuCustomID:
 		m.handleActionSelection(ctx, s, option)
 	case constants.ReasonSelectMenuCustomID:
@@ -214,8 +242,11 @@ func (m *ReviewMenu) handleActionSelection(ctx *interaction.Context, s *session.
 	// Check reviewer-only options
 	switch option {
 	case constants.ViewUserLogsButtonCustomID,
+		constants.CompareUsersButtonCustomID,
+		constants.SuggestRuleButtonCustomID,
 		constants.ReviewModeOption,
-		constants.ViewCommentsButtonCustomID:
+		constants.ViewCommentsButtonCustomID,
+		constants.UndoDecisionButtonCustomID:
 		if !isReviewer {
 			m.layout.logger.Error("Non-reviewer attempted restricted action",
 				zap.Uint64("userID", userID),
@@ -226,6 +257,12 @@ func (m *ReviewMenu) handleActionSelection(ctx *interaction.Context, s *session.
 		}
 	}
 
+	// Handle gold item options
+	if strings.HasPrefix(option, constants.GoldMarkOptionPrefix) || option == constants.GoldRemoveButtonCustomID {
+		m.HandleGoldOption(ctx, s, types.DecisionTargetUser, session.UserTarget.Get(s).ID, option)
+		return
+	}
+
 	// Process selected option
 	switch option {
 	case constants.OpenFriendsMenuButtonCustomID:
@@ -249,6 +286,12 @@ func (m *ReviewMenu) handleActionSelection(ctx *interaction.Context, s *session.
 		m.HandleDeleteComment(ctx, s, viewShared.TargetTypeUser)
 	case constants.ViewUserLogsButtonCustomID:
 		m.handleViewUserLogs(ctx, s)
+	case constants.CompareUsersButtonCustomID:
+		m.handleCompareUsers(ctx)
+	case constants.SuggestRuleButtonCustomID:
+		m.HandleSuggestRule(ctx, s, viewShared.TargetTypeUser)
+	case constants.UndoDecisionButtonCustomID:
+		m.handleUndoDecision(ctx, s)
 	case constants.ReviewModeOption:
 		session.SettingType.Set(s, constants.UserSettingPrefix)
 		session.SettingCustomID.Set(s, constants.ReviewModeOption)
@@ -302,8 +345,14 @@ func (m *ReviewMenu) handleModal(ctx *interaction.Context, s *session.Session) {
 		)
 	case constants.AddCommentModalCustomID:
 		m.HandleCommentModalSubmit(ctx, s, viewShared.TargetTypeUser)
+	case constants.FilterPresetModalCustomID:
+		m.HandleFilterPresetModalSubmit(ctx, s, viewShared.TargetTypeUser)
 	case constants.GenerateProfileReasonModalCustomID:
 		m.handleGenerateProfileReasonModalSubmit(ctx, s)
+	case constants.CompareUsersModalCustomID:
+		m.handleCompareUsersModalSubmit(ctx, s)
+	case constants.SuggestRuleModalCustomID:
+		m.HandleSuggestRuleModalSubmit(ctx, s, viewShared.TargetTypeUser)
 	}
 }
 
@@ -321,6 +370,66 @@ func (m *ReviewMenu) handleViewUserLogs(ctx *interaction.Context, s *session.Ses
 	ctx.Show(constants.LogPageName, "")
 }
 
+// handleCompareUsers opens a modal for entering the users to compare with the current user.
+func (m *ReviewMenu) handleCompareUsers(ctx *interaction.Context) {
+	modal := discord.NewModalCreateBuilder().
+		SetCustomID(constants.CompareUsersModalCustomID).
+		SetTitle("Compare Users").
+		AddLabel(fmt.Sprintf("User IDs or profile links (up to %d)", constants.CompareMaxUsers-1),
+			discord.NewTextInput(constants.CompareUserIDsInputCustomID, discord.TextInputStyleParagraph).
+				WithRequired(true).
+				WithMaxLength(512).
+				WithPlaceholder("One user per line or separated by commas"),
+		)
+
+	ctx.Modal(modal)
+}
+
+// handleCompareUsersModalSubmit opens the comparison of the current user with the entered users.
+func (m *ReviewMenu) handleCompareUsersModalSubmit(ctx *interaction.Context, s *session.Session) {
+	input := ctx.Event().ModalData().Text(constants.CompareUserIDsInputCustomID)
+	user := session.UserTarget.Get(s)
+	userIDs := []int64{user.ID}
+
+	for _, entry := range strings.FieldsFunc(input, func(r rune) bool {
+		return r == ',' || unicode.IsSpace(r)
+	}) {
+		rawID := entry
+		if utils.IsRobloxProfileURL(entry) {
+			extracted, err := utils.ExtractUserIDFromURL(entry)
+			if err != nil {
+				ctx.Cancel(fmt.Sprintf("Invalid profile link: %s", entry))
+				return
+			}
+
+			rawID = extracted
+		}
+
+		userID, err := strconv.ParseInt(rawID, 10, 64)
+		if err != nil || userID <= 0 {
+			ctx.Cancel(fmt.Sprintf("Invalid user ID: %s", entry))
+			return
+		}
+
+		if !slices.Contains(userIDs, userID) {
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	if len(userIDs) < 2 {
+		ctx.Cancel("Enter at least one other user to compare with.")
+		return
+	}
+
+	if len(userIDs) > constants.CompareMaxUsers {
+		ctx.Cancel(fmt.Sprintf("Cannot compare more than %d users at once.", constants.CompareMaxUsers))
+		return
+	}
+
+	session.UserCompareIDs.Set(s, userIDs)
+	ctx.Show(constants.UserComparePageName, "")
+}
+
 // handleNavigateUser handles navigation to previous or next user based on the button pressed.
 func (m *ReviewMenu) handleNavigateUser(ctx *interaction.Context, s *session.Session, isNext bool) {
 	// Get the review history and current index
@@ -432,6 +541,12 @@ func (m *ReviewMenu) handleConfirmUser(ctx *interaction.Context, s *session.Sess
 		return
 	}
 
+	// Grade the answer without applying it if the user is a gold item
+	if m.RecordGoldAnswer(ctx, s, types.DecisionTargetUser, user.ID, types.DecisionActionConfirm) {
+		m.handleGoldAnswer(ctx, s, "confirmed")
+		return
+	}
+
 	// Re-classify category if reasons have been modified
 	if session.ReasonsChanged.Get(s) {
 		// Prepare user map for classification
@@ -457,8 +572,28 @@ func (m *ReviewMenu) handleConfirmUser(ctx *interaction.Context, s *session.Sess
 		}
 	}
 
-	// Confirm the user
-	if err := m.layout.db.Service().User().ConfirmUser(ctx.Context(), user, reviewerID); err != nil {
+	// Record the vote if the decision needs a second reviewer
+	consensus := m.layout.db.Service().Consensus()
+
+	vote := m.CastVote(ctx, s, types.DecisionTargetUser, user.ID,
+		consensus.UserConsensusReason(user), types.DecisionActionConfirm)
+	if vote == nil {
+		return
+	}
+
+	if !vote.ShouldApply() {
+		m.handleDecisionVote(ctx, s, user, vote)
+		return
+	}
+
+	// Confirm the user along with any consensus votes
+	details := map[string]any{
+		"reasons":    user.Reasons.Messages(),
+		"confidence": user.Confidence,
+	}
+	maps.Copy(details, vote.LogDetails())
+
+	if err := m.applyConfirm(ctx, user, details); err != nil {
 		m.layout.logger.Error("Failed to confirm user", zap.Error(err))
 		ctx.Error("Failed to confirm the user. Please try again.")
 
@@ -474,28 +609,6 @@ func (m *ReviewMenu) handleConfirmUser(ctx *interaction.Context, s *session.Sess
 	// Navigate to next user in history or fetch new one
 	m.UpdateCounters(s)
 	m.navigateAfterAction(ctx, s, fmt.Sprintf("User confirmed. %d users left to review.", flaggedCount))
-
-	// Add or update the user in the D1 database
-	if err := m.layout.cfClient.UserFlags.AddConfirmed(ctx.Context(), user, reviewerID); err != nil {
-		m.layout.logger.Error("Failed to add confirmed user to D1 database",
-			zap.Error(err),
-			zap.Int64("userID", user.ID),
-			zap.Uint64("reviewerID", reviewerID))
-	}
-
-	// Log the confirm action
-	m.layout.db.Model().Activity().Log(ctx.Context(), &types.ActivityLog{
-		ActivityTarget: types.ActivityTarget{
-			UserID: user.ID,
-		},
-		ReviewerID:        reviewerID,
-		ActivityType:      enum.ActivityTypeUserConfirmed,
-		ActivityTimestamp: time.Now(),
-		Details: map[string]any{
-			"reasons":    user.Reasons.Messages(),
-			"confidence": user.Confidence,
-		},
-	})
 }
 
 // handleClearUser removes a user from the flagged state and logs the action.
@@ -513,8 +626,28 @@ func (m *ReviewMenu) handleClearUser(ctx *interaction.Context, s *session.Sessio
 		return
 	}
 
+	// Grade the answer without applying it if the user is a gold item
+	if m.RecordGoldAnswer(ctx, s, types.DecisionTargetUser, user.ID, types.DecisionActionClear) {
+		m.handleGoldAnswer(ctx, s, "cleared")
+		return
+	}
+
+	// Record the vote if the decision needs a second reviewer
+	consensus := m.layout.db.Service().Consensus()
+
+	vote := m.CastVote(ctx, s, types.DecisionTargetUser, user.ID,
+		consensus.UserConsensusReason(user), types.DecisionActionClear)
+	if vote == nil {
+		return
+	}
+
+	if !vote.ShouldApply() {
+		m.handleDecisionVote(ctx, s, user, vote)
+		return
+	}
+
 	// Clear the user
-	if err := m.layout.db.Service().User().ClearUser(ctx.Context(), user, reviewerID); err != nil {
+	if err := m.applyClear(ctx, user, vote.LogDetails()); err != nil {
 		m.layout.logger.Error("Failed to clear user", zap.Error(err))
 		ctx.Error("Failed to clear the user. Please try again.")
 
@@ -530,6 +663,45 @@ func (m *ReviewMenu) handleClearUser(ctx *interaction.Context, s *session.Sessio
 	// Navigate to next user in history or fetch new one
 	m.UpdateCounters(s)
 	m.navigateAfterAction(ctx, s, fmt.Sprintf("User cleared. %d users left to review.", flaggedCount))
+}
+
+// applyConfirm confirms a user, adds it to the D1 database and logs the action with the given details.
+func (m *ReviewMenu) applyConfirm(ctx *interaction.Context, user *types.ReviewUser, details map[string]any) error {
+	reviewerID := uint64(ctx.Event().User().ID)
+
+	if err := m.layout.db.Service().User().ConfirmUser(ctx.Context(), user, reviewerID); err != nil {
+		return err
+	}
+
+	// Add or update the user in the D1 database
+	if err := m.layout.cfClient.UserFlags.AddConfirmed(ctx.Context(), user, reviewerID); err != nil {
+		m.layout.logger.Error("Failed to add confirmed user to D1 database",
+			zap.Error(err),
+			zap.Int64("userID", user.ID),
+			zap.Uint64("reviewerID", reviewerID))
+	}
+
+	// Log the confirm action
+	m.layout.db.Model().Activity().Log(ctx.Context(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: user.ID,
+		},
+		ReviewerID:        reviewerID,
+		ActivityType:      enum.ActivityTypeUserConfirmed,
+		ActivityTimestamp: time.Now(),
+		Details:           details,
+	})
+
+	return nil
+}
+
+// applyClear clears a user, removes it from the D1 database and logs the action with the given details.
+func (m *ReviewMenu) applyClear(ctx *interaction.Context, user *types.ReviewUser, details map[string]any) error {
+	reviewerID := uint64(ctx.Event().User().ID)
+
+	if err := m.layout.db.Service().User().ClearUser(ctx.Context(), user, reviewerID); err != nil {
+		return err
+	}
 
 	// Remove the user from the D1 database
 	if err := m.layout.cfClient.UserFlags.Remove(ctx.Context(), user.ID); err != nil {
@@ -546,10 +718,141 @@ func (m *ReviewMenu) handleClearUser(ctx *interaction.Context, s *session.Sessio
 		ReviewerID:        reviewerID,
 		ActivityType:      enum.ActivityTypeUserCleared,
 		ActivityTimestamp: time.Now(),
-		Details:           map[string]any{},
+		Details:           details,
+	})
+
+	return nil
+}
+
+// handleUndoDecision reverts the latest decision on the user and syncs the restored state.
+func (m *ReviewMenu) handleUndoDecision(ctx *interaction.Context, s *session.Session) {
+	change := session.UserLatestStatusChange.Get(s)
+	if change == nil {
+		ctx.Cancel("There is no decision to undo for this user.")
+		return
+	}
+
+	reviewerID := uint64(ctx.Event().User().ID)
+	isAdmin := s.BotSettings().IsAdmin(reviewerID)
+
+	// Revert the decision
+	revert, err := m.layout.db.Service().User().RevertDecision(ctx.Context(), change.ID, reviewerID, isAdmin)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotDecisionOwner):
+			ctx.Cancel("You can only undo your own decisions.")
+		case errors.Is(err, service.ErrUndoWindowExpired):
+			ctx.Cancel(fmt.Sprintf("Decisions can only be undone within %s. Ask an admin to revert it.",
+				service.DecisionUndoWindow))
+		case errors.Is(err, types.ErrStatusChangeReverted):
+			ctx.Cancel("This decision was already reverted.")
+		case errors.Is(err, types.ErrStatusChangeSuperseded):
+			ctx.Cancel("A newer decision was made on this user. Please reload and try again.")
+		case errors.Is(err, types.ErrStatusChangeStale):
+			ctx.Cancel("This user was updated since the decision. Please reload and try again.")
+		default:
+			m.layout.logger.Error("Failed to revert decision",
+				zap.Error(err),
+				zap.Int64("changeID", change.ID))
+			ctx.Error("Failed to undo the decision. Please try again.")
+		}
+
+		return
+	}
+
+	// Reload the user with the restored state
+	user, err := m.layout.db.Service().User().GetUserByID(
+		ctx.Context(), strconv.FormatInt(revert.UserID, 10), types.UserFieldAll,
+	)
+	if err != nil {
+		m.layout.logger.Error("Failed to reload reverted user", zap.Error(err))
+		ctx.Error("The decision was undone but the user could not be reloaded.")
+
+		return
+	}
+
+	session.UserTarget.Set(s, user)
+	session.OriginalUserReasons.Set(s, user.Reasons)
+	session.UnsavedUserReasons.Delete(s)
+	session.ReasonsChanged.Delete(s)
+
+	// Bring the D1 database in line with the restored state
+	message := fmt.Sprintf("Decision undone. User restored to %s.", user.Status.String())
+
+	if err := m.layout.cfClient.UserFlags.Revert(ctx.Context(), user); err != nil {
+		m.layout.logger.Error("Failed to sync reverted user to D1 database",
+			zap.Error(err),
+			zap.Int64("userID", user.ID))
+
+		message += " Warning: the public flags could not be updated and may be out of date."
+	}
+
+	ctx.Reload(message)
+
+	// Log the revert action
+	m.layout.db.Model().Activity().Log(ctx.Context(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: user.ID,
+		},
+		ReviewerID:        reviewerID,
+		ActivityType:      enum.ActivityTypeUserDecisionReverted,
+		ActivityTimestamp: time.Now(),
+		Details: map[string]any{
+			"changeId":         revert.ID,
+			"revertedChangeId": change.ID,
+			"revertedReviewer": change.ReviewerID,
+			"fromStatus":       revert.Before.Status.String(),
+			"toStatus":         revert.After.Status.String(),
+		},
+	})
+}
+
+// handleDecisionVote moves on after a vote that could not be applied yet and logs the vote.
+func (m *ReviewMenu) handleDecisionVote(
+	ctx *interaction.Context, s *session.Session, user *types.ReviewUser, vote *service.VoteResult,
+) {
+	activityType := enum.ActivityTypeUserDecisionVoted
+	message := "Vote recorded. Another reviewer must agree before the decision is applied."
+
+	if vote.Outcome == service.VoteOutcomeEscalated {
+		activityType = enum.ActivityTypeUserDecisionEscalated
+		message = "Your vote disagrees with the first reviewer. The decision was escalated to admins."
+	}
+
+	// Navigate to next user in history or fetch new one
+	m.UpdateCounters(s)
+	m.navigateAfterAction(ctx, s, message)
+
+	// Log the vote
+	details := map[string]any{
+		"reasons":    user.Reasons.Messages(),
+		"confidence": user.Confidence,
+	}
+	maps.Copy(details, vote.LogDetails())
+
+	m.layout.db.Model().Activity().Log(ctx.Context(), &types.ActivityLog{
+		ActivityTarget: types.ActivityTarget{
+			UserID: user.ID,
+		},
+		ReviewerID:        uint64(ctx.Event().User().ID),
+		ActivityType:      activityType,
+		ActivityTimestamp: time.Now(),
+		Details:           details,
 	})
 }
 
+// handleGoldAnswer moves on after an answer on a gold item the same way a regular
+// decision would, so reviewers cannot tell gold items apart.
+func (m *ReviewMenu) handleGoldAnswer(ctx *interaction.Context, s *session.Session, verb string) {
+	flaggedCount, err := m.layout.db.Model().User().GetFlaggedUsersCount(ctx.Context())
+	if err != nil {
+		m.layout.logger.Error("Failed to get flagged users count", zap.Error(err))
+	}
+
+	m.UpdateCounters(s)
+	m.navigateAfterAction(ctx, s, fmt.Sprintf("User %s. %d users left to review.", verb, flaggedCount))
+}
+
 // navigateAfterAction handles navigation after confirming or clearing a user.
 // It tries to navigate to the next user in history, or fetches a new user if at the end.
 func (m *ReviewMenu) navigateAfterAction(ctx *interaction.Context, s *session.Session, message string) {
@@ -879,12 +1182,24 @@ func (m *ReviewMenu) fetchNewTarget(ctx *interaction.Context, s *session.Session
 
 	// Get the next user to review
 	reviewerID := uint64(ctx.Event().User().ID)
+	isAdmin := s.BotSettings().IsAdmin(reviewerID)
 	defaultSort := session.UserUserDefaultSort.Get(s)
 	reviewTargetMode := session.UserReviewTargetMode.Get(s)
 
-	user, err := m.layout.db.Service().User().GetUserToReview(
-		ctx.Context(), defaultSort, reviewTargetMode, reviewerID,
-	)
+	var user *types.ReviewUser
+
+	err := m.ClaimNextTarget(ctx, s, types.DecisionTargetUser, func(claimedIDs []int64) (int64, error) {
+		var err error
+
+		user, err = m.layout.db.Service().User().GetUserToReview(
+			ctx.Context(), defaultSort, reviewTargetMode, reviewerID, isAdmin, claimedIDs, shared.ActiveFilterPreset(s),
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		return user.ID, nil
+	})
 	if err != nil {
 		return nil, err
 	}
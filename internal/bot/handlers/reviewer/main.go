@@ -79,6 +79,24 @@ func (m *Menu) Show(ctx *interaction.Context, s *session.Session) {
 		return
 	}
 
+	// Fetch gold item accuracy for the same period
+	accuracies, err := m.layout.db.Service().Gold().GetReviewerAccuracies(ctx.Context(), reviewerIDs, period)
+	if err != nil {
+		m.layout.logger.Error("Failed to get reviewer accuracies", zap.Error(err))
+
+		accuracies = map[uint64]*types.ReviewerAccuracy{} // Continue without accuracy - not critical
+	}
+
+	// Fetch decision pace for the same period
+	fatigues, err := m.layout.db.Service().Reviewer().GetReviewerFatigue(
+		ctx.Context(), s.BotSettings(), reviewerIDs, period,
+	)
+	if err != nil {
+		m.layout.logger.Error("Failed to get reviewer fatigue", zap.Error(err))
+
+		fatigues = map[uint64]*types.ReviewerFatigue{} // Continue without fatigue - not critical
+	}
+
 	// Map reviewer IDs to usernames
 	usernames := make(map[uint64]string)
 
@@ -93,6 +111,8 @@ func (m *Menu) Show(ctx *interaction.Context, s *session.Session) {
 	// Store results in session
 	session.ReviewerStats.Set(s, stats)
 	session.ReviewerUsernames.Set(s, usernames)
+	session.ReviewerAccuracies.Set(s, accuracies)
+	session.ReviewerFatigues.Set(s, fatigues)
 	session.ReviewerStatsNextCursor.Set(s, nextCursor)
 	session.PaginationHasNextPage.Set(s, nextCursor != nil)
 	session.PaginationHasPrevPage.Set(s, cursor != nil)
@@ -3,6 +3,7 @@ package status
 import (
 	"github.com/redis/rueidis"
 	"github.com/robalyx/rotector/internal/bot/core/interaction"
+	"github.com/robalyx/rotector/internal/database"
 	"github.com/robalyx/rotector/internal/redis"
 	"github.com/robalyx/rotector/internal/setup"
 	"github.com/robalyx/rotector/internal/worker/core"
@@ -11,6 +12,7 @@ import (
 
 // Layout handles the display and interaction logic for the worker status menu.
 type Layout struct {
+	db            database.Client
 	redisClient   rueidis.Client
 	workerMonitor *core.Monitor
 	menu          *Menu
@@ -27,6 +29,7 @@ func New(app *setup.App) *Layout {
 
 	// Initialize layout
 	l := &Layout{
+		db:            app.DB,
 		redisClient:   statusClient,
 		logger:        app.Logger.Named("status_menu"),
 		workerMonitor: core.NewMonitor(statusClient, app.Logger),
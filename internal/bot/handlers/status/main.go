@@ -1,11 +1,14 @@
 package status
 
 import (
+	"time"
+
 	"github.com/disgoorg/disgo/discord"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/interaction"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	view "github.com/robalyx/rotector/internal/bot/views/status"
+	"github.com/robalyx/rotector/internal/worker/core"
 	"go.uber.org/zap"
 )
 
@@ -38,8 +41,17 @@ func (m *Menu) Show(ctx *interaction.Context, s *session.Session) {
 		m.layout.logger.Error("Failed to get worker statuses", zap.Error(err))
 	}
 
+	// Get worker history for the status window
+	history, err := m.layout.db.Model().Worker().GetHistory(
+		ctx.Context(), time.Now().Add(-constants.WorkerHistoryWindow), core.CrashLoopWindow,
+	)
+	if err != nil {
+		m.layout.logger.Error("Failed to get worker history", zap.Error(err))
+	}
+
 	// Store data in session
 	session.StatusWorkers.Set(s, workerStatuses)
+	session.StatusWorkerHistory.Set(s, history)
 }
 
 // handleButton processes button interactions, mainly handling refresh requests.
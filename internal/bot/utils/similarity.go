@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// MatchedFragment is a run of words that appears in more than one text.
+type MatchedFragment struct {
+	Text    string // Lowercase words of the fragment
+	Sources []int  // Indexes of the texts that contain the fragment
+}
+
+// FindMatchedFragments finds runs of at least minWords words that appear in two or more texts.
+// Overlapping runs shared by the same texts are merged, and the longest fragments come first.
+func FindMatchedFragments(texts []string, minWords int) []MatchedFragment {
+	if minWords < 1 {
+		minWords = 1
+	}
+
+	// Split texts into lowercase words without surrounding punctuation
+	words := make([][]string, len(texts))
+	for i, text := range texts {
+		for _, word := range strings.Fields(strings.ToLower(text)) {
+			word = strings.TrimFunc(word, func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+			})
+			if word != "" {
+				words[i] = append(words[i], word)
+			}
+		}
+	}
+
+	// Record which texts contain each run of words
+	sources := make(map[string][]int)
+
+	for i := range words {
+		for start := 0; start+minWords <= len(words[i]); start++ {
+			key := strings.Join(words[i][start:start+minWords], " ")
+			if !slices.Contains(sources[key], i) {
+				sources[key] = append(sources[key], i)
+			}
+		}
+	}
+
+	// Merge consecutive shared runs into fragments
+	seen := make(map[string]struct{})
+
+	var fragments []MatchedFragment
+
+	for i := range words {
+		start := 0
+		for start+minWords <= len(words[i]) {
+			shared := sources[strings.Join(words[i][start:start+minWords], " ")]
+			if len(shared) < 2 {
+				start++
+				continue
+			}
+
+			end := start + 1
+			for end+minWords <= len(words[i]) &&
+				slices.Equal(sources[strings.Join(words[i][end:end+minWords], " ")], shared) {
+				end++
+			}
+
+			text := strings.Join(words[i][start:end+minWords-1], " ")
+			if _, exists := seen[text]; !exists {
+				seen[text] = struct{}{}
+				fragments = append(fragments, MatchedFragment{Text: text, Sources: shared})
+			}
+
+			start = end
+		}
+	}
+
+	slices.SortStableFunc(fragments, func(a, b MatchedFragment) int {
+		return len(b.Text) - len(a.Text)
+	})
+
+	return fragments
+}
+
+// JaccardSimilarity calculates the overlap between two sets of values from 0 to 1.
+// Returns 0 if both sets are empty.
+func JaccardSimilarity[T comparable](a, b []T) float64 {
+	setA := make(map[T]struct{}, len(a))
+	for _, value := range a {
+		setA[value] = struct{}{}
+	}
+
+	setB := make(map[T]struct{}, len(b))
+	for _, value := range b {
+		setB[value] = struct{}{}
+	}
+
+	intersection := 0
+
+	for value := range setA {
+		if _, exists := setB[value]; exists {
+			intersection++
+		}
+	}
+
+	union := len(setA) + len(setB) - intersection
+	if union == 0 {
+		return 0
+	}
+
+	return float64(intersection) / float64(union)
+}
@@ -0,0 +1,99 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindMatchedFragments(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		texts    []string
+		minWords int
+		want     []utils.MatchedFragment
+	}{
+		{
+			name:     "no shared words",
+			texts:    []string{"hello there friend", "something else entirely"},
+			minWords: 2,
+			want:     nil,
+		},
+		{
+			name:     "merges overlapping runs",
+			texts:    []string{"Add me on the other app, thanks!", "pls add me on the other app"},
+			minWords: 3,
+			want: []utils.MatchedFragment{
+				{Text: "add me on the other app", Sources: []int{0, 1}},
+			},
+		},
+		{
+			name:     "tracks every source",
+			texts:    []string{"dm me for trades", "no trades here", "DM me for trades now"},
+			minWords: 2,
+			want: []utils.MatchedFragment{
+				{Text: "dm me for trades", Sources: []int{0, 2}},
+			},
+		},
+		{
+			name:     "repeated words in one text",
+			texts:    []string{"same same same", "different text"},
+			minWords: 1,
+			want:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := utils.FindMatchedFragments(tt.texts, tt.minWords)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestJaccardSimilarity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		a    []int64
+		b    []int64
+		want float64
+	}{
+		{
+			name: "both empty",
+			want: 0,
+		},
+		{
+			name: "identical",
+			a:    []int64{1, 2, 3},
+			b:    []int64{3, 2, 1},
+			want: 1,
+		},
+		{
+			name: "partial overlap",
+			a:    []int64{1, 2, 3},
+			b:    []int64{2, 3, 4},
+			want: 0.5,
+		},
+		{
+			name: "duplicates ignored",
+			a:    []int64{1, 1, 2},
+			b:    []int64{1},
+			want: 0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.InDelta(t, tt.want, utils.JaccardSimilarity(tt.a, tt.b), 0.0001)
+		})
+	}
+}
@@ -21,6 +21,18 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 		discord.NewStringSelectMenuOption("Bot Settings", constants.BotSettingsButtonCustomID).
 			WithEmoji(discord.ComponentEmoji{Name: "⚙️"}).
 			WithDescription("Configure bot-wide settings"),
+		discord.NewStringSelectMenuOption("Suspected Games", constants.SuspectedGamesButtonCustomID).
+			WithEmoji(discord.ComponentEmoji{Name: "🎮"}).
+			WithDescription("Review games favorited by many flagged users"),
+		discord.NewStringSelectMenuOption("Worker Control", constants.WorkerControlButtonCustomID).
+			WithEmoji(discord.ComponentEmoji{Name: "🛠️"}).
+			WithDescription("Pause, drain or reconfigure running workers"),
+		discord.NewStringSelectMenuOption("Reviewer Workload", constants.ReviewerWorkloadButtonCustomID).
+			WithEmoji(discord.ComponentEmoji{Name: "📋"}).
+			WithDescription("View review claims and assign categories or clusters to reviewers"),
+		discord.NewStringSelectMenuOption("Rule Suggestions", constants.RuleSuggestionsButtonCustomID).
+			WithEmoji(discord.ComponentEmoji{Name: "💡"}).
+			WithDescription("Approve detection rules suggested by reviewers"),
 		discord.NewStringSelectMenuOption("Delete Roblox User", constants.DeleteUserButtonCustomID).
 			WithEmoji(discord.ComponentEmoji{Name: "🗑️"}).
 			WithDescription("Delete a Roblox user from the database"),
@@ -0,0 +1,129 @@
+package admin
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/worker/core"
+)
+
+// ControlBuilder creates the visual layout for the worker control menu.
+type ControlBuilder struct {
+	states       []*core.ControlState
+	selectedType string
+}
+
+// NewControlBuilder creates a new worker control builder.
+func NewControlBuilder(s *session.Session) *ControlBuilder {
+	return &ControlBuilder{
+		states:       session.AdminWorkerControls.Get(s),
+		selectedType: session.AdminWorkerControlType.Get(s),
+	}
+}
+
+// Build creates a Discord message showing worker control state and actions.
+func (b *ControlBuilder) Build() *discord.MessageUpdateBuilder {
+	var content strings.Builder
+
+	content.WriteString("## Worker Control\n")
+	content.WriteString("Changes apply at each worker's next checkpoint. ")
+	content.WriteString("AI concurrency limits are fixed at startup and cannot be overridden.\n")
+
+	var selected *core.ControlState
+
+	for _, state := range b.states {
+		if state.WorkerType == b.selectedType {
+			selected = state
+		}
+
+		status := "🟢 Running"
+		if state.Paused {
+			status = "⏸️ Paused"
+		}
+
+		if !state.DrainAt.IsZero() {
+			status += fmt.Sprintf(" • 🛑 Drain requested <t:%d:R>", state.DrainAt.Unix())
+		}
+
+		content.WriteString(fmt.Sprintf("\n**%s** — %s", state.WorkerType, status))
+
+		if len(state.Overrides) > 0 {
+			content.WriteString(fmt.Sprintf(" • %d override(s)", len(state.Overrides)))
+		}
+	}
+
+	components := []discord.ContainerSubComponent{
+		discord.NewTextDisplay(content.String()),
+		discord.NewLargeSeparator(),
+	}
+
+	// Add details for the selected worker type
+	if selected != nil {
+		var details strings.Builder
+
+		details.WriteString(fmt.Sprintf("### Selected: %s\n", selected.WorkerType))
+
+		if len(selected.Overrides) == 0 {
+			details.WriteString("No config overrides\n")
+		} else {
+			keys := make([]string, 0, len(selected.Overrides))
+			for key := range selected.Overrides {
+				keys = append(keys, key)
+			}
+
+			slices.Sort(keys)
+
+			for _, key := range keys {
+				details.WriteString(fmt.Sprintf("- `%s` = `%s`\n", key, selected.Overrides[key]))
+			}
+		}
+
+		if !selected.UpdatedAt.IsZero() {
+			details.WriteString(fmt.Sprintf("-# Last updated <t:%d:R> by %s", selected.UpdatedAt.Unix(), selected.UpdatedBy))
+		}
+
+		components = append(components, discord.NewTextDisplay(details.String()))
+	}
+
+	// Add worker type selector
+	options := make([]discord.StringSelectMenuOption, 0, len(core.WorkerTypes))
+	for _, workerType := range core.WorkerTypes {
+		options = append(options, discord.NewStringSelectMenuOption(workerType, workerType).
+			WithDefault(workerType == b.selectedType))
+	}
+
+	paused := selected != nil && selected.Paused
+
+	components = append(components,
+		discord.NewActionRow(
+			discord.NewStringSelectMenu(constants.WorkerControlTypeSelectMenuCustomID, "Select Worker Type", options...),
+		),
+		discord.NewActionRow(
+			discord.NewSecondaryButton("⏸️ Pause", constants.WorkerPauseButtonCustomID).WithDisabled(paused),
+			discord.NewSecondaryButton("▶️ Resume", constants.WorkerResumeButtonCustomID),
+			discord.NewSecondaryButton("⚡ Run Now", constants.WorkerRunNowButtonCustomID),
+			discord.NewDangerButton("🛑 Drain", constants.WorkerDrainButtonCustomID),
+		),
+		discord.NewActionRow(
+			discord.NewSecondaryButton("✏️ Set Override", constants.WorkerOverrideButtonCustomID),
+			discord.NewSecondaryButton("🧹 Clear Overrides", constants.WorkerClearOverridesButtonCustomID).
+				WithDisabled(selected == nil || len(selected.Overrides) == 0),
+		),
+	)
+
+	mainContainer := discord.NewContainer(components...).
+		WithAccentColor(constants.DefaultContainerColor)
+
+	return discord.NewMessageUpdateBuilder().
+		AddComponents(
+			mainContainer,
+			discord.NewActionRow(
+				discord.NewSecondaryButton("◀️ Back", constants.BackButtonCustomID),
+				discord.NewSecondaryButton("🔄 Refresh", constants.RefreshButtonCustomID),
+			),
+		)
+}
@@ -0,0 +1,89 @@
+package admin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/database/types"
+)
+
+// GamesBuilder creates the visual layout for the suspected games list.
+type GamesBuilder struct {
+	games      []*types.GameReputation
+	page       int
+	offset     int
+	totalItems int
+	totalPages int
+}
+
+// NewGamesBuilder creates a new suspected games builder.
+func NewGamesBuilder(s *session.Session) *GamesBuilder {
+	return &GamesBuilder{
+		games:      session.AdminSuspectedGames.Get(s),
+		page:       session.PaginationPage.Get(s),
+		offset:     session.PaginationOffset.Get(s),
+		totalItems: session.PaginationTotalItems.Get(s),
+		totalPages: session.PaginationTotalPages.Get(s),
+	}
+}
+
+// Build creates a Discord message listing suspected games.
+func (b *GamesBuilder) Build() *discord.MessageUpdateBuilder {
+	components := []discord.ContainerSubComponent{
+		discord.NewTextDisplay("## Suspected Games\nGames favorited by an unusually high density of flagged users"),
+		discord.NewLargeSeparator(),
+	}
+
+	if len(b.games) == 0 {
+		components = append(components, discord.NewTextDisplay("No suspected games found"))
+	} else {
+		var content strings.Builder
+
+		for _, game := range b.games {
+			// Place links need the root place ID since the reputation is keyed by universe ID
+			if game.RootPlaceID > 0 {
+				content.WriteString(fmt.Sprintf("### [%s](https://www.roblox.com/games/%d)\n",
+					game.Name, game.RootPlaceID))
+			} else {
+				content.WriteString(fmt.Sprintf("### %s\n", game.Name))
+			}
+			content.WriteString(fmt.Sprintf(
+				"-# Score: %.0f%% • Flagged Favoriters: %d • Visits: %d • Density: %.2f/1k • Updated <t:%d:R>\n",
+				game.Score*100,
+				game.FlaggedUsers,
+				game.PlaceVisits,
+				game.Density,
+				game.LastCalculated.Unix(),
+			))
+		}
+
+		end := b.offset + len(b.games)
+		content.WriteString(fmt.Sprintf("\n-# Page %d/%d • Showing %d-%d of %d games",
+			b.page+1, b.totalPages+1, b.offset+1, end, b.totalItems))
+
+		components = append(components, discord.NewTextDisplay(content.String()))
+	}
+
+	// Add navigation buttons
+	components = append(components, discord.NewActionRow(
+		discord.NewSecondaryButton("⏮️", string(session.ViewerFirstPage)).WithDisabled(b.page == 0),
+		discord.NewSecondaryButton("◀️", string(session.ViewerPrevPage)).WithDisabled(b.page == 0),
+		discord.NewSecondaryButton("▶️", string(session.ViewerNextPage)).WithDisabled(b.page >= b.totalPages),
+		discord.NewSecondaryButton("⏭️", string(session.ViewerLastPage)).WithDisabled(b.page >= b.totalPages),
+	))
+
+	mainContainer := discord.NewContainer(components...).
+		WithAccentColor(constants.DefaultContainerColor)
+
+	return discord.NewMessageUpdateBuilder().
+		AddComponents(
+			mainContainer,
+			discord.NewActionRow(
+				discord.NewSecondaryButton("◀️ Back", constants.BackButtonCustomID),
+				discord.NewSecondaryButton("🔄 Refresh", constants.RefreshButtonCustomID),
+			),
+		)
+}
@@ -0,0 +1,136 @@
+package admin
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/database/types"
+)
+
+// RulesBuilder creates the visual layout for the rule suggestion approval queue.
+type RulesBuilder struct {
+	rules      []*types.DetectionRule
+	page       int
+	offset     int
+	totalItems int
+	totalPages int
+}
+
+// NewRulesBuilder creates a new rule suggestions builder.
+func NewRulesBuilder(s *session.Session) *RulesBuilder {
+	return &RulesBuilder{
+		rules:      session.AdminRuleSuggestions.Get(s),
+		page:       session.PaginationPage.Get(s),
+		offset:     session.PaginationOffset.Get(s),
+		totalItems: session.PaginationTotalItems.Get(s),
+		totalPages: session.PaginationTotalPages.Get(s),
+	}
+}
+
+// Build creates a Discord message listing the pending rule suggestions.
+func (b *RulesBuilder) Build() *discord.MessageUpdateBuilder {
+	components := []discord.ContainerSubComponent{
+		discord.NewTextDisplay("## Rule Suggestions\nCandidate detection rules suggested by reviewers. " +
+			"Hit counts show how many existing flagged and cleared targets each term matches."),
+		discord.NewLargeSeparator(),
+	}
+
+	if len(b.rules) == 0 {
+		components = append(components, discord.NewTextDisplay("No pending rule suggestions"))
+	} else {
+		var content strings.Builder
+
+		for _, rule := range b.rules {
+			content.WriteString(fmt.Sprintf("### #%d `%s`\n", rule.ID, utils.TruncateString(rule.Term, 100)))
+			content.WriteString(fmt.Sprintf("Scope: %s • Suggested by <@%d> <t:%d:R> from %s\n",
+				rule.Scope, rule.SuggestedBy, rule.CreatedAt.Unix(), ruleSourceLink(rule)))
+
+			if rule.Notes != "" {
+				content.WriteString(fmt.Sprintf("> %s\n", utils.NormalizeString(rule.Notes)))
+			}
+
+			if rule.BacktestedAt.IsZero() {
+				content.WriteString("-# Not back-tested yet\n")
+			} else {
+				content.WriteString(fmt.Sprintf(
+					"-# Flagged Hits: %d • Cleared Hits: %d • Precision: %.0f%% • Confidence: %.0f%% • "+
+						"Tested <t:%d:R>\n",
+					rule.FlaggedHits, rule.ClearedHits, rule.Precision()*100, rule.MatchConfidence()*100,
+					rule.BacktestedAt.Unix()))
+			}
+		}
+
+		end := b.offset + len(b.rules)
+		content.WriteString(fmt.Sprintf("\n-# Page %d/%d • Showing %d-%d of %d rules",
+			b.page+1, b.totalPages+1, b.offset+1, end, b.totalItems))
+
+		components = append(components,
+			discord.NewTextDisplay(content.String()),
+			discord.NewActionRow(
+				discord.NewStringSelectMenu(constants.RuleSuggestionSelectMenuCustomID, "Review a rule",
+					b.buildActionOptions()...),
+			),
+		)
+	}
+
+	// Add navigation buttons
+	components = append(components, discord.NewActionRow(
+		discord.NewSecondaryButton("⏮️", string(session.ViewerFirstPage)).WithDisabled(b.page == 0),
+		discord.NewSecondaryButton("◀️", string(session.ViewerPrevPage)).WithDisabled(b.page == 0),
+		discord.NewSecondaryButton("▶️", string(session.ViewerNextPage)).WithDisabled(b.page >= b.totalPages),
+		discord.NewSecondaryButton("⏭️", string(session.ViewerLastPage)).WithDisabled(b.page >= b.totalPages),
+	))
+
+	mainContainer := discord.NewContainer(components...).
+		WithAccentColor(constants.DefaultContainerColor)
+
+	return discord.NewMessageUpdateBuilder().
+		AddComponents(
+			mainContainer,
+			discord.NewActionRow(
+				discord.NewSecondaryButton("◀️ Back", constants.BackButtonCustomID),
+				discord.NewSecondaryButton("🔄 Refresh", constants.RefreshButtonCustomID),
+			),
+		)
+}
+
+// buildActionOptions creates the enable, reject and re-test options for each rule on the page.
+func (b *RulesBuilder) buildActionOptions() []discord.StringSelectMenuOption {
+	options := make([]discord.StringSelectMenuOption, 0, len(b.rules)*3)
+
+	for _, rule := range b.rules {
+		term := utils.TruncateString(rule.Term, 50)
+		options = append(options,
+			discord.NewStringSelectMenuOption(
+				fmt.Sprintf("Enable #%d", rule.ID), fmt.Sprintf("%s:%d", constants.RuleActionEnable, rule.ID),
+			).
+				WithEmoji(discord.ComponentEmoji{Name: "✅"}).
+				WithDescription(term),
+			discord.NewStringSelectMenuOption(
+				fmt.Sprintf("Reject #%d", rule.ID), fmt.Sprintf("%s:%d", constants.RuleActionReject, rule.ID),
+			).
+				WithEmoji(discord.ComponentEmoji{Name: "❌"}).
+				WithDescription(term),
+			discord.NewStringSelectMenuOption(
+				fmt.Sprintf("Re-test #%d", rule.ID), fmt.Sprintf("%s:%d", constants.RuleActionRetest, rule.ID),
+			).
+				WithEmoji(discord.ComponentEmoji{Name: "🔄"}).
+				WithDescription(term),
+		)
+	}
+
+	return options
+}
+
+// ruleSourceLink returns a link to the target the rule was suggested from.
+func ruleSourceLink(rule *types.DetectionRule) string {
+	if rule.SourceType == types.DecisionTargetGroup {
+		return fmt.Sprintf("[group %d](https://www.roblox.com/communities/%d)", rule.SourceID, rule.SourceID)
+	}
+
+	return fmt.Sprintf("[user %d](https://www.roblox.com/users/%d/profile)", rule.SourceID, rule.SourceID)
+}
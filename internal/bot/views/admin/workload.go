@@ -0,0 +1,114 @@
+package admin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/database/types"
+)
+
+// WorkloadBuilder creates the visual layout for the reviewer workload menu.
+type WorkloadBuilder struct {
+	workloads []*types.ReviewerWorkload
+}
+
+// NewWorkloadBuilder creates a new reviewer workload builder.
+func NewWorkloadBuilder(s *session.Session) *WorkloadBuilder {
+	return &WorkloadBuilder{
+		workloads: session.AdminReviewerWorkloads.Get(s),
+	}
+}
+
+// Build creates a Discord message showing reviewer assignments and active claims.
+func (b *WorkloadBuilder) Build() *discord.MessageUpdateBuilder {
+	var content strings.Builder
+
+	content.WriteString("## Reviewer Workload\n")
+	content.WriteString("Reviewers pull members of their assigned clusters first, ")
+	content.WriteString("then users from their assigned categories. ")
+	content.WriteString("Other reviewers still get these users once the assigned reviewers are done with them.\n")
+
+	if len(b.workloads) == 0 {
+		content.WriteString("\nNo reviewers configured")
+	}
+
+	for _, workload := range b.workloads {
+		content.WriteString(fmt.Sprintf("\n**%s** (`%d`)\n", workload.Username, workload.ReviewerID))
+
+		if len(workload.Categories) == 0 {
+			content.WriteString("-# Categories: None\n")
+		} else {
+			names := make([]string, len(workload.Categories))
+			for i, category := range workload.Categories {
+				names[i] = category.String()
+			}
+
+			content.WriteString(fmt.Sprintf("-# Categories: %s • %d pending\n",
+				strings.Join(names, ", "), workload.PendingUsers))
+		}
+
+		if len(workload.Clusters) == 0 {
+			content.WriteString("-# Clusters: None\n")
+		} else {
+			groups := make([]string, len(workload.Clusters))
+			for i, groupID := range workload.Clusters {
+				groups[i] = fmt.Sprintf("`%d`", groupID)
+			}
+
+			content.WriteString(fmt.Sprintf("-# Clusters: members of %s\n", strings.Join(groups, ", ")))
+		}
+
+		claims := make([]string, 0, 2)
+		if workload.ClaimedUserID != 0 {
+			claims = append(claims, fmt.Sprintf("user `%d`", workload.ClaimedUserID))
+		}
+
+		if workload.ClaimedGroupID != 0 {
+			claims = append(claims, fmt.Sprintf("group `%d`", workload.ClaimedGroupID))
+		}
+
+		if len(claims) == 0 {
+			content.WriteString("-# Claimed: Nothing\n")
+		} else {
+			content.WriteString(fmt.Sprintf("-# Claimed: %s\n", strings.Join(claims, ", ")))
+		}
+	}
+
+	components := []discord.ContainerSubComponent{
+		discord.NewTextDisplay(content.String()),
+	}
+
+	// Add reviewer selector for editing assignments
+	if len(b.workloads) > 0 {
+		options := make([]discord.StringSelectMenuOption, 0, min(len(b.workloads), 25))
+		for _, workload := range b.workloads[:min(len(b.workloads), 25)] {
+			options = append(options, discord.NewStringSelectMenuOption(
+				workload.Username, strconv.FormatUint(workload.ReviewerID, 10),
+			).WithDescription(strconv.FormatUint(workload.ReviewerID, 10)))
+		}
+
+		components = append(components,
+			discord.NewLargeSeparator(),
+			discord.NewActionRow(
+				discord.NewStringSelectMenu(constants.WorkloadReviewerSelectMenuCustomID,
+					"Assign categories or clusters to a reviewer", options...),
+			),
+		)
+	}
+
+	mainContainer := discord.NewContainer(components...).
+		WithAccentColor(constants.DefaultContainerColor)
+
+	return discord.NewMessageUpdateBuilder().
+		AddComponents(
+			mainContainer,
+			discord.NewActionRow(
+				discord.NewSecondaryButton("◀️ Back", constants.BackButtonCustomID),
+				discord.NewSecondaryButton("🔄 Refresh", constants.RefreshButtonCustomID),
+			),
+		)
+}
@@ -0,0 +1,176 @@
+package command
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+)
+
+// CheckBuilder creates the response of the message check command.
+type CheckBuilder struct {
+	userIDs     []int64
+	groupIDs    []int64
+	users       map[int64]*types.ReviewUser
+	groups      map[int64]*types.ReviewGroup
+	isReviewer  bool
+	privacyMode bool
+}
+
+// NewCheckBuilder creates a new message check builder.
+func NewCheckBuilder(
+	userIDs, groupIDs []int64, users map[int64]*types.ReviewUser, groups map[int64]*types.ReviewGroup,
+	isReviewer, privacyMode bool,
+) *CheckBuilder {
+	return &CheckBuilder{
+		userIDs:     userIDs,
+		groupIDs:    groupIDs,
+		users:       users,
+		groups:      groups,
+		isReviewer:  isReviewer,
+		privacyMode: privacyMode,
+	}
+}
+
+// Build creates a Discord message with the status of every user and group found in the message.
+func (b *CheckBuilder) Build() *discord.MessageUpdateBuilder {
+	components := []discord.ContainerSubComponent{
+		discord.NewTextDisplay(fmt.Sprintf("## 🔍 Rotector Check\n-# Found %d users and %d groups in this message",
+			len(b.userIDs), len(b.groupIDs))),
+	}
+
+	unknownUsers := make([]int64, 0, len(b.userIDs))
+
+	for _, userID := range b.userIDs {
+		user, exists := b.users[userID]
+		if !exists {
+			unknownUsers = append(unknownUsers, userID)
+		}
+
+		components = append(components, discord.NewTextDisplay(b.buildUserSection(userID, user)))
+	}
+
+	for _, groupID := range b.groupIDs {
+		components = append(components, discord.NewTextDisplay(b.buildGroupSection(groupID, b.groups[groupID])))
+	}
+
+	// Offer to queue users that are not tracked yet
+	if customID, count := BuildCheckQueueCustomID(unknownUsers); count > 0 {
+		components = append(components,
+			discord.NewLargeSeparator(),
+			discord.NewActionRow(
+				discord.NewPrimaryButton(fmt.Sprintf("📥 Queue %d Unknown Users", count), customID),
+			),
+		)
+	}
+
+	return discord.NewMessageUpdateBuilder().
+		AddComponents(discord.NewContainer(components...).
+			WithAccentColor(utils.GetContainerColor(b.privacyMode)))
+}
+
+// buildUserSection formats the status of a single user.
+func (b *CheckBuilder) buildUserSection(userID int64, user *types.ReviewUser) string {
+	id := strconv.FormatInt(userID, 10)
+
+	if user == nil {
+		return fmt.Sprintf("### 👤 User `%s`\nNot in the database", utils.CensorString(id, b.privacyMode))
+	}
+
+	var content strings.Builder
+
+	content.WriteString(fmt.Sprintf("### 👤 %s (`%s`)\nStatus: %s",
+		utils.CensorString(user.Name, b.privacyMode), utils.CensorString(id, b.privacyMode), user.Status.String()))
+
+	if user.Status == enum.UserTypeFlagged || user.Status == enum.UserTypeConfirmed {
+		content.WriteString(fmt.Sprintf(" • Category: %s • Confidence: %.0f%%",
+			user.Category.String(), user.Confidence*100))
+	}
+
+	content.WriteString("\n" + buildCheckReasons(user.Reasons, b.isReviewer, b.privacyMode, id, user.Name))
+
+	return utils.TruncateString(content.String(), constants.CheckSectionLength)
+}
+
+// buildGroupSection formats the status of a single group.
+func (b *CheckBuilder) buildGroupSection(groupID int64, group *types.ReviewGroup) string {
+	id := strconv.FormatInt(groupID, 10)
+
+	if group == nil {
+		return fmt.Sprintf("### 👥 Group `%s`\nNot in the database", utils.CensorString(id, b.privacyMode))
+	}
+
+	var content strings.Builder
+
+	content.WriteString(fmt.Sprintf("### 👥 %s (`%s`)\nStatus: %s",
+		utils.CensorString(group.Name, b.privacyMode), utils.CensorString(id, b.privacyMode), group.Status.String()))
+
+	if group.Status == enum.GroupTypeFlagged || group.Status == enum.GroupTypeConfirmed {
+		content.WriteString(fmt.Sprintf(" • Confidence: %.0f%%", group.Confidence*100))
+	}
+
+	content.WriteString("\n" + buildCheckReasons(group.Reasons, b.isReviewer, b.privacyMode, id, group.Name))
+
+	return utils.TruncateString(content.String(), constants.CheckSectionLength)
+}
+
+// BuildCheckQueueCustomID encodes as many user IDs as fit into the custom ID of the queue button.
+// Returns the custom ID and the number of IDs it holds.
+func BuildCheckQueueCustomID(userIDs []int64) (string, int) {
+	customID := constants.CheckQueueButtonCustomID
+	count := 0
+
+	for _, userID := range userIDs {
+		next := customID + ":" + strconv.FormatInt(userID, 10)
+		if count > 0 {
+			next = customID + "," + strconv.FormatInt(userID, 10)
+		}
+
+		if len(next) > constants.CheckCustomIDLength {
+			break
+		}
+
+		customID = next
+		count++
+	}
+
+	return customID, count
+}
+
+// buildCheckReasons lists the reason types of a target. Reviewers also see a short message per reason.
+func buildCheckReasons[T types.ReasonType](
+	reasons types.Reasons[T], isReviewer, privacyMode bool, sensitiveInfo ...string,
+) string {
+	if len(reasons) == 0 {
+		return "-# No reasons"
+	}
+
+	reasonTypes := slices.Sorted(maps.Keys(reasons))
+
+	if !isReviewer {
+		names := make([]string, len(reasonTypes))
+		for i, reasonType := range reasonTypes {
+			names[i] = "`" + reasonType.String() + "`"
+		}
+
+		return "Reasons: " + strings.Join(names, ", ")
+	}
+
+	var content strings.Builder
+
+	for _, reasonType := range reasonTypes {
+		message := utils.CensorStringsInText(reasons[reasonType].Message, privacyMode, sensitiveInfo...)
+		message = utils.TruncateString(utils.NormalizeString(message), 80)
+
+		content.WriteString(fmt.Sprintf("- **%s** %s\n", reasonType.String(), message))
+	}
+
+	return content.String()
+}
@@ -0,0 +1,256 @@
+package command
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/bot/views/review/shared"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+	"github.com/robalyx/rotector/internal/roblox/fetcher"
+)
+
+// UserBuilder creates a compact summary of a Roblox user for the lookup command.
+type UserBuilder struct {
+	shared.BaseReviewBuilder
+
+	user *types.ReviewUser
+}
+
+// NewUserBuilder creates a new user lookup builder.
+func NewUserBuilder(
+	user *types.ReviewUser, comments []*types.Comment, botSettings *types.BotSetting, privacyMode bool,
+) *UserBuilder {
+	return &UserBuilder{
+		BaseReviewBuilder: shared.BaseReviewBuilder{
+			BotSettings: botSettings,
+			Comments:    comments,
+			IsReviewer:  true,
+			PrivacyMode: privacyMode,
+		},
+		user: user,
+	}
+}
+
+// Build creates a Discord message with the user summary.
+func (b *UserBuilder) Build() *discord.MessageUpdateBuilder {
+	var content strings.Builder
+
+	content.WriteString(fmt.Sprintf("## %s (%s)\n",
+		utils.CensorString(b.user.Name, b.PrivacyMode),
+		utils.CensorString(b.user.DisplayName, b.PrivacyMode)))
+	content.WriteString(fmt.Sprintf("-# ID: `%s` • Status: %s",
+		utils.CensorString(strconv.FormatInt(b.user.ID, 10), b.PrivacyMode), b.user.Status.String()))
+
+	if b.user.Status == enum.UserTypeFlagged || b.user.Status == enum.UserTypeConfirmed {
+		content.WriteString(fmt.Sprintf(" • Category: %s • Confidence: %.0f%%",
+			b.user.Category.String(), b.user.Confidence*100))
+	}
+
+	if b.user.IsBanned {
+		content.WriteString(" • 🔨 Banned")
+	}
+
+	if b.user.IsDeleted {
+		content.WriteString(" • 🗑️ Deleted")
+	}
+
+	content.WriteString(fmt.Sprintf("\n-# Updated: <t:%d:R>", b.user.LastUpdated.Unix()))
+
+	content.WriteString("\n" + buildReasonsText(b.user.Reasons, b.PrivacyMode,
+		strconv.FormatInt(b.user.ID, 10), b.user.Name, b.user.DisplayName))
+
+	if comments := b.BuildReviewerCommentsText(); comments != "" {
+		content.WriteString("\n" + comments)
+	}
+
+	// Show the thumbnail only when one exists and privacy mode is off
+	var summary discord.ContainerSubComponent = discord.NewTextDisplay(content.String())
+	if b.user.ThumbnailURL != "" && b.user.ThumbnailURL != fetcher.ThumbnailPlaceholder && !b.PrivacyMode {
+		summary = discord.NewSection(discord.NewTextDisplay(content.String())).
+			WithAccessory(discord.NewThumbnail(b.user.ThumbnailURL))
+	}
+
+	return buildLookupMessage(summary,
+		discord.NewLinkButton("View Profile", fmt.Sprintf("https://www.roblox.com/users/%d/profile", b.user.ID)).
+			WithEmoji(discord.ComponentEmoji{Name: "🔗"}).
+			WithDisabled(b.PrivacyMode),
+		b.PrivacyMode)
+}
+
+// GroupBuilder creates a compact summary of a Roblox group for the lookup command.
+type GroupBuilder struct {
+	shared.BaseReviewBuilder
+
+	group *types.ReviewGroup
+}
+
+// NewGroupBuilder creates a new group lookup builder.
+func NewGroupBuilder(
+	group *types.ReviewGroup, comments []*types.Comment, botSettings *types.BotSetting, privacyMode bool,
+) *GroupBuilder {
+	return &GroupBuilder{
+		BaseReviewBuilder: shared.BaseReviewBuilder{
+			BotSettings: botSettings,
+			Comments:    comments,
+			IsReviewer:  true,
+			PrivacyMode: privacyMode,
+		},
+		group: group,
+	}
+}
+
+// Build creates a Discord message with the group summary.
+func (b *GroupBuilder) Build() *discord.MessageUpdateBuilder {
+	var content strings.Builder
+
+	content.WriteString(fmt.Sprintf("## %s\n", utils.CensorString(b.group.Name, b.PrivacyMode)))
+	content.WriteString(fmt.Sprintf("-# ID: `%s` • Status: %s",
+		utils.CensorString(strconv.FormatInt(b.group.ID, 10), b.PrivacyMode), b.group.Status.String()))
+
+	if b.group.Status == enum.GroupTypeFlagged || b.group.Status == enum.GroupTypeConfirmed {
+		content.WriteString(fmt.Sprintf(" • Confidence: %.0f%%", b.group.Confidence*100))
+	}
+
+	if b.group.IsLocked {
+		content.WriteString(" • 🔒 Locked")
+	}
+
+	if b.group.IsDeleted {
+		content.WriteString(" • 🗑️ Deleted")
+	}
+
+	if b.group.Owner != nil {
+		content.WriteString(fmt.Sprintf("\n-# Owner: %s (`%s`)",
+			utils.CensorString(b.group.Owner.Username, b.PrivacyMode),
+			utils.CensorString(strconv.FormatInt(b.group.Owner.UserID, 10), b.PrivacyMode)))
+	}
+
+	content.WriteString(fmt.Sprintf("\n-# Updated: <t:%d:R>", b.group.LastUpdated.Unix()))
+
+	content.WriteString("\n" + buildReasonsText(b.group.Reasons, b.PrivacyMode,
+		strconv.FormatInt(b.group.ID, 10), b.group.Name))
+
+	if comments := b.BuildReviewerCommentsText(); comments != "" {
+		content.WriteString("\n" + comments)
+	}
+
+	return buildLookupMessage(discord.NewTextDisplay(content.String()),
+		discord.NewLinkButton("View Group", fmt.Sprintf("https://www.roblox.com/communities/%d", b.group.ID)).
+			WithEmoji(discord.ComponentEmoji{Name: "🔗"}).
+			WithDisabled(b.PrivacyMode),
+		b.PrivacyMode)
+}
+
+// DiscordBuilder creates a compact summary of a Discord user for the lookup command.
+type DiscordBuilder struct {
+	userID         uint64
+	userGuilds     []*types.UserGuildInfo
+	guildNames     map[uint64]string
+	messageSummary *types.InappropriateUserSummary
+	totalGuilds    int
+	isDataRedacted bool
+}
+
+// NewDiscordBuilder creates a new Discord user lookup builder.
+func NewDiscordBuilder(
+	userID uint64, userGuilds []*types.UserGuildInfo, guildNames map[uint64]string,
+	messageSummary *types.InappropriateUserSummary, totalGuilds int, isDataRedacted bool,
+) *DiscordBuilder {
+	return &DiscordBuilder{
+		userID:         userID,
+		userGuilds:     userGuilds,
+		guildNames:     guildNames,
+		messageSummary: messageSummary,
+		totalGuilds:    totalGuilds,
+		isDataRedacted: isDataRedacted,
+	}
+}
+
+// Build creates a Discord message with the Discord user summary.
+func (b *DiscordBuilder) Build() *discord.MessageUpdateBuilder {
+	var content strings.Builder
+
+	content.WriteString(fmt.Sprintf("## Discord User <@%d>\n", b.userID))
+	content.WriteString(fmt.Sprintf("-# ID: `%d` • Flagged Servers: %d", b.userID, b.totalGuilds))
+
+	if b.isDataRedacted {
+		content.WriteString(" • 🗑️ Data Redacted")
+	}
+
+	if b.messageSummary != nil {
+		content.WriteString(fmt.Sprintf("\n### Recent Activity\nTotal Messages: `%d` • Last Flagged: <t:%d:R>\nReason: `%s`",
+			b.messageSummary.MessageCount, b.messageSummary.LastDetected.Unix(), b.messageSummary.Reason))
+	}
+
+	content.WriteString("\n### Server Memberships\n")
+
+	if len(b.userGuilds) == 0 {
+		content.WriteString("This user is not a member of any flagged servers in our database.")
+	}
+
+	for _, guild := range b.userGuilds {
+		guildName := b.guildNames[guild.ServerID]
+		if guildName == "" {
+			guildName = constants.UnknownServer
+		}
+
+		joinedInfo := "Unknown"
+		if !guild.JoinedAt.IsZero() {
+			joinedInfo = fmt.Sprintf("<t:%d:R>", guild.JoinedAt.Unix())
+		}
+
+		content.WriteString(fmt.Sprintf("- %s (`%d`) • Joined %s\n", guildName, guild.ServerID, joinedInfo))
+	}
+
+	if remaining := b.totalGuilds - len(b.userGuilds); remaining > 0 {
+		content.WriteString(fmt.Sprintf("-# and %d more servers", remaining))
+	}
+
+	return discord.NewMessageUpdateBuilder().
+		AddComponents(discord.NewContainer(
+			discord.NewTextDisplay(content.String()),
+		).WithAccentColor(constants.DefaultContainerColor))
+}
+
+// buildLookupMessage wraps a lookup summary and its link button in a container.
+func buildLookupMessage(
+	summary discord.ContainerSubComponent, link discord.InteractiveComponent, privacyMode bool,
+) *discord.MessageUpdateBuilder {
+	container := discord.NewContainer(
+		summary,
+		discord.NewActionRow(link),
+	).WithAccentColor(utils.GetContainerColor(privacyMode))
+
+	return discord.NewMessageUpdateBuilder().
+		AddComponents(container)
+}
+
+// buildReasonsText formats one line per reason with its confidence and a short message.
+func buildReasonsText[T types.ReasonType](
+	reasons types.Reasons[T], privacyMode bool, sensitiveInfo ...string,
+) string {
+	if len(reasons) == 0 {
+		return "### Reasons\nNo reasons have been added yet."
+	}
+
+	var content strings.Builder
+	content.WriteString("### Reasons\n")
+
+	for _, reasonType := range slices.Sorted(maps.Keys(reasons)) {
+		reason := reasons[reasonType]
+		message := utils.CensorStringsInText(reason.Message, privacyMode, sensitiveInfo...)
+		message = utils.TruncateString(message, constants.CommandReasonLength)
+		message = utils.FormatString(message)
+
+		content.WriteString(fmt.Sprintf("**%s** [%.0f%%] %s\n", reasonType.String(), reason.Confidence*100, message))
+	}
+
+	return content.String()
+}
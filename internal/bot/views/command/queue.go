@@ -0,0 +1,38 @@
+package command
+
+import (
+	"fmt"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/views/queue"
+	"github.com/robalyx/rotector/internal/cloudflare/manager"
+)
+
+// QueueBuilder creates the response of the queue command.
+type QueueBuilder struct {
+	userID int64
+	result string
+	stats  *manager.Stats
+}
+
+// NewQueueBuilder creates a new queue command builder.
+func NewQueueBuilder(userID int64, result string, stats *manager.Stats) *QueueBuilder {
+	return &QueueBuilder{
+		userID: userID,
+		result: result,
+		stats:  stats,
+	}
+}
+
+// Build creates a Discord message with the queue result and statistics.
+func (b *QueueBuilder) Build() *discord.MessageUpdateBuilder {
+	container := discord.NewContainer(
+		discord.NewTextDisplay(fmt.Sprintf("## 📥 Queue User `%d`\n%s", b.userID, b.result)),
+		discord.NewLargeSeparator(),
+		discord.NewTextDisplay(queue.FormatQueueStats(b.stats)),
+	).WithAccentColor(constants.DefaultContainerColor)
+
+	return discord.NewMessageUpdateBuilder().
+		AddComponents(container)
+}
@@ -232,6 +232,12 @@ func (b *Builder) buildActivityTypeOptions() []discord.StringSelectMenuOption {
 			WithDefault(b.activityTypeFilter == enum.ActivityTypeUserQueued),
 		discord.NewStringSelectMenuOption("User Deleted", strconv.Itoa(int(enum.ActivityTypeUserDeleted))).
 			WithDefault(b.activityTypeFilter == enum.ActivityTypeUserDeleted),
+		discord.NewStringSelectMenuOption("User Decision Voted", strconv.Itoa(int(enum.ActivityTypeUserDecisionVoted))).
+			WithDefault(b.activityTypeFilter == enum.ActivityTypeUserDecisionVoted),
+		discord.NewStringSelectMenuOption("User Decision Escalated", strconv.Itoa(int(enum.ActivityTypeUserDecisionEscalated))).
+			WithDefault(b.activityTypeFilter == enum.ActivityTypeUserDecisionEscalated),
+		discord.NewStringSelectMenuOption("User Decision Reverted", strconv.Itoa(int(enum.ActivityTypeUserDecisionReverted))).
+			WithDefault(b.activityTypeFilter == enum.ActivityTypeUserDecisionReverted),
 	}
 
 	groupOptions := []discord.StringSelectMenuOption{
@@ -249,6 +255,12 @@ func (b *Builder) buildActivityTypeOptions() []discord.StringSelectMenuOption {
 			WithDefault(b.activityTypeFilter == enum.ActivityTypeGroupSkipped),
 		discord.NewStringSelectMenuOption("Group Deleted", strconv.Itoa(int(enum.ActivityTypeGroupDeleted))).
 			WithDefault(b.activityTypeFilter == enum.ActivityTypeGroupDeleted),
+		discord.NewStringSelectMenuOption("Group Decision Voted", strconv.Itoa(int(enum.ActivityTypeGroupDecisionVoted))).
+			WithDefault(b.activityTypeFilter == enum.ActivityTypeGroupDecisionVoted),
+		discord.NewStringSelectMenuOption("Group Decision Escalated", strconv.Itoa(int(enum.ActivityTypeGroupDecisionEscalated))).
+			WithDefault(b.activityTypeFilter == enum.ActivityTypeGroupDecisionEscalated),
+		discord.NewStringSelectMenuOption("Group Decision Reverted", strconv.Itoa(int(enum.ActivityTypeGroupDecisionReverted))).
+			WithDefault(b.activityTypeFilter == enum.ActivityTypeGroupDecisionReverted),
 	}
 
 	otherOptions := []discord.StringSelectMenuOption{
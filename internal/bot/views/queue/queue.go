@@ -72,9 +72,14 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 		),
 	)
 
-	// Add group review section only for admins
+	// Add group sections only for admins
 	if b.isAdmin {
 		mainInfoDisplays = append(mainInfoDisplays,
+			discord.NewSection(
+				discord.NewTextDisplay("### 👥 Queue Group Members\nQueue every member of a group, and optionally its allies, for processing"),
+			).WithAccessory(
+				discord.NewPrimaryButton("Queue Group", constants.QueueGroupButtonCustomID),
+			),
 			discord.NewSection(
 				discord.NewTextDisplay("### 🔍 Direct Group Review\nImmediately fetch and review a group's profile"),
 			).WithAccessory(
@@ -114,7 +119,12 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 
 // buildQueueStats formats the queue statistics.
 func (b *Builder) buildQueueStats() string {
-	if b.stats == nil {
+	return FormatQueueStats(b.stats)
+}
+
+// FormatQueueStats formats the queue statistics section.
+func FormatQueueStats(stats *manager.Stats) string {
+	if stats == nil {
 		return "### 📊 Queue Statistics\n*No statistics available.*"
 	}
 
@@ -123,9 +133,9 @@ func (b *Builder) buildQueueStats() string {
 			"**Total Items:** %d\n"+
 			"**Processing:** %d\n"+
 			"**Pending:** %d",
-		b.stats.TotalItems,
-		b.stats.Processing,
-		b.stats.Unprocessed,
+		stats.TotalItems,
+		stats.Processing,
+		stats.Unprocessed,
 	)
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/disgoorg/disgo/discord"
 	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
@@ -14,6 +15,7 @@ import (
 	"github.com/robalyx/rotector/internal/bot/utils"
 	"github.com/robalyx/rotector/internal/bot/views/review/shared"
 	"github.com/robalyx/rotector/internal/database"
+	"github.com/robalyx/rotector/internal/database/service"
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
 	"github.com/robalyx/rotector/internal/roblox/fetcher"
@@ -26,6 +28,7 @@ type ReviewBuilder struct {
 	db             database.Client
 	group          *types.ReviewGroup
 	groupInfo      *apiTypes.GroupResponse
+	latestChange   *types.GroupStatusChange
 	unsavedReasons map[enum.GroupReasonType]struct{}
 	flaggedCount   int
 	defaultSort    enum.ReviewSortBy
@@ -38,22 +41,27 @@ func NewReviewBuilder(s *session.Session, db database.Client) *ReviewBuilder {
 
 	return &ReviewBuilder{
 		BaseReviewBuilder: shared.BaseReviewBuilder{
-			BotSettings:    s.BotSettings(),
-			Logs:           session.ReviewLogs.Get(s),
-			Comments:       session.ReviewComments.Get(s),
-			ReviewMode:     reviewMode,
-			ReviewHistory:  session.GroupReviewHistory.Get(s),
-			UserID:         userID,
-			HistoryIndex:   session.GroupReviewHistoryIndex.Get(s),
-			LogsHasMore:    session.ReviewLogsHasMore.Get(s),
-			ReasonsChanged: session.ReasonsChanged.Get(s),
-			IsReviewer:     s.BotSettings().IsReviewer(userID),
-			IsAdmin:        s.BotSettings().IsAdmin(userID),
-			PrivacyMode:    session.UserStreamerMode.Get(s),
+			BotSettings:     s.BotSettings(),
+			Logs:            session.ReviewLogs.Get(s),
+			Comments:        session.ReviewComments.Get(s),
+			PendingDecision: session.ReviewPendingDecision.Get(s),
+			GoldItem:        session.ReviewGoldItem.Get(s),
+			FilterPresets:   session.UserFilterPresets.Get(s),
+			ActivePreset:    session.UserActiveFilterPreset.Get(s),
+			ReviewMode:      reviewMode,
+			ReviewHistory:   session.GroupReviewHistory.Get(s),
+			UserID:          userID,
+			HistoryIndex:    session.GroupReviewHistoryIndex.Get(s),
+			LogsHasMore:     session.ReviewLogsHasMore.Get(s),
+			ReasonsChanged:  session.ReasonsChanged.Get(s),
+			IsReviewer:      s.BotSettings().IsReviewer(userID),
+			IsAdmin:         s.BotSettings().IsAdmin(userID),
+			PrivacyMode:     session.UserStreamerMode.Get(s),
 		},
 		db:             db,
 		group:          session.GroupTarget.Get(s),
 		groupInfo:      session.GroupInfo.Get(s),
+		latestChange:   session.GroupLatestStatusChange.Get(s),
 		unsavedReasons: session.UnsavedGroupReasons.Get(s),
 		flaggedCount:   session.GroupFlaggedMembersCount.Get(s),
 		defaultSort:    session.UserGroupDefaultSort.Get(s),
@@ -128,6 +136,10 @@ func (b *ReviewBuilder) Build() *discord.MessageUpdateBuilder {
 		discord.NewActionRow(
 			discord.NewStringSelectMenu(constants.SortOrderSelectMenuCustomID, "Sorting", b.BuildSortingOptions(b.defaultSort)...),
 		),
+		discord.NewActionRow(
+			discord.NewStringSelectMenu(constants.FilterPresetSelectMenuCustomID, "Filter Preset",
+				b.BuildFilterPresetOptions()...),
+		),
 		discord.NewActionRow(
 			discord.NewStringSelectMenu(constants.ActionSelectMenuCustomID, "Other Actions", b.buildActionOptions()...),
 		),
@@ -177,6 +189,11 @@ func (b *ReviewBuilder) buildStatusDisplay() []discord.ContainerSubComponent {
 		content.WriteString("\n\n## ⚠️ Active Review Warning\n" + warningDisplay)
 	}
 
+	// Add notice if a decision is awaiting consensus
+	if consensusDisplay := b.BuildConsensusText("group"); consensusDisplay != "" {
+		content.WriteString("\n\n" + consensusDisplay)
+	}
+
 	// Add comments if any exist
 	if len(b.Comments) > 0 {
 		content.WriteString("\n\n" + b.BuildCommentsText())
@@ -387,11 +404,25 @@ func (b *ReviewBuilder) buildActionOptions() []discord.StringSelectMenuOption {
 			discord.NewStringSelectMenuOption("View group logs", constants.GroupViewLogsButtonCustomID).
 				WithEmoji(discord.ComponentEmoji{Name: "📋"}).
 				WithDescription("View activity logs for this group"),
+			discord.NewStringSelectMenuOption("Suggest detection rule", constants.SuggestRuleButtonCustomID).
+				WithEmoji(discord.ComponentEmoji{Name: "💡"}).
+				WithDescription("Promote evidence or a term to a candidate rule"),
 			discord.NewStringSelectMenuOption("Change Review Mode", constants.ReviewModeOption).
 				WithEmoji(discord.ComponentEmoji{Name: "🗳️"}).
 				WithDescription("Switch between voting and standard modes"),
 		}
 		options = append(options, reviewerOptions...)
+
+		if b.canUndoDecision() {
+			from := b.latestChange.Before.Status.String()
+			to := b.latestChange.After.Status.String()
+
+			options = append(options,
+				discord.NewStringSelectMenuOption("Undo Last Decision", constants.UndoDecisionButtonCustomID).
+					WithEmoji(discord.ComponentEmoji{Name: "↩️"}).
+					WithDescription(fmt.Sprintf("Revert this group from %s back to %s", to, from)),
+			)
+		}
 	}
 
 	// Add admin-only options
@@ -402,6 +433,7 @@ func (b *ReviewBuilder) buildActionOptions() []discord.StringSelectMenuOption {
 				WithDescription("Permanently delete this group from the system"),
 		}
 		options = append(options, adminOptions...)
+		options = append(options, b.BuildGoldOptions(types.DecisionActionConfirm, types.DecisionActionMix)...)
 	}
 
 	// Add last default option
@@ -414,6 +446,21 @@ func (b *ReviewBuilder) buildActionOptions() []discord.StringSelectMenuOption {
 	return options
 }
 
+// canUndoDecision checks if the viewer may undo the latest decision on the group.
+// Reviewers may undo their own decisions within the undo window while admins may revert any.
+func (b *ReviewBuilder) canUndoDecision() bool {
+	change := b.latestChange
+	if change == nil || change.IsReverted() {
+		return false
+	}
+
+	if b.IsAdmin {
+		return true
+	}
+
+	return change.ReviewerID == b.UserID && time.Since(change.CreatedAt) <= service.DecisionUndoWindow
+}
+
 // buildReasonOptions creates the reason management options.
 func (b *ReviewBuilder) buildReasonOptions() []discord.StringSelectMenuOption {
 	reasonTypes := []enum.GroupReasonType{
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -22,19 +23,23 @@ const (
 
 // BaseReviewBuilder contains common fields used by both user and group review builders.
 type BaseReviewBuilder struct {
-	BotSettings    *types.BotSetting
-	Logs           []*types.ActivityLog
-	Comments       []*types.Comment
-	ReviewMode     enum.ReviewMode
-	ReviewHistory  []int64
-	UserID         uint64
-	HistoryIndex   int
-	LogsHasMore    bool
-	ReasonsChanged bool
-	IsReviewer     bool
-	IsAdmin        bool
-	TrainingMode   bool
-	PrivacyMode    bool
+	BotSettings     *types.BotSetting
+	Logs            []*types.ActivityLog
+	Comments        []*types.Comment
+	PendingDecision *types.PendingDecision
+	GoldItem        *types.GoldItem
+	FilterPresets   []types.ReviewFilterPreset
+	ActivePreset    string
+	ReviewMode      enum.ReviewMode
+	ReviewHistory   []int64
+	UserID          uint64
+	HistoryIndex    int
+	LogsHasMore     bool
+	ReasonsChanged  bool
+	IsReviewer      bool
+	IsAdmin         bool
+	TrainingMode    bool
+	PrivacyMode     bool
 }
 
 // BuildCommentsText creates the comments text content.
@@ -141,6 +146,41 @@ func (b *BaseReviewBuilder) BuildSortingOptions(defaultSort enum.ReviewSortBy) [
 	}
 }
 
+// BuildFilterPresetOptions creates the options for switching between saved filter presets.
+func (b *BaseReviewBuilder) BuildFilterPresetOptions() []discord.StringSelectMenuOption {
+	options := []discord.StringSelectMenuOption{
+		discord.NewStringSelectMenuOption("No filter preset", constants.FilterPresetNoneOption).
+			WithDefault(b.ActivePreset == "").
+			WithEmoji(discord.ComponentEmoji{Name: "🌐"}),
+	}
+
+	for i, preset := range b.FilterPresets {
+		option := discord.NewStringSelectMenuOption("Preset: "+preset.Name, strconv.Itoa(i)).
+			WithDefault(b.ActivePreset == preset.Name).
+			WithEmoji(discord.ComponentEmoji{Name: "🔎"})
+
+		if ranges := preset.FormatRanges(); ranges != "" {
+			option = option.WithDescription(utils.TruncateString(ranges, 100))
+		}
+
+		options = append(options, option)
+	}
+
+	options = append(options,
+		discord.NewStringSelectMenuOption("Save new preset", constants.FilterPresetCreateOption).
+			WithEmoji(discord.ComponentEmoji{Name: "➕"}),
+	)
+
+	if b.ActivePreset != "" {
+		options = append(options,
+			discord.NewStringSelectMenuOption("Delete selected preset", constants.FilterPresetDeleteOption).
+				WithEmoji(discord.ComponentEmoji{Name: "🗑️"}),
+		)
+	}
+
+	return options
+}
+
 // BuildNavigationButtons creates navigation buttons for review menus.
 func (b *BaseReviewBuilder) BuildNavigationButtons() []discord.InteractiveComponent {
 	prevButton := discord.NewSecondaryButton("⬅️ Prev", constants.PrevReviewButtonCustomID)
@@ -204,6 +244,40 @@ func (b *BaseReviewBuilder) BuildCommentOptions() []discord.StringSelectMenuOpti
 	return options
 }
 
+// BuildGoldOptions creates the admin options for managing the gold item of the target.
+// Each action is offered as an expected verdict unless it is already the expected one.
+func (b *BaseReviewBuilder) BuildGoldOptions(actions ...types.DecisionAction) []discord.StringSelectMenuOption {
+	if !b.IsAdmin || b.TrainingMode {
+		return nil
+	}
+
+	var options []discord.StringSelectMenuOption
+
+	for _, action := range actions {
+		if b.GoldItem != nil && b.GoldItem.ExpectedAction == action {
+			continue
+		}
+
+		options = append(options,
+			discord.NewStringSelectMenuOption(
+				fmt.Sprintf("Mark as Gold (%s)", action), constants.GoldMarkOptionPrefix+string(action),
+			).
+				WithEmoji(discord.ComponentEmoji{Name: "🥇"}).
+				WithDescription(fmt.Sprintf("Grade reviewers against the %s verdict", action)),
+		)
+	}
+
+	if b.GoldItem != nil {
+		options = append(options,
+			discord.NewStringSelectMenuOption("Remove from Gold Set", constants.GoldRemoveButtonCustomID).
+				WithEmoji(discord.ComponentEmoji{Name: "🥇"}).
+				WithDescription(fmt.Sprintf("Stop grading reviewers (expects %s)", b.GoldItem.ExpectedAction)),
+		)
+	}
+
+	return options
+}
+
 // BuildBaseComponents creates the common base components for review menus.
 func (b *BaseReviewBuilder) BuildBaseComponents(
 	sortOptions []discord.StringSelectMenuOption,
@@ -332,6 +406,42 @@ func (b *BaseReviewBuilder) BuildReviewWarningText(targetType string, activityTy
 		targetType)
 }
 
+// BuildConsensusText creates the notice for a decision awaiting a second reviewer or an admin.
+func (b *BaseReviewBuilder) BuildConsensusText(targetType string) string {
+	decision := b.PendingDecision
+	if decision == nil {
+		return ""
+	}
+
+	switch decision.Status {
+	case types.DecisionStatusPending:
+		if decision.FirstReviewerID == b.UserID {
+			return fmt.Sprintf("## ⚖️ Awaiting Second Reviewer\nYou voted to **%s** this %s <t:%d:R>. "+
+				"Another reviewer must agree before it is applied (%s).",
+				decision.FirstAction, targetType, decision.FirstVotedAt.Unix(), decision.Reason)
+		}
+
+		return fmt.Sprintf("## ⚖️ Awaiting Second Reviewer\n<@%d> voted to **%s** this %s <t:%d:R>. "+
+			"Your decision will be applied only if it agrees, otherwise it is escalated to admins (%s).",
+			decision.FirstReviewerID, decision.FirstAction, targetType, decision.FirstVotedAt.Unix(), decision.Reason)
+
+	case types.DecisionStatusEscalated:
+		text := fmt.Sprintf("## 🚨 Escalated Decision\n<@%d> voted to **%s** and <@%d> voted to **%s** this %s.",
+			decision.FirstReviewerID, decision.FirstAction,
+			decision.SecondReviewerID, decision.SecondAction, targetType)
+		_, voted := decision.Votes()[b.UserID]
+		if b.IsAdmin && !voted {
+			return text + " Your decision as an admin will be final."
+		}
+
+		return text + " An admin must make the final decision."
+
+	case types.DecisionStatusAgreed, types.DecisionStatusResolved:
+	}
+
+	return ""
+}
+
 // BuildSingleReasonDisplay creates a display for a single reason with evidence.
 func BuildSingleReasonDisplay[T types.ReasonType](
 	privacyMode bool, reasonType T, reason *types.Reason, maxLength int, sensitiveInfo ...string,
@@ -0,0 +1,279 @@
+package user
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/disgoorg/disgo/discord"
+	"github.com/robalyx/rotector/internal/bot/constants"
+	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+)
+
+// CompareBuilder creates the visual layout for comparing suspected alts side by side.
+type CompareBuilder struct {
+	users        []*types.ReviewUser
+	isReviewer   bool
+	trainingMode bool
+	privacyMode  bool
+}
+
+// NewCompareBuilder creates a new comparison builder.
+func NewCompareBuilder(s *session.Session) *CompareBuilder {
+	trainingMode := session.UserReviewMode.Get(s) == enum.ReviewModeTraining
+
+	return &CompareBuilder{
+		users:        session.UserCompareUsers.Get(s),
+		isReviewer:   s.BotSettings().IsReviewer(session.UserID.Get(s)),
+		trainingMode: trainingMode,
+		privacyMode:  trainingMode || session.UserStreamerMode.Get(s),
+	}
+}
+
+// Build creates a Discord message comparing the users.
+func (b *CompareBuilder) Build() *discord.MessageUpdateBuilder {
+	components := []discord.ContainerSubComponent{
+		discord.NewTextDisplay(fmt.Sprintf("## %s\n-# Comparing %d users side by side",
+			constants.UserComparePageName, len(b.users))),
+		discord.NewLargeSeparator(),
+	}
+
+	for i, user := range b.users {
+		components = append(components, discord.NewTextDisplay(b.buildUserSection(i, user)))
+	}
+
+	components = append(components,
+		discord.NewLargeSeparator(),
+		discord.NewTextDisplay(b.buildFragmentSection()),
+		discord.NewTextDisplay(b.buildFriendSection()),
+		discord.NewTextDisplay(b.buildGroupSection()),
+		discord.NewTextDisplay(b.buildOutfitSection()),
+	)
+
+	// Decisions apply to every compared user
+	disabled := !b.isReviewer || b.trainingMode
+
+	builder := discord.NewMessageUpdateBuilder()
+	builder.AddComponents(
+		discord.NewContainer(components...).WithAccentColor(utils.GetContainerColor(b.privacyMode)),
+		discord.NewActionRow(
+			discord.NewSecondaryButton("◀️ Back", constants.BackButtonCustomID),
+			discord.NewDangerButton(fmt.Sprintf("Confirm All (%d)", len(b.users)),
+				constants.CompareConfirmAllButtonCustomID).WithDisabled(disabled),
+			discord.NewSuccessButton(fmt.Sprintf("Clear All (%d)", len(b.users)),
+				constants.CompareClearAllButtonCustomID).WithDisabled(disabled),
+		),
+	)
+
+	return builder
+}
+
+// buildUserSection formats the summary of a single compared user.
+func (b *CompareBuilder) buildUserSection(index int, user *types.ReviewUser) string {
+	var content strings.Builder
+
+	content.WriteString(fmt.Sprintf("### %s %s (`%s`)\n",
+		compareLabel(index),
+		utils.CensorString(user.Name, b.privacyMode),
+		utils.CensorString(strconv.FormatInt(user.ID, 10), b.privacyMode)))
+	content.WriteString(fmt.Sprintf("Status: %s • Category: %s • Confidence: %.0f%%\n",
+		user.Status.String(), user.Category.String(), user.Confidence*100))
+	content.WriteString(fmt.Sprintf("-# Created <t:%d:R> • %d friends • %d groups • %d outfits\n",
+		user.CreatedAt.Unix(), len(user.Friends), len(user.Groups), len(user.Outfits)))
+
+	description := utils.NormalizeString(user.Description)
+	if description == "" {
+		description = constants.NotApplicable
+	}
+
+	description = utils.CensorStringsInText(description, b.privacyMode,
+		strconv.FormatInt(user.ID, 10), user.Name, user.DisplayName)
+	content.WriteString("> " + utils.TruncateString(description, 200))
+
+	return content.String()
+}
+
+// buildFragmentSection lists the description fragments shared by the users.
+func (b *CompareBuilder) buildFragmentSection() string {
+	descriptions := make([]string, len(b.users))
+	for i, user := range b.users {
+		descriptions[i] = user.Description
+	}
+
+	fragments := utils.FindMatchedFragments(descriptions, constants.CompareFragmentMinWords)
+
+	var content strings.Builder
+
+	content.WriteString("### 📝 Matched Description Fragments\n")
+
+	if len(fragments) == 0 {
+		content.WriteString("-# No shared fragments")
+		return content.String()
+	}
+
+	// Descriptions often mention the names of the accounts themselves
+	sensitiveInfo := make([]string, 0, len(b.users)*3)
+	for _, user := range b.users {
+		sensitiveInfo = append(sensitiveInfo, strconv.FormatInt(user.ID, 10), user.Name, user.DisplayName)
+	}
+
+	for _, fragment := range fragments[:min(len(fragments), constants.CompareListLimit)] {
+		text := utils.CensorStringsInText(fragment.Text, b.privacyMode, sensitiveInfo...)
+		content.WriteString(fmt.Sprintf("- `%s` %s\n", utils.TruncateString(text, 80), compareLabels(fragment.Sources)))
+	}
+
+	return b.withOverflow(&content, len(fragments))
+}
+
+// buildFriendSection lists the friends shared by the users and friendships between them.
+func (b *CompareBuilder) buildFriendSection() string {
+	// Map friends to the users that have them
+	owners := make(map[int64][]int)
+	names := make(map[int64]string)
+
+	for i, user := range b.users {
+		for _, friend := range user.Friends {
+			if !slices.Contains(owners[friend.ID], i) {
+				owners[friend.ID] = append(owners[friend.ID], i)
+			}
+
+			names[friend.ID] = friend.Name
+		}
+	}
+
+	var content strings.Builder
+
+	content.WriteString("### 👥 Shared Friends\n")
+
+	// Direct friendships between compared users are the strongest signal
+	for i, user := range b.users {
+		if sources := owners[user.ID]; len(sources) > 0 {
+			content.WriteString(fmt.Sprintf("- %s is friends with %s\n", compareLabel(i), compareLabels(sources)))
+		}
+	}
+
+	return b.writeSharedList(&content, owners, names, "-# No shared friends")
+}
+
+// buildGroupSection lists the groups shared by the users.
+func (b *CompareBuilder) buildGroupSection() string {
+	// Map groups to the users that are members
+	owners := make(map[int64][]int)
+	names := make(map[int64]string)
+
+	for i, user := range b.users {
+		for _, group := range user.Groups {
+			if !slices.Contains(owners[group.Group.ID], i) {
+				owners[group.Group.ID] = append(owners[group.Group.ID], i)
+			}
+
+			names[group.Group.ID] = group.Group.Name
+		}
+	}
+
+	var content strings.Builder
+
+	content.WriteString("### 🌐 Shared Groups\n")
+
+	return b.writeSharedList(&content, owners, names, "-# No shared groups")
+}
+
+// buildOutfitSection lists the outfit similarity score of every pair of users.
+// The score is the overlap of all assets worn across the current avatar and saved outfits.
+func (b *CompareBuilder) buildOutfitSection() string {
+	assetIDs := make([][]int64, len(b.users))
+
+	for i, user := range b.users {
+		for _, asset := range user.CurrentAssets {
+			assetIDs[i] = append(assetIDs[i], asset.ID)
+		}
+
+		for _, assets := range user.OutfitAssets {
+			for _, asset := range assets {
+				assetIDs[i] = append(assetIDs[i], asset.ID)
+			}
+		}
+	}
+
+	var content strings.Builder
+
+	content.WriteString("### 👕 Outfit Similarity\n")
+
+	for i := range b.users {
+		for j := i + 1; j < len(b.users); j++ {
+			if len(assetIDs[i]) == 0 || len(assetIDs[j]) == 0 {
+				content.WriteString(fmt.Sprintf("- %s ↔ %s: no outfit data\n", compareLabel(i), compareLabel(j)))
+				continue
+			}
+
+			content.WriteString(fmt.Sprintf("- %s ↔ %s: %.0f%%\n",
+				compareLabel(i), compareLabel(j), utils.JaccardSimilarity(assetIDs[i], assetIDs[j])*100))
+		}
+	}
+
+	return content.String()
+}
+
+// writeSharedList writes the entries owned by more than one user, most shared first.
+func (b *CompareBuilder) writeSharedList(
+	content *strings.Builder, owners map[int64][]int, names map[int64]string, emptyMessage string,
+) string {
+	shared := make([]int64, 0, len(owners))
+
+	for id, sources := range owners {
+		if len(sources) > 1 {
+			shared = append(shared, id)
+		}
+	}
+
+	if len(shared) == 0 {
+		content.WriteString(emptyMessage)
+		return content.String()
+	}
+
+	slices.SortFunc(shared, func(a, b int64) int {
+		if diff := len(owners[b]) - len(owners[a]); diff != 0 {
+			return diff
+		}
+
+		return cmp.Compare(a, b)
+	})
+
+	for _, id := range shared[:min(len(shared), constants.CompareListLimit)] {
+		content.WriteString(fmt.Sprintf("- %s (`%s`) %s\n",
+			utils.CensorString(names[id], b.privacyMode),
+			utils.CensorString(strconv.FormatInt(id, 10), b.privacyMode),
+			compareLabels(owners[id])))
+	}
+
+	return b.withOverflow(content, len(shared))
+}
+
+// withOverflow notes how many entries were left out of a list.
+func (b *CompareBuilder) withOverflow(content *strings.Builder, total int) string {
+	if total > constants.CompareListLimit {
+		content.WriteString(fmt.Sprintf("-# ...and %d more", total-constants.CompareListLimit))
+	}
+
+	return content.String()
+}
+
+// compareLabel returns the letter used to refer to a compared user.
+func compareLabel(index int) string {
+	return "**" + string(rune('A'+index)) + "**"
+}
+
+// compareLabels formats the letters of several compared users.
+func compareLabels(indexes []int) string {
+	labels := make([]string, len(indexes))
+	for i, index := range indexes {
+		labels[i] = compareLabel(index)
+	}
+
+	return "[" + strings.Join(labels, ", ") + "]"
+}
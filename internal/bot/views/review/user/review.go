@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/robalyx/rotector/assets"
@@ -13,6 +14,7 @@ import (
 	"github.com/robalyx/rotector/internal/bot/utils"
 	"github.com/robalyx/rotector/internal/bot/views/review/shared"
 	"github.com/robalyx/rotector/internal/database"
+	"github.com/robalyx/rotector/internal/database/service"
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
 	"github.com/robalyx/rotector/internal/roblox/fetcher"
@@ -26,6 +28,7 @@ type ReviewBuilder struct {
 	user           *types.ReviewUser
 	flaggedFriends map[int64]*types.ReviewUser
 	flaggedGroups  map[int64]*types.ReviewGroup
+	latestChange   *types.UserStatusChange
 	unsavedReasons map[enum.UserReasonType]struct{}
 	defaultSort    enum.ReviewSortBy
 	trainingMode   bool
@@ -39,24 +42,29 @@ func NewReviewBuilder(s *session.Session, db database.Client) *ReviewBuilder {
 
 	return &ReviewBuilder{
 		BaseReviewBuilder: shared.BaseReviewBuilder{
-			BotSettings:    s.BotSettings(),
-			Logs:           session.ReviewLogs.Get(s),
-			Comments:       session.ReviewComments.Get(s),
-			ReviewMode:     reviewMode,
-			ReviewHistory:  session.UserReviewHistory.Get(s),
-			UserID:         userID,
-			HistoryIndex:   session.UserReviewHistoryIndex.Get(s),
-			LogsHasMore:    session.ReviewLogsHasMore.Get(s),
-			ReasonsChanged: session.ReasonsChanged.Get(s),
-			IsReviewer:     s.BotSettings().IsReviewer(userID),
-			IsAdmin:        s.BotSettings().IsAdmin(userID),
-			PrivacyMode:    trainingMode || session.UserStreamerMode.Get(s),
-			TrainingMode:   trainingMode,
+			BotSettings:     s.BotSettings(),
+			Logs:            session.ReviewLogs.Get(s),
+			Comments:        session.ReviewComments.Get(s),
+			PendingDecision: session.ReviewPendingDecision.Get(s),
+			GoldItem:        session.ReviewGoldItem.Get(s),
+			FilterPresets:   session.UserFilterPresets.Get(s),
+			ActivePreset:    session.UserActiveFilterPreset.Get(s),
+			ReviewMode:      reviewMode,
+			ReviewHistory:   session.UserReviewHistory.Get(s),
+			UserID:          userID,
+			HistoryIndex:    session.UserReviewHistoryIndex.Get(s),
+			LogsHasMore:     session.ReviewLogsHasMore.Get(s),
+			ReasonsChanged:  session.ReasonsChanged.Get(s),
+			IsReviewer:      s.BotSettings().IsReviewer(userID),
+			IsAdmin:         s.BotSettings().IsAdmin(userID),
+			PrivacyMode:     trainingMode || session.UserStreamerMode.Get(s),
+			TrainingMode:    trainingMode,
 		},
 		db:             db,
 		user:           session.UserTarget.Get(s),
 		flaggedFriends: session.UserFlaggedFriends.Get(s),
 		flaggedGroups:  session.UserFlaggedGroups.Get(s),
+		latestChange:   session.UserLatestStatusChange.Get(s),
 		unsavedReasons: session.UnsavedUserReasons.Get(s),
 		defaultSort:    session.UserUserDefaultSort.Get(s),
 		trainingMode:   trainingMode,
@@ -135,6 +143,10 @@ func (b *ReviewBuilder) Build() *discord.MessageUpdateBuilder {
 		discord.NewActionRow(
 			discord.NewStringSelectMenu(constants.SortOrderSelectMenuCustomID, "Sorting", b.BuildSortingOptions(b.defaultSort)...),
 		),
+		discord.NewActionRow(
+			discord.NewStringSelectMenu(constants.FilterPresetSelectMenuCustomID, "Filter Preset",
+				b.BuildFilterPresetOptions()...),
+		),
 		discord.NewActionRow(
 			discord.NewStringSelectMenu(constants.ActionSelectMenuCustomID, "Other Actions", b.buildActionOptions()...),
 		),
@@ -184,6 +196,11 @@ func (b *ReviewBuilder) buildStatusDisplay() []discord.ContainerSubComponent {
 		content.WriteString("\n\n## ⚠️ Active Review Warning\n" + warningDisplay)
 	}
 
+	// Add notice if a decision is awaiting consensus
+	if consensusDisplay := b.BuildConsensusText("user"); consensusDisplay != "" {
+		content.WriteString("\n\n" + consensusDisplay)
+	}
+
 	// Add comments if any exist
 	if len(b.Comments) > 0 {
 		content.WriteString("\n\n" + b.BuildCommentsText())
@@ -423,11 +440,30 @@ func (b *ReviewBuilder) buildActionOptions() []discord.StringSelectMenuOption {
 			discord.NewStringSelectMenuOption("View user logs", constants.ViewUserLogsButtonCustomID).
 				WithEmoji(discord.ComponentEmoji{Name: "📋"}).
 				WithDescription("View activity logs for this user"),
+			discord.NewStringSelectMenuOption("Compare with other users", constants.CompareUsersButtonCustomID).
+				WithEmoji(discord.ComponentEmoji{Name: "🪞"}).
+				WithDescription("Compare suspected alts side by side"),
+			discord.NewStringSelectMenuOption("Suggest detection rule", constants.SuggestRuleButtonCustomID).
+				WithEmoji(discord.ComponentEmoji{Name: "💡"}).
+				WithDescription("Promote evidence or a term to a candidate rule"),
 			discord.NewStringSelectMenuOption("Change Review Mode", constants.ReviewModeOption).
 				WithEmoji(discord.ComponentEmoji{Name: "🎓"}).
 				WithDescription("Switch between training and standard modes"),
 		}
 		options = append(options, reviewerOptions...)
+
+		if b.canUndoDecision() {
+			from := b.latestChange.Before.Status.String()
+			to := b.latestChange.After.Status.String()
+
+			options = append(options,
+				discord.NewStringSelectMenuOption("Undo Last Decision", constants.UndoDecisionButtonCustomID).
+					WithEmoji(discord.ComponentEmoji{Name: "↩️"}).
+					WithDescription(fmt.Sprintf("Revert this user from %s back to %s", to, from)),
+			)
+		}
+
+		options = append(options, b.BuildGoldOptions(types.DecisionActionConfirm, types.DecisionActionClear)...)
 	}
 
 	// Add last default option
@@ -440,6 +476,21 @@ func (b *ReviewBuilder) buildActionOptions() []discord.StringSelectMenuOption {
 	return options
 }
 
+// canUndoDecision checks if the viewer may undo the latest decision on the user.
+// Reviewers may undo their own decisions within the undo window while admins may revert any.
+func (b *ReviewBuilder) canUndoDecision() bool {
+	change := b.latestChange
+	if change == nil || change.IsReverted() || b.TrainingMode {
+		return false
+	}
+
+	if b.IsAdmin {
+		return true
+	}
+
+	return change.ReviewerID == b.UserID && time.Since(change.CreatedAt) <= service.DecisionUndoWindow
+}
+
 // buildReasonOptions creates the reason management options.
 func (b *ReviewBuilder) buildReasonOptions() []discord.StringSelectMenuOption {
 	reasonTypes := []enum.UserReasonType{
@@ -9,6 +9,7 @@ import (
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/session"
 	"github.com/robalyx/rotector/internal/bot/utils"
+	"github.com/robalyx/rotector/internal/database/service"
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
 )
@@ -17,6 +18,8 @@ import (
 type Builder struct {
 	stats       map[uint64]*types.ReviewerStats
 	usernames   map[uint64]string
+	accuracies  map[uint64]*types.ReviewerAccuracy
+	fatigues    map[uint64]*types.ReviewerFatigue
 	hasNextPage bool
 	hasPrevPage bool
 	lastRefresh time.Time
@@ -29,6 +32,8 @@ func NewBuilder(s *session.Session) *Builder {
 	return &Builder{
 		stats:       session.ReviewerStats.Get(s),
 		usernames:   session.ReviewerUsernames.Get(s),
+		accuracies:  session.ReviewerAccuracies.Get(s),
+		fatigues:    session.ReviewerFatigues.Get(s),
 		hasNextPage: session.PaginationHasNextPage.Get(s),
 		hasPrevPage: session.PaginationHasPrevPage.Get(s),
 		lastRefresh: session.ReviewerStatsLastRefresh.Get(s),
@@ -68,10 +73,12 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 			if stat, ok := b.stats[reviewerID]; ok {
 				statsContent.WriteString(fmt.Sprintf("\n### %s\n", username))
 				statsContent.WriteString(utils.FormatString(fmt.Sprintf(
-					"Users Viewed: %d\nUsers Confirmed: %d\nUsers Cleared: %d",
+					"Users Viewed: %d\nUsers Confirmed: %d\nUsers Cleared: %d\nGold Accuracy: %s\nReview Pace: %s",
 					stat.UsersViewed,
 					stat.UsersConfirmed,
 					stat.UsersCleared,
+					b.formatAccuracy(reviewerID),
+					b.formatFatigue(reviewerID),
 				)))
 			}
 		}
@@ -110,6 +117,32 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 	return builder
 }
 
+// formatAccuracy formats the gold item accuracy of a reviewer.
+func (b *Builder) formatAccuracy(reviewerID uint64) string {
+	accuracy, ok := b.accuracies[reviewerID]
+	if !ok || accuracy.Answered == 0 {
+		return "No gold items answered"
+	}
+
+	text := fmt.Sprintf("%.0f%% (%d/%d)", accuracy.Rate()*100, accuracy.Correct, accuracy.Answered)
+	if accuracy.Answered >= service.GoldDemotionMinAnswers && accuracy.Rate() < service.GoldDemotionThreshold {
+		text += " - below threshold"
+	}
+
+	return text
+}
+
+// formatFatigue formats the decision pace of a reviewer.
+func (b *Builder) formatFatigue(reviewerID uint64) string {
+	fatigue, ok := b.fatigues[reviewerID]
+	if !ok || fatigue.Reviews == 0 {
+		return "No timed decisions"
+	}
+
+	return fmt.Sprintf("%.0fs median, %.0fs average, %.0f%% fast (%d decisions)",
+		fatigue.MedianSeconds, fatigue.AvgSeconds, fatigue.FastRate()*100, fatigue.Reviews)
+}
+
 // buildPeriodOptions creates the options for the time period selection menu.
 func (b *Builder) buildPeriodOptions() []discord.StringSelectMenuOption {
 	return []discord.StringSelectMenuOption{
@@ -2,12 +2,15 @@ package status
 
 import (
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/disgoorg/disgo/discord"
 	"github.com/robalyx/rotector/internal/bot/constants"
 	"github.com/robalyx/rotector/internal/bot/core/session"
+	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/worker/core"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -22,41 +25,96 @@ const (
 // Builder creates the visual layout for the worker status menu.
 type Builder struct {
 	workerStatuses []core.Status
+	workerHistory  []*types.WorkerHistory
 	titleCaser     cases.Caser
 }
 
 // NewBuilder creates a new status builder.
 func NewBuilder(s *session.Session) *Builder {
+	return NewBuilderFromData(session.StatusWorkers.Get(s), session.StatusWorkerHistory.Get(s))
+}
+
+// NewBuilderFromData creates a new status builder from worker data fetched outside a session.
+func NewBuilderFromData(workerStatuses []core.Status, workerHistory []*types.WorkerHistory) *Builder {
 	return &Builder{
-		workerStatuses: session.StatusWorkers.Get(s),
+		workerStatuses: workerStatuses,
+		workerHistory:  workerHistory,
 		titleCaser:     cases.Title(language.English),
 	}
 }
 
 // Build creates a Discord message showing worker status information.
 func (b *Builder) Build() *discord.MessageUpdateBuilder {
+	// Create container with text display and navigation buttons
+	container := discord.NewContainer(
+		discord.NewTextDisplay(b.buildContent()),
+		discord.NewLargeSeparator(),
+		discord.NewActionRow(
+			discord.NewSecondaryButton("◀️ Back", constants.BackButtonCustomID),
+			discord.NewSecondaryButton("🔄 Refresh", constants.RefreshButtonCustomID),
+		),
+	).WithAccentColor(constants.DefaultContainerColor)
+
+	return discord.NewMessageUpdateBuilder().
+		AddComponents(container)
+}
+
+// BuildCompact creates a Discord message showing worker status information without navigation.
+func (b *Builder) BuildCompact() *discord.MessageUpdateBuilder {
+	container := discord.NewContainer(
+		discord.NewTextDisplay(b.buildContent()),
+	).WithAccentColor(constants.DefaultContainerColor)
+
+	return discord.NewMessageUpdateBuilder().
+		AddComponents(container)
+}
+
+// buildContent formats the status of each worker type.
+func (b *Builder) buildContent() string {
 	var content strings.Builder
 
 	// Create header and legend
 	content.WriteString("## Worker Statuses\n")
 	content.WriteString(fmt.Sprintf("%s Online  %s Unhealthy  %s Offline\n\n", healthyEmoji, unhealthyEmoji, staleEmoji))
 
-	// Group workers by type
+	// Group workers and history by type
 	workerGroups := make(map[string][]core.Status)
 	for _, status := range b.workerStatuses {
 		workerGroups[status.WorkerType] = append(workerGroups[status.WorkerType], status)
 	}
 
+	historyGroups := make(map[string][]*types.WorkerHistory)
+	for _, history := range b.workerHistory {
+		historyGroups[history.WorkerType] = append(historyGroups[history.WorkerType], history)
+	}
+
+	typeSet := make(map[string]struct{})
+	for workerType := range workerGroups {
+		typeSet[workerType] = struct{}{}
+	}
+
+	for workerType := range historyGroups {
+		typeSet[workerType] = struct{}{}
+	}
+
+	workerTypes := slices.Sorted(maps.Keys(typeSet))
+
 	// Add sections for each worker type
-	for workerType, workers := range workerGroups {
+	for _, workerType := range workerTypes {
+		workers := workerGroups[workerType]
+
 		// Format worker statuses
 		var statusLines []string
 
 		for _, w := range workers {
 			shortID := w.WorkerID[:8]
 			emoji := b.getStatusEmoji(w)
-			statusLines = append(statusLines, fmt.Sprintf("%s `%s` %s (%d%%)",
-				emoji, shortID, w.CurrentTask, w.Progress))
+			statusLines = append(statusLines, fmt.Sprintf("%s `%s` %s (%d%%) • up %s",
+				emoji, shortID, w.CurrentTask, w.Progress, formatUptime(w.Uptime())))
+
+			if len(w.Details) > 0 {
+				statusLines = append(statusLines, "-# "+formatDetails(w.Details))
+			}
 		}
 
 		// Add section for this worker type
@@ -68,21 +126,14 @@ func (b *Builder) Build() *discord.MessageUpdateBuilder {
 			content.WriteString("No workers online")
 		}
 
+		if history := historyGroups[workerType]; len(history) > 0 {
+			content.WriteString("\n" + b.formatHistory(history))
+		}
+
 		content.WriteString("\n\n")
 	}
 
-	// Create container with text display and navigation buttons
-	container := discord.NewContainer(
-		discord.NewTextDisplay(content.String()),
-		discord.NewLargeSeparator(),
-		discord.NewActionRow(
-			discord.NewSecondaryButton("◀️ Back", constants.BackButtonCustomID),
-			discord.NewSecondaryButton("🔄 Refresh", constants.RefreshButtonCustomID),
-		),
-	).WithAccentColor(constants.DefaultContainerColor)
-
-	return discord.NewMessageUpdateBuilder().
-		AddComponents(container)
+	return content.String()
 }
 
 // getStatusEmoji returns the appropriate emoji for a worker's status.
@@ -98,3 +149,55 @@ func (b *Builder) getStatusEmoji(status core.Status) string {
 
 	return healthyEmoji
 }
+
+// formatHistory summarizes the history of a worker type over the status window.
+func (b *Builder) formatHistory(history []*types.WorkerHistory) string {
+	var (
+		restarts       int
+		unhealthyFlips int
+		processed      int64
+		crashLooping   []string
+	)
+
+	for _, h := range history {
+		restarts += max(h.Starts-1, 0)
+		unhealthyFlips += h.UnhealthyFlips
+		processed += h.Processed
+
+		if h.RecentStarts >= core.CrashLoopThreshold {
+			crashLooping = append(crashLooping, fmt.Sprintf("`%s`", h.WorkerSlot))
+		}
+	}
+
+	perHour := float64(processed) / constants.WorkerHistoryWindow.Hours()
+	line := fmt.Sprintf("-# Last %s: %d restarts • %d unhealthy • %d processed (%.0f/h)",
+		formatUptime(constants.WorkerHistoryWindow), restarts, unhealthyFlips, processed, perHour)
+
+	if len(crashLooping) > 0 {
+		line += "\n⚠️ Crash loop: " + strings.Join(crashLooping, ", ")
+	}
+
+	return line
+}
+
+// formatDetails formats worker status details as a single sorted line.
+func formatDetails(details map[string]string) string {
+	parts := make([]string, 0, len(details))
+	for _, key := range slices.Sorted(maps.Keys(details)) {
+		parts = append(parts, fmt.Sprintf("%s: %s", key, details[key]))
+	}
+
+	return strings.Join(parts, " • ")
+}
+
+// formatUptime formats a duration as a compact uptime string.
+func formatUptime(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd%dh", int(d.Hours())/24, int(d.Hours())%24)
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh%dm", int(d.Hours()), int(d.Minutes())%60)
+	default:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	}
+}
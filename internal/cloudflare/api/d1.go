@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/bytedance/sonic"
 	"github.com/cenkalti/backoff/v4"
+	"github.com/robalyx/rotector/internal/metrics"
 	"github.com/robalyx/rotector/pkg/utils"
 )
 
@@ -50,7 +53,9 @@ func (c *D1Client) ExecuteSQL(ctx context.Context, sql string, params []any) ([]
 	err := utils.WithRetry(ctx, func() error {
 		var execErr error
 
+		start := time.Now()
 		result, execErr = c.executeRequest(ctx, sql, params)
+		metrics.ObserveCloudflareOperation("d1", sqlOperation(sql), execErr, time.Since(start))
 
 		// Don't retry on validation errors or permanent failures
 		if errors.Is(execErr, ErrD1APIUnsuccessful) {
@@ -119,3 +124,13 @@ func (c *D1Client) executeRequest(ctx context.Context, sql string, params []any)
 
 	return d1Resp.Result[0].Results, nil
 }
+
+// sqlOperation returns the lowercase leading keyword of a SQL statement.
+func sqlOperation(sql string) string {
+	fields := strings.Fields(sql)
+	if len(fields) == 0 {
+		return "unknown"
+	}
+
+	return strings.ToLower(fields[0])
+}
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/robalyx/rotector/internal/metrics"
 )
 
 // R2Client handles R2 object storage using MinIO S3 client.
@@ -39,10 +41,12 @@ func NewR2Client(endpoint, accessKeyID, secretAccessKey, bucketName, region stri
 }
 
 // PutObject uploads an object to R2 storage.
-func (c *R2Client) PutObject(ctx context.Context, key string, data []byte, contentType string) error {
+func (c *R2Client) PutObject(ctx context.Context, key string, data []byte, contentType string) (err error) {
+	defer observeR2("put", time.Now(), &err)
+
 	reader := strings.NewReader(string(data))
 
-	_, err := c.client.PutObject(ctx, c.bucketName, key, reader, int64(len(data)), minio.PutObjectOptions{
+	_, err = c.client.PutObject(ctx, c.bucketName, key, reader, int64(len(data)), minio.PutObjectOptions{
 		ContentType: contentType,
 	})
 	if err != nil {
@@ -53,14 +57,16 @@ func (c *R2Client) PutObject(ctx context.Context, key string, data []byte, conte
 }
 
 // GetObject retrieves an object from R2 storage.
-func (c *R2Client) GetObject(ctx context.Context, key string) ([]byte, error) {
+func (c *R2Client) GetObject(ctx context.Context, key string) (data []byte, err error) {
+	defer observeR2("get", time.Now(), &err)
+
 	object, err := c.client.GetObject(ctx, c.bucketName, key, minio.GetObjectOptions{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get object %s: %w", key, err)
 	}
 	defer object.Close()
 
-	data, err := io.ReadAll(object)
+	data, err = io.ReadAll(object)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read object %s: %w", key, err)
 	}
@@ -69,8 +75,10 @@ func (c *R2Client) GetObject(ctx context.Context, key string) ([]byte, error) {
 }
 
 // DeleteObject removes an object from R2 storage.
-func (c *R2Client) DeleteObject(ctx context.Context, key string) error {
-	err := c.client.RemoveObject(ctx, c.bucketName, key, minio.RemoveObjectOptions{})
+func (c *R2Client) DeleteObject(ctx context.Context, key string) (err error) {
+	defer observeR2("delete", time.Now(), &err)
+
+	err = c.client.RemoveObject(ctx, c.bucketName, key, minio.RemoveObjectOptions{})
 	if err != nil {
 		return fmt.Errorf("failed to delete object %s: %w", key, err)
 	}
@@ -79,8 +87,10 @@ func (c *R2Client) DeleteObject(ctx context.Context, key string) error {
 }
 
 // HeadObject checks if an object exists in R2 storage.
-func (c *R2Client) HeadObject(ctx context.Context, key string) (bool, error) {
-	_, err := c.client.StatObject(ctx, c.bucketName, key, minio.StatObjectOptions{})
+func (c *R2Client) HeadObject(ctx context.Context, key string) (exists bool, err error) {
+	defer observeR2("head", time.Now(), &err)
+
+	_, err = c.client.StatObject(ctx, c.bucketName, key, minio.StatObjectOptions{})
 	if err != nil {
 		// Check if it's a not found error
 		errResponse := minio.ToErrorResponse(err)
@@ -95,7 +105,9 @@ func (c *R2Client) HeadObject(ctx context.Context, key string) (bool, error) {
 }
 
 // ListObjects lists objects with the given prefix from R2 storage.
-func (c *R2Client) ListObjects(ctx context.Context, prefix string) ([]string, error) {
+func (c *R2Client) ListObjects(ctx context.Context, prefix string) (keys []string, err error) {
+	defer observeR2("list", time.Now(), &err)
+
 	objects := make([]string, 0, 100)
 
 	objectCh := c.client.ListObjects(ctx, c.bucketName, minio.ListObjectsOptions{
@@ -137,3 +149,8 @@ func (c *R2Client) CreateBucketIfNotExists(ctx context.Context) error {
 
 	return nil
 }
+
+// observeR2 records the outcome and latency of an R2 operation.
+func observeR2(operation string, start time.Time, err *error) {
+	metrics.ObserveCloudflareOperation("r2", operation, *err, time.Since(start))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/bytedance/sonic"
 	"github.com/robalyx/rotector/internal/cloudflare/api"
 	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
 	"go.uber.org/zap"
 )
 
@@ -79,6 +80,24 @@ func (g *GroupFlags) Remove(ctx context.Context, groupID int64) error {
 	return nil
 }
 
+// Revert updates the group_flags table after a decision on the group was reverted.
+func (g *GroupFlags) Revert(ctx context.Context, group *types.ReviewGroup) error {
+	if group == nil {
+		return nil
+	}
+
+	switch group.Status {
+	case enum.GroupTypeConfirmed:
+		return g.AddConfirmed(ctx, group)
+	case enum.GroupTypeMixed:
+		return g.AddMixed(ctx, group)
+	case enum.GroupTypeFlagged:
+		return g.addGroup(ctx, group, GroupFlagTypeFlagged)
+	default:
+		return g.Remove(ctx, group.ID)
+	}
+}
+
 // UpdateBanStatus updates the is_banned field for groups in the group_flags table.
 func (g *GroupFlags) UpdateBanStatus(ctx context.Context, groupIDs []int64, isBanned bool) error {
 	if len(groupIDs) == 0 {
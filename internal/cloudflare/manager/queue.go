@@ -16,6 +16,40 @@ const (
 	MaxQueueBatchSize = 50
 )
 
+// QueuePriority identifies the lane a queued user is processed in.
+type QueuePriority string
+
+const (
+	// QueuePriorityReviewer is for users queued by hand through the bot.
+	QueuePriorityReviewer QueuePriority = "reviewer"
+	// QueuePriorityExtension is for users reported through the browser extension.
+	// Entries inserted without a priority, such as by the upload API, also land here.
+	QueuePriorityExtension QueuePriority = "extension"
+	// QueuePriorityRecheck is for automated re-checks of known users scheduled by risk.
+	QueuePriorityRecheck QueuePriority = "recheck"
+	// QueuePriorityBulk is for large imports from the queue CLI.
+	QueuePriorityBulk QueuePriority = "bulk"
+)
+
+// QueuePriorities lists all priority lanes from highest to lowest.
+var QueuePriorities = []QueuePriority{
+	QueuePriorityReviewer,
+	QueuePriorityExtension,
+	QueuePriorityRecheck,
+	QueuePriorityBulk,
+}
+
+// Rank returns the lane's position in QueuePriorities, where lower ranks are served first.
+func (p QueuePriority) Rank() int {
+	for i, priority := range QueuePriorities {
+		if priority == p {
+			return i
+		}
+	}
+
+	return len(QueuePriorities)
+}
+
 var (
 	// ErrUserNotFound indicates the user was not found in the cloudflare.
 	ErrUserNotFound = errors.New("user not found in cloudflare")
@@ -27,6 +61,8 @@ var (
 	ErrBatchSizeExceeded = errors.New("batch size exceeds maximum capacity")
 	// ErrEmptyBatch indicates an empty batch was provided.
 	ErrEmptyBatch = errors.New("empty batch provided")
+	// ErrInvalidPriority indicates an unknown queue priority was provided.
+	ErrInvalidPriority = errors.New("invalid queue priority")
 )
 
 // Status represents the current status of a queued user.
@@ -44,9 +80,17 @@ type Stats struct {
 	Unprocessed int
 }
 
+// LanePending describes the unprocessed users waiting in a priority lane.
+type LanePending struct {
+	Count    int
+	OldestAt time.Time
+}
+
 // UserBatch represents a batch of users with their inappropriate flags.
 type UserBatch struct {
 	UserIDs                   []int64
+	Priorities                map[int64]QueuePriority
+	SourceGroups              map[int64]int64
 	InappropriateOutfitFlags  map[int64]struct{}
 	InappropriateProfileFlags map[int64]struct{}
 	InappropriateFriendsFlags map[int64]struct{}
@@ -67,20 +111,100 @@ func NewQueue(d1Client *api.D1Client, logger *zap.Logger) *Queue {
 	}
 }
 
+// EnsureSchema adds the priority lane and source group columns and the lane
+// index to the queue table if missing.
+func (q *Queue) EnsureSchema(ctx context.Context) error {
+	for _, column := range []string{"priority TEXT", "source_group_id INTEGER"} {
+		_, err := q.d1.ExecuteSQL(ctx, "ALTER TABLE queued_users ADD COLUMN "+column, nil)
+		if err != nil && !strings.Contains(err.Error(), "duplicate column") {
+			return fmt.Errorf("failed to add %s column: %w", column, err)
+		}
+	}
+
+	indexQuery := `
+		CREATE INDEX IF NOT EXISTS idx_queued_users_lane
+		ON queued_users (processed, processing, priority, queued_at)
+	`
+	if _, err := q.d1.ExecuteSQL(ctx, indexQuery, nil); err != nil {
+		return fmt.Errorf("failed to create priority lane index: %w", err)
+	}
+
+	return nil
+}
+
+// GetPendingByPriority retrieves the number of waiting users and the oldest
+// queue time for each priority lane.
+func (q *Queue) GetPendingByPriority(ctx context.Context) (map[QueuePriority]LanePending, error) {
+	query := `
+		SELECT COALESCE(priority, ?) as lane, COUNT(*) as pending, MIN(queued_at) as oldest
+		FROM queued_users
+		WHERE processed = 0 AND processing = 0
+		GROUP BY lane
+	`
+
+	result, err := q.d1.ExecuteSQL(ctx, query, []any{string(QueuePriorityExtension)})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending lanes: %w", err)
+	}
+
+	pending := make(map[QueuePriority]LanePending, len(result))
+
+	for _, row := range result {
+		lane, ok := row["lane"].(string)
+		if !ok {
+			continue
+		}
+
+		var entry LanePending
+		if count, ok := row["pending"].(float64); ok {
+			entry.Count = int(count)
+		}
+
+		if oldest, ok := row["oldest"].(float64); ok {
+			entry.OldestAt = time.Unix(int64(oldest), 0)
+		}
+
+		pending[QueuePriority(lane)] = entry
+	}
+
+	return pending, nil
+}
+
 // GetNextBatch retrieves the next batch of unprocessed and non-processing users.
-func (q *Queue) GetNextBatch(ctx context.Context, batchSize int) (*UserBatch, error) {
-	// First, get the batch of users
+// The allocation sets how many users to take from each priority lane, oldest first.
+func (q *Queue) GetNextBatch(ctx context.Context, allocation map[QueuePriority]int) (*UserBatch, error) {
+	// First, get the batch of users from each lane
 	selectQuery := `
-		SELECT user_id, inappropriate_outfit, inappropriate_profile, inappropriate_friends, inappropriate_groups
+		SELECT user_id, inappropriate_outfit, inappropriate_profile, inappropriate_friends, inappropriate_groups,
+			source_group_id
 		FROM queued_users
-		WHERE processed = 0 AND processing = 0
+		WHERE processed = 0 AND processing = 0 AND COALESCE(priority, ?) = ?
 		ORDER BY queued_at ASC
 		LIMIT ?
 	`
 
-	result, err := q.d1.ExecuteSQL(ctx, selectQuery, []any{batchSize})
-	if err != nil {
-		return nil, fmt.Errorf("failed to query cloudflare: %w", err)
+	var result []map[string]any
+
+	priorities := make(map[int64]QueuePriority)
+
+	for _, priority := range QueuePriorities {
+		limit := allocation[priority]
+		if limit <= 0 {
+			continue
+		}
+
+		rows, err := q.d1.ExecuteSQL(ctx, selectQuery, []any{string(QueuePriorityExtension), string(priority), limit})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query cloudflare: %w", err)
+		}
+
+		for _, row := range rows {
+			if userID, ok := row["user_id"].(float64); ok {
+				priorities[int64(userID)] = priority
+			}
+		}
+
+		result = append(result, rows...)
 	}
 
 	userIDs := make([]int64, 0, len(result))
@@ -88,11 +212,16 @@ func (q *Queue) GetNextBatch(ctx context.Context, batchSize int) (*UserBatch, er
 	inappropriateProfileFlags := make(map[int64]struct{}, len(result))
 	inappropriateFriendsFlags := make(map[int64]struct{}, len(result))
 	inappropriateGroupsFlags := make(map[int64]struct{}, len(result))
+	sourceGroups := make(map[int64]int64)
 
 	for _, row := range result {
 		if userID, ok := row["user_id"].(float64); ok {
 			userIDs = append(userIDs, int64(userID))
 
+			if groupID, ok := row["source_group_id"].(float64); ok && groupID > 0 {
+				sourceGroups[int64(userID)] = int64(groupID)
+			}
+
 			if row["inappropriate_outfit"].(float64) == 1 {
 				inappropriateOutfitFlags[int64(userID)] = struct{}{}
 			}
@@ -114,6 +243,8 @@ func (q *Queue) GetNextBatch(ctx context.Context, batchSize int) (*UserBatch, er
 	if len(userIDs) == 0 {
 		return &UserBatch{
 			UserIDs:                   []int64{},
+			Priorities:                make(map[int64]QueuePriority),
+			SourceGroups:              make(map[int64]int64),
 			InappropriateOutfitFlags:  make(map[int64]struct{}),
 			InappropriateProfileFlags: make(map[int64]struct{}),
 			InappropriateFriendsFlags: make(map[int64]struct{}),
@@ -148,6 +279,8 @@ func (q *Queue) GetNextBatch(ctx context.Context, batchSize int) (*UserBatch, er
 
 	return &UserBatch{
 		UserIDs:                   userIDs,
+		Priorities:                priorities,
+		SourceGroups:              sourceGroups,
 		InappropriateOutfitFlags:  inappropriateOutfitFlags,
 		InappropriateProfileFlags: inappropriateProfileFlags,
 		InappropriateFriendsFlags: inappropriateFriendsFlags,
@@ -248,23 +381,37 @@ func (q *Queue) GetStats(ctx context.Context) (*Stats, error) {
 	return stats, nil
 }
 
-// AddUsers adds multiple users to the processing cloudflare.
-func (q *Queue) AddUsers(ctx context.Context, userIDs []int64) (map[int64]error, error) {
+// AddUsers adds multiple users to the processing cloudflare in the given priority lane.
+// Users still waiting in a lower priority lane are promoted instead of rejected.
+func (q *Queue) AddUsers(ctx context.Context, userIDs []int64, priority QueuePriority) (map[int64]error, error) {
+	return q.AddUsersFromGroups(ctx, userIDs, priority, nil)
+}
+
+// AddUsersFromGroups adds multiple users like AddUsers and records the group
+// each user was found in, keyed by user ID, for context when processing.
+func (q *Queue) AddUsersFromGroups(
+	ctx context.Context, userIDs []int64, priority QueuePriority, sourceGroups map[int64]int64,
+) (map[int64]error, error) {
 	if len(userIDs) == 0 {
 		return nil, ErrEmptyBatch
 	}
 
+	if priority.Rank() == len(QueuePriorities) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidPriority, priority)
+	}
+
 	if len(userIDs) > MaxQueueBatchSize {
 		return nil, ErrBatchSizeExceeded
 	}
 
 	// Check existing users and their cloudflare status
 	checkQuery := `
-		SELECT user_id, queued_at, processed
+		SELECT user_id, queued_at, processed, processing, COALESCE(priority, ?) as priority
 		FROM queued_users
 		WHERE user_id IN (`
 
-	params := make([]any, len(userIDs))
+	params := make([]any, 0, len(userIDs)+1)
+	params = append(params, string(QueuePriorityExtension))
 
 	var whereInPlaceholders strings.Builder
 
@@ -275,7 +422,7 @@ func (q *Queue) AddUsers(ctx context.Context, userIDs []int64) (map[int64]error,
 
 		whereInPlaceholders.WriteString("?")
 
-		params[i] = id
+		params = append(params, id)
 	}
 
 	checkQuery += whereInPlaceholders.String()
@@ -288,33 +435,48 @@ func (q *Queue) AddUsers(ctx context.Context, userIDs []int64) (map[int64]error,
 	}
 
 	// Track which users need to be inserted vs updated
-	existingUsers := make(map[int64]float64) // user_id -> queued_at
+	existingUsers := make(map[int64]float64)      // user_id -> queued_at
+	waitingUsers := make(map[int64]QueuePriority) // user_id -> lane for users not yet picked up
+	unprocessedUsers := make(map[int64]struct{})  // users waiting or being processed
 
 	for _, row := range result {
 		if userID, ok := row["user_id"].(float64); ok {
 			if queuedAt, ok := row["queued_at"].(float64); ok {
 				existingUsers[int64(userID)] = queuedAt
 			}
+
+			lane, _ := row["priority"].(string)
+			if row["processed"].(float64) == 0 && row["processing"].(float64) == 0 {
+				waitingUsers[int64(userID)] = QueuePriority(lane)
+			}
+
+			if row["processed"].(float64) == 0 {
+				unprocessedUsers[int64(userID)] = struct{}{}
+			}
 		}
 	}
 
 	// Prepare batch insert for new users
 	now := time.Now().Unix()
 	insertQuery := `
-		INSERT INTO queued_users (user_id, queued_at, processed, processing)
+		INSERT INTO queued_users (user_id, queued_at, processed, processing, priority)
 		VALUES `
 
 	updateQuery := `
 		UPDATE queued_users
-		SET queued_at = ?, processed = 0, processing = 0
+		SET queued_at = ?, processed = 0, processing = 0, priority = ?, source_group_id = NULL
 		WHERE user_id IN (`
 
 	var (
-		insertParams []any
-		updateParams []any
+		insertParams  []any
+		updateParams  []any
+		promoteParams []any
 	)
 
-	updateParams = append(updateParams, now) // First param is the new queued_at time
+	// Numbered parameters let rows share the timestamp and lane, keeping
+	// the insert within D1's bound parameter limit
+	insertParams = append(insertParams, now, string(priority))
+	updateParams = append(updateParams, now, string(priority)) // First params are the new queued_at time and lane
 
 	cutoffTime := time.Now().AddDate(0, 0, -7).Unix()
 	errors := make(map[int64]error)
@@ -323,38 +485,52 @@ func (q *Queue) AddUsers(ctx context.Context, userIDs []int64) (map[int64]error,
 
 	for _, userID := range userIDs {
 		if queuedAt, exists := existingUsers[userID]; exists {
-			// Check if user was queued in the past 7 days
-			if int64(queuedAt) > cutoffTime {
+			// Check if user was queued in the past 7 days. Re-checks are scheduled by
+			// risk so they only wait for users that have not been processed yet.
+			_, unprocessed := unprocessedUsers[userID]
+			recent := int64(queuedAt) > cutoffTime
+			if priority == QueuePriorityRecheck {
+				recent = unprocessed
+			}
+
+			if recent {
+				// Move users still waiting in a lower lane up to this one
+				if lane, waiting := waitingUsers[userID]; waiting && priority.Rank() < lane.Rank() {
+					promoteParams = append(promoteParams, userID)
+					continue
+				}
+
 				errors[userID] = ErrUserRecentlyQueued
+
 				continue
 			}
 
 			updateParams = append(updateParams, userID)
 		} else {
-			if len(insertParams) > 0 {
+			if len(insertParams) > 2 {
 				insertValuesBuilder.WriteString(",")
 			}
 
-			insertValuesBuilder.WriteString("(?, ?, 0, 0)")
+			insertParams = append(insertParams, userID)
 
-			insertParams = append(insertParams, userID, now)
+			fmt.Fprintf(&insertValuesBuilder, "(?%d, ?1, 0, 0, ?2)", len(insertParams))
 		}
 	}
 
 	insertQuery += insertValuesBuilder.String()
 
 	// Execute insert for new users if any
-	if len(insertParams) > 0 {
+	if len(insertParams) > 2 { // More than just the timestamp and lane
 		if _, err := q.d1.ExecuteSQL(ctx, insertQuery, insertParams); err != nil {
 			return errors, fmt.Errorf("failed to insert cloudflare entries: %w", err)
 		}
 	}
 
 	// Execute update for existing users if any
-	if len(updateParams) > 1 { // More than just the timestamp
+	if len(updateParams) > 2 { // More than just the timestamp and lane
 		var whereInPlaceholders strings.Builder
 
-		for i := range len(updateParams) - 1 {
+		for i := range len(updateParams) - 2 {
 			if i > 0 {
 				whereInPlaceholders.WriteString(",")
 			}
@@ -371,9 +547,119 @@ func (q *Queue) AddUsers(ctx context.Context, userIDs []int64) (map[int64]error,
 		}
 	}
 
+	// Execute promotion for waiting users if any
+	if len(promoteParams) > 0 {
+		promoteQuery := "UPDATE queued_users SET priority = ? WHERE processed = 0 AND processing = 0 AND user_id IN (" +
+			strings.TrimSuffix(strings.Repeat("?,", len(promoteParams)), ",") + ")"
+
+		if _, err := q.d1.ExecuteSQL(ctx, promoteQuery, append([]any{string(priority)}, promoteParams...)); err != nil {
+			return errors, fmt.Errorf("failed to promote cloudflare entries: %w", err)
+		}
+	}
+
+	// Record the source group of each accepted user if any
+	if err := q.setSourceGroups(ctx, userIDs, sourceGroups, errors); err != nil {
+		return errors, err
+	}
+
 	return errors, nil
 }
 
+// setSourceGroups records the source group of users that were not rejected.
+func (q *Queue) setSourceGroups(
+	ctx context.Context, userIDs []int64, sourceGroups map[int64]int64, rejected map[int64]error,
+) error {
+	query := "UPDATE queued_users SET source_group_id = CASE user_id "
+	params := make([]any, 0, len(userIDs)*2)
+
+	// Numbered parameters let the WHERE clause reuse the user IDs from the CASE
+	var (
+		caseWhenBuilder     strings.Builder
+		whereInPlaceholders strings.Builder
+	)
+
+	for _, userID := range userIDs {
+		groupID, ok := sourceGroups[userID]
+		if !ok || groupID <= 0 {
+			continue
+		}
+
+		if _, failed := rejected[userID]; failed {
+			continue
+		}
+
+		if len(params) > 0 {
+			whereInPlaceholders.WriteString(",")
+		}
+
+		params = append(params, userID, groupID)
+
+		fmt.Fprintf(&caseWhenBuilder, "WHEN ?%d THEN ?%d ", len(params)-1, len(params))
+		fmt.Fprintf(&whereInPlaceholders, "?%d", len(params)-1)
+	}
+
+	if len(params) == 0 {
+		return nil
+	}
+
+	query += caseWhenBuilder.String()
+	query += "END WHERE processed = 0 AND processing = 0 AND user_id IN (" + whereInPlaceholders.String() + ")"
+
+	if _, err := q.d1.ExecuteSQL(ctx, query, params); err != nil {
+		return fmt.Errorf("failed to record source groups: %w", err)
+	}
+
+	return nil
+}
+
+// GetRecentlyQueued returns which of the given users were queued within the past
+// 7 days and would be rejected by AddUsers.
+func (q *Queue) GetRecentlyQueued(ctx context.Context, userIDs []int64) (map[int64]struct{}, error) {
+	if len(userIDs) == 0 {
+		return map[int64]struct{}{}, nil
+	}
+
+	if len(userIDs) > MaxQueueBatchSize {
+		return nil, ErrBatchSizeExceeded
+	}
+
+	query := "SELECT user_id FROM queued_users WHERE queued_at > ? AND user_id IN ("
+
+	params := make([]any, 0, len(userIDs)+1)
+	params = append(params, time.Now().AddDate(0, 0, -7).Unix())
+
+	var whereInPlaceholders strings.Builder
+
+	for i, id := range userIDs {
+		if i > 0 {
+			whereInPlaceholders.WriteString(",")
+		}
+
+		whereInPlaceholders.WriteString("?")
+
+		params = append(params, id)
+	}
+
+	query += whereInPlaceholders.String()
+
+	query += ")"
+
+	result, err := q.d1.ExecuteSQL(ctx, query, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check recently queued users: %w", err)
+	}
+
+	queued := make(map[int64]struct{}, len(result))
+
+	for _, row := range result {
+		if userID, ok := row["user_id"].(float64); ok {
+			queued[int64(userID)] = struct{}{}
+		}
+	}
+
+	return queued, nil
+}
+
 // Remove removes an unprocessed user from the processing cloudflare.
 func (q *Queue) Remove(ctx context.Context, userID int64) error {
 	// First check if the user is in an unprocessed state
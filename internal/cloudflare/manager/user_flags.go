@@ -116,6 +116,32 @@ func (u *UserFlags) Remove(ctx context.Context, userID int64) error {
 	return nil
 }
 
+// Revert updates the user_flags table after a decision on the user was reverted.
+// The user is taken out of the war system as its targeting relied on the reverted decision.
+func (u *UserFlags) Revert(ctx context.Context, user *types.ReviewUser) error {
+	if user == nil {
+		return nil
+	}
+
+	// Cleared users are not kept in the user_flags table
+	if user.Status == enum.UserTypeCleared {
+		return u.Remove(ctx, user.ID)
+	}
+
+	if err := u.warManager.RemoveUserFromWarSystem(ctx, user.ID); err != nil {
+		return fmt.Errorf("failed to remove user from war system: %w", err)
+	}
+
+	var reviewerID *uint64
+	if user.Status == enum.UserTypeConfirmed && user.ReviewerID != 0 {
+		reviewerID = &user.ReviewerID
+	}
+
+	users := map[int64]*types.ReviewUser{user.ID: user}
+
+	return u.addUsers(ctx, users, user.Status, reviewerID)
+}
+
 // RemoveBatch removes multiple users from the user_flags table in batches.
 func (u *UserFlags) RemoveBatch(ctx context.Context, userIDs []int64) error {
 	if len(userIDs) == 0 {
@@ -2,8 +2,11 @@ package database
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 
+	"github.com/robalyx/rotector/internal/metrics"
 	"github.com/uptrace/bun"
 	"go.uber.org/zap"
 )
@@ -27,6 +30,14 @@ func (h *Hook) BeforeQuery(ctx context.Context, _ *bun.QueryEvent) context.Conte
 func (h *Hook) AfterQuery(_ context.Context, event *bun.QueryEvent) {
 	duration := time.Since(event.StartTime)
 
+	// Record query latency, ignoring empty results as errors
+	queryErr := event.Err
+	if errors.Is(queryErr, sql.ErrNoRows) {
+		queryErr = nil
+	}
+
+	metrics.ObserveDBQuery(event.Operation(), queryErr, duration)
+
 	// Track transaction boundaries
 	if event.Query == "BEGIN" || event.Query == "COMMIT" || event.Query == "ROLLBACK" {
 		if duration > 200*time.Millisecond {
@@ -0,0 +1,43 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewCreateTable().
+			Model((*types.GameReputation)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create game_reputations table: %w", err)
+		}
+
+		_, err = db.NewRaw(`
+			CREATE INDEX IF NOT EXISTS idx_game_reputations_suspected
+			ON game_reputations (score DESC, id)
+			WHERE is_suspected = TRUE;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create game_reputations indexes: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewDropTable().
+			Model((*types.GameReputation)(nil)).
+			IfExists().
+			Cascade().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop game_reputations table: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,80 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		// Create partitioned badge tracking tables
+		tables := []struct {
+			model        any
+			name         string
+			partitionKey string
+		}{
+			{(*types.BadgeTracking)(nil), "badge_trackings", "id"},
+			{(*types.BadgeTrackingUser)(nil), "badge_tracking_users", "badge_id"},
+		}
+
+		for _, table := range tables {
+			_, err := db.NewCreateTable().
+				Model(table.model).
+				ModelTableExpr(table.name).
+				IfNotExists().
+				PartitionBy(fmt.Sprintf("HASH (%s)", table.partitionKey)).
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create parent table %s: %w", table.name, err)
+			}
+
+			for i := range 8 {
+				_, err = db.NewRaw(fmt.Sprintf(
+					"CREATE TABLE IF NOT EXISTS %s_%d PARTITION OF %s FOR VALUES WITH (modulus 8, remainder %d)",
+					table.name, i, table.name, i)).
+					Exec(ctx)
+				if err != nil {
+					return fmt.Errorf("failed to create partition %s_%d: %w", table.name, i, err)
+				}
+			}
+		}
+
+		_, err := db.NewRaw(`
+			-- Badge tracking indexes
+			CREATE INDEX IF NOT EXISTS idx_badge_tracking_users_badge
+			ON badge_tracking_users (badge_id);
+
+			CREATE INDEX IF NOT EXISTS idx_badge_tracking_users_user
+			ON badge_tracking_users (user_id);
+
+			-- Game reputation root place lookup
+			ALTER TABLE game_reputations
+			ADD COLUMN IF NOT EXISTS root_place_id BIGINT NOT NULL DEFAULT 0;
+
+			CREATE INDEX IF NOT EXISTS idx_game_reputations_root_place
+			ON game_reputations (root_place_id)
+			WHERE is_suspected = TRUE;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create badge tracking indexes: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`
+			DROP INDEX IF EXISTS idx_game_reputations_root_place;
+			ALTER TABLE game_reputations DROP COLUMN IF EXISTS root_place_id;
+
+			DROP TABLE IF EXISTS badge_tracking_users CASCADE;
+			DROP TABLE IF EXISTS badge_trackings CASCADE;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop badge tracking tables: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,48 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`
+			-- Risk-based rescan scheduling
+			ALTER TABLE user_processing_logs
+			ADD COLUMN IF NOT EXISTS risk_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+			ADD COLUMN IF NOT EXISTS description_hash BIGINT NOT NULL DEFAULT 0,
+			ADD COLUMN IF NOT EXISTS friend_count INTEGER NOT NULL DEFAULT 0,
+			ADD COLUMN IF NOT EXISTS is_cleared BOOLEAN NOT NULL DEFAULT FALSE,
+			ADD COLUMN IF NOT EXISTS is_confirmed BOOLEAN NOT NULL DEFAULT FALSE;
+
+			-- Scan queue ordering
+			CREATE INDEX IF NOT EXISTS idx_user_processing_logs_scan_queue
+			ON user_processing_logs (risk_score DESC, next_scan_time ASC)
+			WHERE is_confirmed = FALSE;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add processing risk columns: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`
+			DROP INDEX IF EXISTS idx_user_processing_logs_scan_queue;
+
+			ALTER TABLE user_processing_logs
+			DROP COLUMN IF EXISTS risk_score,
+			DROP COLUMN IF EXISTS description_hash,
+			DROP COLUMN IF EXISTS friend_count,
+			DROP COLUMN IF EXISTS is_cleared,
+			DROP COLUMN IF EXISTS is_confirmed;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop processing risk columns: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,53 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewCreateTable().
+			Model((*types.Job)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create jobs table: %w", err)
+		}
+
+		_, err = db.NewRaw(`
+			-- Only one active job may exist per idempotency key
+			CREATE UNIQUE INDEX IF NOT EXISTS idx_jobs_idempotency_active
+			ON jobs (idempotency_key)
+			WHERE status IN ('pending', 'leased');
+
+			-- Leasing pending and expired jobs by type
+			CREATE INDEX IF NOT EXISTS idx_jobs_lease
+			ON jobs (type, status, available_at, id);
+
+			CREATE INDEX IF NOT EXISTS idx_jobs_lease_expiry
+			ON jobs (lease_expires_at)
+			WHERE status = 'leased';
+
+			-- Purging finished jobs
+			CREATE INDEX IF NOT EXISTS idx_jobs_finished
+			ON jobs (updated_at)
+			WHERE status IN ('completed', 'dead');
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create job queue indexes: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`DROP TABLE IF EXISTS jobs CASCADE;`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop jobs table: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewCreateTable().
+			Model((*types.WorkerEvent)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create worker events table: %w", err)
+		}
+
+		_, err = db.NewRaw(`
+			SELECT create_hypertable('worker_events', 'timestamp',
+				chunk_time_interval => INTERVAL '1 day',
+				if_not_exists => TRUE
+			);
+
+			-- Worker history is only needed for recent trends
+			SELECT add_retention_policy('worker_events', INTERVAL '30 days', if_not_exists => TRUE);
+
+			CREATE INDEX IF NOT EXISTS idx_worker_events_worker
+			ON worker_events (worker_id, timestamp DESC);
+
+			-- Slots are stable so restarts of a process are counted together
+			CREATE INDEX IF NOT EXISTS idx_worker_events_slot
+			ON worker_events (worker_slot, timestamp DESC);
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to set up worker events hypertable: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`DROP TABLE IF EXISTS worker_events CASCADE;`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop worker events table: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`
+			-- Group a user was queued from when expanding group members
+			ALTER TABLE user_processing_logs
+			ADD COLUMN IF NOT EXISTS source_group_id BIGINT NOT NULL DEFAULT 0;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add processing source group column: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`
+			ALTER TABLE user_processing_logs
+			DROP COLUMN IF EXISTS source_group_id;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop processing source group column: %w", err)
+		}
+
+		return nil
+	})
+}
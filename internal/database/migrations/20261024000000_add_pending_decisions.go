@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewCreateTable().
+			Model((*types.PendingDecision)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create pending decisions table: %w", err)
+		}
+
+		_, err = db.NewRaw(`
+			-- Only one open decision may exist per target
+			CREATE UNIQUE INDEX IF NOT EXISTS idx_pending_decisions_open
+			ON pending_decisions (target_type, target_id)
+			WHERE status IN ('pending', 'escalated');
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create pending decision indexes: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`DROP TABLE IF EXISTS pending_decisions CASCADE;`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop pending decisions table: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,39 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewCreateTable().
+			Model((*types.UserStatusChange)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create user status changes table: %w", err)
+		}
+
+		_, err = db.NewRaw(`
+			-- Looking up the history and latest change of a user
+			CREATE INDEX IF NOT EXISTS idx_user_status_changes_user
+			ON user_status_changes (user_id, id DESC);
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create user status change indexes: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`DROP TABLE IF EXISTS user_status_changes CASCADE;`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop user status changes table: %w", err)
+		}
+
+		return nil
+	})
+}
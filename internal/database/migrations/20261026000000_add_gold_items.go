@@ -0,0 +1,53 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		models := []any{
+			(*types.GoldItem)(nil),
+			(*types.GoldResult)(nil),
+		}
+
+		for _, model := range models {
+			_, err := db.NewCreateTable().
+				Model(model).
+				IfNotExists().
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to create table for %T: %w", model, err)
+			}
+		}
+
+		_, err := db.NewRaw(`
+			-- A target can only be a gold item once
+			CREATE UNIQUE INDEX IF NOT EXISTS idx_gold_items_target
+			ON gold_items (target_type, target_id);
+
+			-- Computing reviewer accuracy over a time window
+			CREATE INDEX IF NOT EXISTS idx_gold_results_reviewer
+			ON gold_results (reviewer_id, created_at DESC);
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create gold item indexes: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`
+			DROP TABLE IF EXISTS gold_results CASCADE;
+			DROP TABLE IF EXISTS gold_items CASCADE;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop gold item tables: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewCreateTable().
+			Model((*types.ReviewerAssignment)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create reviewer assignments table: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`DROP TABLE IF EXISTS reviewer_assignments CASCADE;`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop reviewer assignments table: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,36 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`
+			-- Name prefix searches for slash command autocomplete
+			CREATE INDEX IF NOT EXISTS idx_users_name_prefix
+			ON users (lower(name) text_pattern_ops);
+
+			CREATE INDEX IF NOT EXISTS idx_groups_name_prefix
+			ON groups (lower(name) text_pattern_ops);
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create name search indexes: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`
+			DROP INDEX IF EXISTS idx_users_name_prefix;
+			DROP INDEX IF EXISTS idx_groups_name_prefix;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop name search indexes: %w", err)
+		}
+
+		return nil
+	})
+}
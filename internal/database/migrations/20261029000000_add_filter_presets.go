@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`
+			-- Saved review queue filters and the preset in use
+			ALTER TABLE user_settings
+			ADD COLUMN IF NOT EXISTS filter_presets JSONB NOT NULL DEFAULT '[]',
+			ADD COLUMN IF NOT EXISTS active_filter_preset TEXT NOT NULL DEFAULT '';
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add filter preset columns: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`
+			ALTER TABLE user_settings
+			DROP COLUMN IF EXISTS filter_presets,
+			DROP COLUMN IF EXISTS active_filter_preset;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop filter preset columns: %w", err)
+		}
+
+		return nil
+	})
+}
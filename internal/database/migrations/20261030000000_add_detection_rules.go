@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewCreateTable().
+			Model((*types.DetectionRule)(nil)).
+			IfNotExists().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create detection rules table: %w", err)
+		}
+
+		_, err = db.NewRaw(`
+			-- A term can only be suggested once per scope unless it was rejected
+			CREATE UNIQUE INDEX IF NOT EXISTS idx_detection_rules_term
+			ON detection_rules (scope, lower(term))
+			WHERE status != 'rejected';
+
+			-- Listing the approval queue and loading enabled rules
+			CREATE INDEX IF NOT EXISTS idx_detection_rules_status
+			ON detection_rules (status, scope, created_at);
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create detection rule indexes: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`DROP TABLE IF EXISTS detection_rules CASCADE;`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop detection rules table: %w", err)
+		}
+
+		return nil
+	})
+}
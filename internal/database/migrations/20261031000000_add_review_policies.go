@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`
+			-- Break and CAPTCHA policy of each reviewer role
+			ALTER TABLE bot_settings
+			ADD COLUMN IF NOT EXISTS review_policies JSONB NOT NULL DEFAULT '{}';
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add review policies column: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`
+			ALTER TABLE bot_settings
+			DROP COLUMN IF EXISTS review_policies;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop review policies column: %w", err)
+		}
+
+		return nil
+	})
+}
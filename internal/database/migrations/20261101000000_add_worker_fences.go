@@ -0,0 +1,35 @@
+package migrations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	Migrations.MustRegister(func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`
+			-- Highest fencing token that wrote for each singleton worker type
+			CREATE TABLE IF NOT EXISTS worker_fences (
+				worker_type TEXT PRIMARY KEY,
+				token BIGINT NOT NULL,
+				updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+			);
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create worker fences table: %w", err)
+		}
+
+		return nil
+	}, func(ctx context.Context, db *bun.DB) error {
+		_, err := db.NewRaw(`
+			DROP TABLE IF EXISTS worker_fences;
+		`).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drop worker fences table: %w", err)
+		}
+
+		return nil
+	})
+}
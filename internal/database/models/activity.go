@@ -8,6 +8,7 @@ import (
 	"github.com/robalyx/rotector/internal/database/dbretry"
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
+	"github.com/robalyx/rotector/internal/metrics"
 	"github.com/uptrace/bun"
 	"go.uber.org/zap"
 )
@@ -50,6 +51,8 @@ func (r *ActivityModel) Log(ctx context.Context, log *types.ActivityLog) {
 		return
 	}
 
+	metrics.IncReviewAction(log.ReviewerID, log.ActivityType.String())
+
 	r.logger.Debug("Logged activity",
 		zap.Uint64("guildID", log.ActivityTarget.GuildID),
 		zap.Uint64("discordID", log.ActivityTarget.DiscordID),
@@ -81,6 +84,10 @@ func (r *ActivityModel) LogBatch(ctx context.Context, logs []*types.ActivityLog)
 		return
 	}
 
+	for _, log := range logs {
+		metrics.IncReviewAction(log.ReviewerID, log.ActivityType.String())
+	}
+
 	r.logger.Debug("Logged batch activities",
 		zap.Int("count", len(logs)))
 }
@@ -230,3 +237,74 @@ func (r *ActivityModel) GetRecentlyReviewedIDs(
 		return ids, nil
 	})
 }
+
+// GetActivityTimes returns the timestamps of the latest activities of the given types made
+// by a reviewer since the given time, newest first.
+func (r *ActivityModel) GetActivityTimes(
+	ctx context.Context, reviewerID uint64, activityTypes []enum.ActivityType, since time.Time, limit int,
+) ([]time.Time, error) {
+	return dbretry.Operation(ctx, func(ctx context.Context) ([]time.Time, error) {
+		var timestamps []time.Time
+
+		err := r.db.NewSelect().
+			Model((*types.ActivityLog)(nil)).
+			Column("activity_timestamp").
+			Where("reviewer_id = ?", reviewerID).
+			Where("activity_type IN (?)", bun.In(activityTypes)).
+			Where("activity_timestamp >= ?", since).
+			Order("activity_timestamp DESC").
+			Limit(limit).
+			Scan(ctx, &timestamps)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get activity times: %w", err)
+		}
+
+		return timestamps, nil
+	})
+}
+
+// GetReviewerFatigue measures the time between consecutive decisions of each reviewer since
+// the given time. Gaps longer than maxGap are treated as the reviewer stepping away and skipped.
+// Decisions made within fastThreshold of the previous one are counted as fast.
+func (r *ActivityModel) GetReviewerFatigue(
+	ctx context.Context, reviewerIDs []uint64, since time.Time, fastThreshold, maxGap time.Duration,
+) (map[uint64]*types.ReviewerFatigue, error) {
+	if len(reviewerIDs) == 0 {
+		return map[uint64]*types.ReviewerFatigue{}, nil
+	}
+
+	return dbretry.Operation(ctx, func(ctx context.Context) (map[uint64]*types.ReviewerFatigue, error) {
+		gaps := r.db.NewSelect().
+			Model((*types.ActivityLog)(nil)).
+			Column("reviewer_id").
+			ColumnExpr("EXTRACT(EPOCH FROM activity_timestamp - LAG(activity_timestamp) "+
+				"OVER (PARTITION BY reviewer_id ORDER BY activity_timestamp)) AS gap").
+			Where("reviewer_id IN (?)", bun.In(reviewerIDs)).
+			Where("activity_type IN (?)", bun.In(enum.DecisionActivityTypes)).
+			Where("activity_timestamp >= ?", since)
+
+		var fatigues []*types.ReviewerFatigue
+
+		err := r.db.NewSelect().
+			TableExpr("(?) AS gaps", gaps).
+			Column("reviewer_id").
+			ColumnExpr("COUNT(*) AS reviews").
+			ColumnExpr("AVG(gap) AS avg_seconds").
+			ColumnExpr("percentile_cont(0.5) WITHIN GROUP (ORDER BY gap) AS median_seconds").
+			ColumnExpr("COUNT(*) FILTER (WHERE gap < ?) AS fast_reviews", fastThreshold.Seconds()).
+			Where("gap IS NOT NULL").
+			Where("gap <= ?", maxGap.Seconds()).
+			Group("reviewer_id").
+			Scan(ctx, &fatigues)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get reviewer fatigue: %w", err)
+		}
+
+		result := make(map[uint64]*types.ReviewerFatigue, len(fatigues))
+		for _, fatigue := range fatigues {
+			result[fatigue.ReviewerID] = fatigue
+		}
+
+		return result, nil
+	})
+}
@@ -128,7 +128,8 @@ func (r *CacheModel) GetProcessingLogs(ctx context.Context, userIDs []int64) ([]
 	err := dbretry.NoResult(ctx, func(ctx context.Context) error {
 		return r.db.NewSelect().
 			Model(&processedEntries).
-			Column("user_id", "last_processed", "next_scan_time").
+			Column("user_id", "last_processed", "next_scan_time", "risk_score",
+				"description_hash", "friend_count", "is_cleared", "is_confirmed", "source_group_id").
 			Where("user_id IN (?)", bun.In(userIDs)).
 			Scan(ctx)
 	})
@@ -155,6 +156,13 @@ func (r *CacheModel) MarkUsersProcessed(ctx context.Context, entries []*types.Us
 			On("CONFLICT (user_id) DO UPDATE").
 			Set("last_processed = EXCLUDED.last_processed").
 			Set("next_scan_time = EXCLUDED.next_scan_time").
+			Set("risk_score = EXCLUDED.risk_score").
+			Set("description_hash = EXCLUDED.description_hash").
+			Set("friend_count = EXCLUDED.friend_count").
+			Set("is_cleared = EXCLUDED.is_cleared").
+			Set("is_confirmed = EXCLUDED.is_confirmed").
+			Set("source_group_id = CASE WHEN EXCLUDED.source_group_id <> 0 " +
+				"THEN EXCLUDED.source_group_id ELSE user_processing_log.source_group_id END").
 			Exec(ctx)
 
 		return err
@@ -169,6 +177,125 @@ func (r *CacheModel) MarkUsersProcessed(ctx context.Context, entries []*types.Us
 	return nil
 }
 
+// GetDueUsers retrieves users whose next scan time has passed, ordered by their stored risk score.
+// Confirmed users are excluded as they no longer need rescanning.
+func (r *CacheModel) GetDueUsers(ctx context.Context, limit int) ([]types.UserProcessingLog, error) {
+	var entries []types.UserProcessingLog
+
+	err := dbretry.NoResult(ctx, func(ctx context.Context) error {
+		return r.db.NewSelect().
+			Model(&entries).
+			Where("next_scan_time <= ?", time.Now()).
+			Where("is_confirmed = FALSE").
+			Order("risk_score DESC", "next_scan_time ASC").
+			Limit(limit).
+			Scan(ctx)
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get due users: %w", err)
+	}
+
+	return entries, nil
+}
+
+// DeferUsers pushes back the next scan of the given users to the given time.
+func (r *CacheModel) DeferUsers(ctx context.Context, userIDs []int64, nextScanTime time.Time) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		_, err := r.db.NewUpdate().
+			Model((*types.UserProcessingLog)(nil)).
+			Set("next_scan_time = ?", nextScanTime).
+			Where("user_id IN (?)", bun.In(userIDs)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to defer user scans: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// MarkUsersClearedWithTx records a reviewer clearing the given users using the provided transaction.
+// Their risk score is scaled down and their next scan is pushed back to at least the given time.
+func (r *CacheModel) MarkUsersClearedWithTx(
+	ctx context.Context, tx bun.IDB, userIDs []int64, riskMultiplier float64, nextScanTime time.Time,
+) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	_, err := tx.NewUpdate().
+		Model((*types.UserProcessingLog)(nil)).
+		Set("is_cleared = TRUE").
+		Set("is_confirmed = FALSE").
+		Set("risk_score = risk_score * ?", riskMultiplier).
+		Set("next_scan_time = GREATEST(next_scan_time, ?)", nextScanTime).
+		Where("user_id IN (?)", bun.In(userIDs)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark users as cleared in processing logs: %w", err)
+	}
+
+	r.logger.Debug("Marked users as cleared in processing logs",
+		zap.Int("userCount", len(userIDs)))
+
+	return nil
+}
+
+// MarkUsersConfirmedWithTx records a reviewer confirming the given users using the provided
+// transaction, which removes them from the scan queue.
+func (r *CacheModel) MarkUsersConfirmedWithTx(ctx context.Context, tx bun.IDB, userIDs []int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	_, err := tx.NewUpdate().
+		Model((*types.UserProcessingLog)(nil)).
+		Set("is_cleared = FALSE").
+		Set("is_confirmed = TRUE").
+		Set("risk_score = 0").
+		Where("user_id IN (?)", bun.In(userIDs)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to mark users as confirmed in processing logs: %w", err)
+	}
+
+	r.logger.Debug("Marked users as confirmed in processing logs",
+		zap.Int("userCount", len(userIDs)))
+
+	return nil
+}
+
+// ClearReviewDecisionWithTx forgets a reviewer decision on the given users using the
+// provided transaction, so their rescans are scheduled from their risk alone again.
+func (r *CacheModel) ClearReviewDecisionWithTx(ctx context.Context, tx bun.IDB, userIDs []int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	_, err := tx.NewUpdate().
+		Model((*types.UserProcessingLog)(nil)).
+		Set("is_cleared = FALSE").
+		Set("is_confirmed = FALSE").
+		Where("user_id IN (?)", bun.In(userIDs)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to clear review decision in processing logs: %w", err)
+	}
+
+	r.logger.Debug("Cleared review decision in processing logs",
+		zap.Int("userCount", len(userIDs)))
+
+	return nil
+}
+
 // DeleteUserCacheWithTx removes cache entries for the given users using the provided transaction.
 func (r *CacheModel) DeleteUserCacheWithTx(ctx context.Context, tx bun.Tx, userIDs []int64) error {
 	if len(userIDs) == 0 {
@@ -0,0 +1,213 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robalyx/rotector/internal/database/dbretry"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// DecisionModel handles database operations for review decisions awaiting consensus.
+type DecisionModel struct {
+	db     *bun.DB
+	logger *zap.Logger
+}
+
+// NewDecision creates a DecisionModel for managing pending decisions.
+func NewDecision(db *bun.DB, logger *zap.Logger) *DecisionModel {
+	return &DecisionModel{
+		db:     db,
+		logger: logger.Named("db_decision"),
+	}
+}
+
+// GetOpenDecision retrieves the pending or escalated decision of a target.
+// Returns types.ErrDecisionNotFound if the target has no open decision.
+func (r *DecisionModel) GetOpenDecision(
+	ctx context.Context, targetType types.DecisionTargetType, targetID int64,
+) (*types.PendingDecision, error) {
+	decision, err := dbretry.Operation(ctx, func(ctx context.Context) (*types.PendingDecision, error) {
+		var decision types.PendingDecision
+
+		err := r.db.NewSelect().
+			Model(&decision).
+			Where("target_type = ?", targetType).
+			Where("target_id = ?", targetID).
+			Where("status IN (?)", bun.In([]types.DecisionStatus{
+				types.DecisionStatusPending, types.DecisionStatusEscalated,
+			})).
+			Limit(1).
+			Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &decision, nil
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, types.ErrDecisionNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get open decision: %w", err)
+	}
+
+	return decision, nil
+}
+
+// CreateDecision records the first vote of a decision. Returns types.ErrDecisionExists
+// if another reviewer opened a decision for the same target first.
+func (r *DecisionModel) CreateDecision(ctx context.Context, decision *types.PendingDecision) error {
+	now := time.Now()
+	decision.Status = types.DecisionStatusPending
+	decision.FirstVotedAt = now
+	decision.CreatedAt = now
+	decision.UpdatedAt = now
+
+	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		result, err := r.db.NewInsert().
+			Model(decision).
+			On("CONFLICT (target_type, target_id) WHERE status IN ('pending', 'escalated') DO NOTHING").
+			Returning("id").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create pending decision: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		if affected == 0 {
+			return types.ErrDecisionExists
+		}
+
+		r.logger.Debug("Created pending decision",
+			zap.String("targetType", string(decision.TargetType)),
+			zap.Int64("targetID", decision.TargetID),
+			zap.String("action", string(decision.FirstAction)),
+			zap.Uint64("reviewerID", decision.FirstReviewerID))
+
+		return nil
+	})
+}
+
+// RecordSecondVote stores the vote of a second reviewer on a pending decision and
+// moves it to the given status. Returns types.ErrDecisionNotPending if the decision
+// was already decided or the reviewer cast the first vote.
+func (r *DecisionModel) RecordSecondVote(
+	ctx context.Context, decision *types.PendingDecision, reviewerID uint64,
+	action types.DecisionAction, status types.DecisionStatus,
+) error {
+	now := time.Now()
+
+	err := dbretry.NoResult(ctx, func(ctx context.Context) error {
+		result, err := r.db.NewUpdate().
+			Model((*types.PendingDecision)(nil)).
+			Set("second_reviewer_id = ?", reviewerID).
+			Set("second_action = ?", action).
+			Set("second_voted_at = ?", now).
+			Set("status = ?", status).
+			Set("updated_at = ?", now).
+			Where("id = ?", decision.ID).
+			Where("status = ?", types.DecisionStatusPending).
+			Where("first_reviewer_id != ?", reviewerID).
+			Exec(ctx)
+
+		return checkDecisionResult(result, err)
+	})
+	if err != nil {
+		return err
+	}
+
+	decision.SecondReviewerID = reviewerID
+	decision.SecondAction = action
+	decision.SecondVotedAt = now
+	decision.Status = status
+	decision.UpdatedAt = now
+
+	return nil
+}
+
+// ResolveDecision closes an escalated decision with an admin's final action.
+// Returns types.ErrDecisionNotPending if the decision was already resolved.
+func (r *DecisionModel) ResolveDecision(
+	ctx context.Context, decision *types.PendingDecision, adminID uint64, action types.DecisionAction,
+) error {
+	now := time.Now()
+
+	err := dbretry.NoResult(ctx, func(ctx context.Context) error {
+		result, err := r.db.NewUpdate().
+			Model((*types.PendingDecision)(nil)).
+			Set("resolver_id = ?", adminID).
+			Set("resolved_action = ?", action).
+			Set("resolved_at = ?", now).
+			Set("status = ?", types.DecisionStatusResolved).
+			Set("updated_at = ?", now).
+			Where("id = ?", decision.ID).
+			Where("status = ?", types.DecisionStatusEscalated).
+			Exec(ctx)
+
+		return checkDecisionResult(result, err)
+	})
+	if err != nil {
+		return err
+	}
+
+	decision.ResolverID = adminID
+	decision.ResolvedAction = action
+	decision.ResolvedAt = now
+	decision.Status = types.DecisionStatusResolved
+	decision.UpdatedAt = now
+
+	return nil
+}
+
+// checkDecisionResult converts an update that matched no open decision into
+// types.ErrDecisionNotPending.
+func checkDecisionResult(result sql.Result, err error) error {
+	if err != nil {
+		return fmt.Errorf("failed to update pending decision: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return types.ErrDecisionNotPending
+	}
+
+	return nil
+}
+
+// applyDecisionFilter narrows a review queue query by the open consensus decisions
+// of its targets. The ID column is the qualified ID column of the queried table.
+func applyDecisionFilter(
+	query *bun.SelectQuery, filter *types.ReviewQueueFilter, targetType types.DecisionTargetType, idColumn string,
+) {
+	openDecision := "SELECT 1 FROM pending_decisions pd WHERE pd.target_type = ? AND pd.target_id = " + idColumn
+
+	if filter.VoterID != 0 {
+		query.Where("NOT EXISTS ("+openDecision+" AND pd.status IN (?) "+
+			"AND (pd.first_reviewer_id = ? OR pd.second_reviewer_id = ?))",
+			targetType, bun.In([]types.DecisionStatus{types.DecisionStatusPending, types.DecisionStatusEscalated}),
+			filter.VoterID, filter.VoterID)
+	}
+
+	if filter.SkipEscalated {
+		query.Where("NOT EXISTS ("+openDecision+" AND pd.status = ?)", targetType, types.DecisionStatusEscalated)
+	}
+
+	if filter.OnlyEscalated {
+		query.Where("EXISTS ("+openDecision+" AND pd.status = ?)", targetType, types.DecisionStatusEscalated)
+	}
+}
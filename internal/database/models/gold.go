@@ -0,0 +1,177 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robalyx/rotector/internal/database/dbretry"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// GoldModel handles database operations for gold-standard review items.
+type GoldModel struct {
+	db     *bun.DB
+	logger *zap.Logger
+}
+
+// NewGold creates a GoldModel for managing gold items and reviewer answers.
+func NewGold(db *bun.DB, logger *zap.Logger) *GoldModel {
+	return &GoldModel{
+		db:     db,
+		logger: logger.Named("db_gold"),
+	}
+}
+
+// SaveGoldItem marks a target as a gold item or updates its expected action.
+func (r *GoldModel) SaveGoldItem(ctx context.Context, item *types.GoldItem) error {
+	item.CreatedAt = time.Now()
+
+	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		_, err := r.db.NewInsert().
+			Model(item).
+			On("CONFLICT (target_type, target_id) DO UPDATE").
+			Set("expected_action = EXCLUDED.expected_action").
+			Set("created_by = EXCLUDED.created_by").
+			Set("created_at = EXCLUDED.created_at").
+			Returning("id").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to save gold item: %w", err)
+		}
+
+		r.logger.Debug("Saved gold item",
+			zap.String("targetType", string(item.TargetType)),
+			zap.Int64("targetID", item.TargetID),
+			zap.String("expectedAction", string(item.ExpectedAction)))
+
+		return nil
+	})
+}
+
+// DeleteGoldItem removes a target from the gold set. Past answers are kept.
+func (r *GoldModel) DeleteGoldItem(
+	ctx context.Context, targetType types.DecisionTargetType, targetID int64,
+) error {
+	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		_, err := r.db.NewDelete().
+			Model((*types.GoldItem)(nil)).
+			Where("target_type = ?", targetType).
+			Where("target_id = ?", targetID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete gold item: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetGoldItem retrieves the gold item of a target.
+// Returns types.ErrGoldItemNotFound if the target is not a gold item.
+func (r *GoldModel) GetGoldItem(
+	ctx context.Context, targetType types.DecisionTargetType, targetID int64,
+) (*types.GoldItem, error) {
+	item, err := dbretry.Operation(ctx, func(ctx context.Context) (*types.GoldItem, error) {
+		var item types.GoldItem
+
+		err := r.db.NewSelect().
+			Model(&item).
+			Where("target_type = ?", targetType).
+			Where("target_id = ?", targetID).
+			Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &item, nil
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, types.ErrGoldItemNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get gold item: %w", err)
+	}
+
+	return item, nil
+}
+
+// GetGoldTargetIDs retrieves the target IDs of gold items of a type, excluding the given IDs.
+func (r *GoldModel) GetGoldTargetIDs(
+	ctx context.Context, targetType types.DecisionTargetType, excludeIDs []int64,
+) ([]int64, error) {
+	return dbretry.Operation(ctx, func(ctx context.Context) ([]int64, error) {
+		var targetIDs []int64
+
+		query := r.db.NewSelect().
+			Model((*types.GoldItem)(nil)).
+			Column("target_id").
+			Where("target_type = ?", targetType)
+
+		if len(excludeIDs) > 0 {
+			query.Where("target_id NOT IN (?)", bun.In(excludeIDs))
+		}
+
+		err := query.Scan(ctx, &targetIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get gold target IDs: %w", err)
+		}
+
+		return targetIDs, nil
+	})
+}
+
+// SaveGoldResult records the answer of a reviewer on a gold item.
+func (r *GoldModel) SaveGoldResult(ctx context.Context, result *types.GoldResult) error {
+	result.CreatedAt = time.Now()
+
+	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		_, err := r.db.NewInsert().
+			Model(result).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to save gold result: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetReviewerAccuracies summarizes the gold item answers of reviewers since the given time.
+// Reviewers without answers are omitted from the result.
+func (r *GoldModel) GetReviewerAccuracies(
+	ctx context.Context, reviewerIDs []uint64, since time.Time,
+) (map[uint64]*types.ReviewerAccuracy, error) {
+	if len(reviewerIDs) == 0 {
+		return map[uint64]*types.ReviewerAccuracy{}, nil
+	}
+
+	return dbretry.Operation(ctx, func(ctx context.Context) (map[uint64]*types.ReviewerAccuracy, error) {
+		var accuracies []*types.ReviewerAccuracy
+
+		err := r.db.NewSelect().
+			Model((*types.GoldResult)(nil)).
+			Column("reviewer_id").
+			ColumnExpr("COUNT(*) AS answered").
+			ColumnExpr("COUNT(*) FILTER (WHERE correct) AS correct").
+			Where("reviewer_id IN (?)", bun.In(reviewerIDs)).
+			Where("created_at >= ?", since).
+			Group("reviewer_id").
+			Scan(ctx, &accuracies)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get reviewer accuracies: %w", err)
+		}
+
+		result := make(map[uint64]*types.ReviewerAccuracy, len(accuracies))
+		for _, accuracy := range accuracies {
+			result[accuracy.ReviewerID] = accuracy
+		}
+
+		return result, nil
+	})
+}
@@ -38,6 +38,12 @@ func (r *GroupModel) SaveGroups(ctx context.Context, tx bun.Tx, groups []*types.
 		return nil
 	}
 
+	// Capture the state of groups whose status is about to change
+	before, err := r.getChangingStatusSnapshotsWithTx(ctx, tx, groups)
+	if err != nil {
+		return err
+	}
+
 	// Extract base groups
 	baseGroups := make([]*types.Group, len(groups))
 	for i, group := range groups {
@@ -45,7 +51,7 @@ func (r *GroupModel) SaveGroups(ctx context.Context, tx bun.Tx, groups []*types.
 	}
 
 	// Update groups table
-	_, err := tx.NewInsert().
+	_, err = tx.NewInsert().
 		Model(&baseGroups).
 		On("CONFLICT (id) DO UPDATE").
 		Set("uuid = EXCLUDED.uuid").
@@ -99,6 +105,14 @@ func (r *GroupModel) SaveGroups(ctx context.Context, tx bun.Tx, groups []*types.
 		}
 	}
 
+	// Record the status changes without a reviewer as they were not reviewer decisions
+	for groupID, snapshot := range before {
+		changed := &types.ReviewGroup{Group: &types.Group{ID: groupID}}
+		if err := r.recordStatusChangeWithTx(ctx, tx, snapshot, changed); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -107,8 +121,14 @@ func (r *GroupModel) SaveGroups(ctx context.Context, tx bun.Tx, groups []*types.
 // Deprecated: Use Service().Group().ConfirmGroup() instead.
 func (r *GroupModel) ConfirmGroup(ctx context.Context, group *types.ReviewGroup) error {
 	return dbretry.Transaction(ctx, r.db, func(ctx context.Context, tx bun.Tx) error {
+		// Capture the state before the decision for the audit trail
+		before, err := r.getStatusSnapshotWithTx(ctx, tx, group.ID)
+		if err != nil {
+			return err
+		}
+
 		// Delete any existing mixed classification record
-		_, err := tx.NewDelete().
+		_, err = tx.NewDelete().
 			Model((*types.GroupMixedClassification)(nil)).
 			Where("group_id = ?", group.ID).
 			Exec(ctx)
@@ -171,7 +191,7 @@ func (r *GroupModel) ConfirmGroup(ctx context.Context, group *types.ReviewGroup)
 			}
 		}
 
-		return nil
+		return r.recordStatusChangeWithTx(ctx, tx, before, group)
 	})
 }
 
@@ -180,8 +200,14 @@ func (r *GroupModel) ConfirmGroup(ctx context.Context, group *types.ReviewGroup)
 // Deprecated: Use Service().Group().MixGroup() instead.
 func (r *GroupModel) MixGroup(ctx context.Context, group *types.ReviewGroup) error {
 	return dbretry.Transaction(ctx, r.db, func(ctx context.Context, tx bun.Tx) error {
+		// Capture the state before the decision for the audit trail
+		before, err := r.getStatusSnapshotWithTx(ctx, tx, group.ID)
+		if err != nil {
+			return err
+		}
+
 		// Delete any existing verification record
-		_, err := tx.NewDelete().
+		_, err = tx.NewDelete().
 			Model((*types.GroupVerification)(nil)).
 			Where("group_id = ?", group.ID).
 			Exec(ctx)
@@ -244,7 +270,27 @@ func (r *GroupModel) MixGroup(ctx context.Context, group *types.ReviewGroup) err
 			}
 		}
 
-		return nil
+		return r.recordStatusChangeWithTx(ctx, tx, before, group)
+	})
+}
+
+// SearchGroupsByName retrieves tracked groups whose name starts with the given prefix.
+func (r *GroupModel) SearchGroupsByName(ctx context.Context, prefix string, limit int) ([]*types.Group, error) {
+	return dbretry.Operation(ctx, func(ctx context.Context) ([]*types.Group, error) {
+		var groups []*types.Group
+
+		err := r.db.NewSelect().
+			Model(&groups).
+			Column("id", "name", "status").
+			Where("lower(name) LIKE ?", namePrefixPattern(prefix)).
+			OrderExpr("lower(name)").
+			Limit(limit).
+			Scan(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search groups by name: %w", err)
+		}
+
+		return groups, nil
 	})
 }
 
@@ -791,7 +837,7 @@ func (r *GroupModel) GetGroupToScan(ctx context.Context) (*types.Group, error) {
 //
 // Deprecated: Use Service().Group().GetGroupToReview() instead.
 func (r *GroupModel) GetNextToReview(
-	ctx context.Context, targetStatus enum.GroupType, sortBy enum.ReviewSortBy, recentIDs []int64,
+	ctx context.Context, targetStatus enum.GroupType, sortBy enum.ReviewSortBy, filter *types.ReviewQueueFilter,
 ) (*types.ReviewGroup, error) {
 	var (
 		group  types.Group
@@ -804,11 +850,38 @@ func (r *GroupModel) GetNextToReview(
 			Model(&group).
 			Where("status = ?", targetStatus)
 
-		// Exclude recently reviewed IDs if any exist
-		if len(recentIDs) > 0 {
-			query.Where("id NOT IN (?)", bun.In(recentIDs))
+		// Exclude recently reviewed or claimed IDs if any exist
+		if len(filter.ExcludeIDs) > 0 {
+			query.Where("id NOT IN (?)", bun.In(filter.ExcludeIDs))
+		}
+
+		// Limit to specific IDs such as gold items if requested
+		if len(filter.OnlyIDs) > 0 {
+			query.Where("id IN (?)", bun.In(filter.OnlyIDs))
+		}
+
+		// Apply the reviewer's filter preset
+		if filter.MinConfidence > 0 {
+			query.Where("confidence >= ?", filter.MinConfidence)
+		}
+
+		if filter.MaxConfidence > 0 {
+			query.Where("confidence <= ?", filter.MaxConfidence)
 		}
 
+		if len(filter.GroupReasonTypes) > 0 {
+			query.Where("EXISTS (SELECT 1 FROM group_reasons gr "+
+				"WHERE gr.group_id = \"group\".id AND gr.reason_type IN (?))", bun.In(filter.GroupReasonTypes))
+		}
+
+		if filter.TouchedBy != 0 {
+			query.Where("EXISTS (SELECT 1 FROM activity_logs al "+
+				"WHERE al.group_id = \"group\".id AND al.reviewer_id = ?)", filter.TouchedBy)
+		}
+
+		// Apply open consensus decisions
+		applyDecisionFilter(query, filter, types.DecisionTargetGroup, `"group".id`)
+
 		// Apply sort order
 		switch sortBy {
 		case enum.ReviewSortByConfidence:
@@ -901,3 +974,395 @@ func (r *GroupModel) GetNextToReview(
 
 	return &result, err
 }
+
+// GetStatusChange retrieves a group status change by its ID.
+// Returns types.ErrStatusChangeNotFound if the change does not exist.
+func (r *GroupModel) GetStatusChange(ctx context.Context, changeID int64) (*types.GroupStatusChange, error) {
+	change, err := dbretry.Operation(ctx, func(ctx context.Context) (*types.GroupStatusChange, error) {
+		var change types.GroupStatusChange
+
+		err := r.db.NewSelect().
+			Model(&change).
+			Where("id = ?", changeID).
+			Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &change, nil
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, types.ErrStatusChangeNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get status change: %w", err)
+	}
+
+	return change, nil
+}
+
+// GetLatestStatusChange retrieves the most recent status change of a group.
+// Returns types.ErrStatusChangeNotFound if the group has no recorded changes.
+func (r *GroupModel) GetLatestStatusChange(ctx context.Context, groupID int64) (*types.GroupStatusChange, error) {
+	change, err := dbretry.Operation(ctx, func(ctx context.Context) (*types.GroupStatusChange, error) {
+		var change types.GroupStatusChange
+
+		err := r.db.NewSelect().
+			Model(&change).
+			Where("group_id = ?", groupID).
+			Order("id DESC").
+			Limit(1).
+			Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &change, nil
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, types.ErrStatusChangeNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get latest status change: %w", err)
+	}
+
+	return change, nil
+}
+
+// RevertStatusChangeWithTx restores a group to the state before a status change using the
+// provided transaction. Only the latest change of a group that was not already reverted can
+// be reverted. The revert is recorded as a new status change, which is returned.
+func (r *GroupModel) RevertStatusChangeWithTx(
+	ctx context.Context, tx bun.Tx, changeID int64, reviewerID uint64,
+) (*types.GroupStatusChange, error) {
+	// Lock the change so concurrent reverts cannot both succeed
+	var change types.GroupStatusChange
+
+	err := tx.NewSelect().
+		Model(&change).
+		Where("id = ?", changeID).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, types.ErrStatusChangeNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get status change: %w", err)
+	}
+
+	if change.IsReverted() {
+		return nil, types.ErrStatusChangeReverted
+	}
+
+	// Ensure no newer decision was made on the group
+	newer, err := tx.NewSelect().
+		Model((*types.GroupStatusChange)(nil)).
+		Where("group_id = ?", change.GroupID).
+		Where("id > ?", change.ID).
+		Exists(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for newer status changes: %w", err)
+	}
+
+	if newer {
+		return nil, types.ErrStatusChangeSuperseded
+	}
+
+	before, err := r.getStatusSnapshotWithTx(ctx, tx, change.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	if before == nil {
+		return nil, types.ErrGroupNotFound
+	}
+
+	// Refuse to overwrite a state that changed since the decision
+	if !before.Matches(change.After) {
+		return nil, types.ErrStatusChangeStale
+	}
+
+	// Restore status and confidence
+	target := change.Before
+
+	_, err = tx.NewUpdate().
+		Model((*types.Group)(nil)).
+		Set("status = ?", target.Status).
+		Set("confidence = ?", target.Confidence).
+		Where("id = ?", change.GroupID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore group status: %w", err)
+	}
+
+	// Restore reasons
+	_, err = tx.NewDelete().
+		Model((*types.GroupReason)(nil)).
+		Where("group_id = ?", change.GroupID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete group reasons: %w", err)
+	}
+
+	if len(target.Reasons) > 0 {
+		reasons := make([]*types.GroupReason, 0, len(target.Reasons))
+		for reasonType, reason := range target.Reasons {
+			reasons = append(reasons, &types.GroupReason{
+				GroupID:    change.GroupID,
+				ReasonType: reasonType,
+				Message:    reason.Message,
+				Confidence: reason.Confidence,
+				Evidence:   reason.Evidence,
+				CreatedAt:  time.Now(),
+			})
+		}
+
+		_, err = tx.NewInsert().
+			Model(&reasons).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore group reasons: %w", err)
+		}
+	}
+
+	// Restore verification and mixed classification records
+	_, err = tx.NewDelete().
+		Model((*types.GroupVerification)(nil)).
+		Where("group_id = ?", change.GroupID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete verification record: %w", err)
+	}
+
+	_, err = tx.NewDelete().
+		Model((*types.GroupMixedClassification)(nil)).
+		Where("group_id = ?", change.GroupID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete mixed classification record: %w", err)
+	}
+
+	if !target.VerifiedAt.IsZero() {
+		_, err = tx.NewInsert().
+			Model(&types.GroupVerification{
+				GroupID:    change.GroupID,
+				ReviewerID: target.ReviewerID,
+				VerifiedAt: target.VerifiedAt,
+			}).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore verification record: %w", err)
+		}
+	}
+
+	if !target.MixedAt.IsZero() {
+		_, err = tx.NewInsert().
+			Model(&types.GroupMixedClassification{
+				GroupID:    change.GroupID,
+				ReviewerID: target.ReviewerID,
+				MixedAt:    target.MixedAt,
+			}).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore mixed classification record: %w", err)
+		}
+	}
+
+	// Record the revert as a new change and mark the original as reverted
+	after, err := r.getStatusSnapshotWithTx(ctx, tx, change.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	revert := &types.GroupStatusChange{
+		GroupID:    change.GroupID,
+		ReviewerID: reviewerID,
+		Before:     before,
+		After:      after,
+		RevertOf:   change.ID,
+		CreatedAt:  now,
+	}
+
+	_, err = tx.NewInsert().
+		Model(revert).
+		Returning("id").
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record revert: %w", err)
+	}
+
+	_, err = tx.NewUpdate().
+		Model((*types.GroupStatusChange)(nil)).
+		Set("reverted_by = ?", reviewerID).
+		Set("reverted_at = ?", now).
+		Where("id = ?", change.ID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark status change as reverted: %w", err)
+	}
+
+	r.logger.Debug("Reverted status change",
+		zap.Int64("changeID", change.ID),
+		zap.Int64("groupID", change.GroupID),
+		zap.String("restoredStatus", target.Status.String()),
+		zap.Uint64("reviewerID", reviewerID))
+
+	return revert, nil
+}
+
+// getStatusSnapshotWithTx loads the review state of a group using the provided transaction.
+// Returns nil if the group does not exist.
+func (r *GroupModel) getStatusSnapshotWithTx(
+	ctx context.Context, tx bun.IDB, groupID int64,
+) (*types.GroupStatusSnapshot, error) {
+	var group types.Group
+
+	err := tx.NewSelect().
+		Model(&group).
+		Column("id", "status", "confidence").
+		Where("id = ?", groupID).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil //nolint:nilnil // missing groups have no snapshot
+		}
+
+		return nil, fmt.Errorf("failed to get group state: %w", err)
+	}
+
+	snapshot := &types.GroupStatusSnapshot{
+		Status:     group.Status,
+		Confidence: group.Confidence,
+		Reasons:    make(types.Reasons[enum.GroupReasonType]),
+	}
+
+	var reasons []*types.GroupReason
+
+	err = tx.NewSelect().
+		Model(&reasons).
+		Where("group_id = ?", groupID).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group reasons: %w", err)
+	}
+
+	for _, reason := range reasons {
+		snapshot.Reasons[reason.ReasonType] = &types.Reason{
+			Message:    reason.Message,
+			Confidence: reason.Confidence,
+			Evidence:   reason.Evidence,
+		}
+	}
+
+	var verification types.GroupVerification
+
+	err = tx.NewSelect().
+		Model(&verification).
+		Where("group_id = ?", groupID).
+		Scan(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get verification record: %w", err)
+	}
+
+	if err == nil {
+		snapshot.ReviewerID = verification.ReviewerID
+		snapshot.VerifiedAt = verification.VerifiedAt
+	}
+
+	var mixed types.GroupMixedClassification
+
+	err = tx.NewSelect().
+		Model(&mixed).
+		Where("group_id = ?", groupID).
+		Scan(ctx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get mixed classification record: %w", err)
+	}
+
+	if err == nil {
+		snapshot.ReviewerID = mixed.ReviewerID
+		snapshot.MixedAt = mixed.MixedAt
+	}
+
+	return snapshot, nil
+}
+
+// getChangingStatusSnapshotsWithTx loads the review state of the given groups whose stored
+// status differs from their new status. New groups and groups keeping their status are left out.
+func (r *GroupModel) getChangingStatusSnapshotsWithTx(
+	ctx context.Context, tx bun.IDB, groups []*types.ReviewGroup,
+) (map[int64]*types.GroupStatusSnapshot, error) {
+	groupIDs := make([]int64, len(groups))
+	for i, group := range groups {
+		groupIDs[i] = group.ID
+	}
+
+	var existing []*types.Group
+
+	err := tx.NewSelect().
+		Model(&existing).
+		Column("id", "status").
+		Where("id IN (?)", bun.In(groupIDs)).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get group statuses: %w", err)
+	}
+
+	statuses := make(map[int64]enum.GroupType, len(existing))
+	for _, group := range existing {
+		statuses[group.ID] = group.Status
+	}
+
+	snapshots := make(map[int64]*types.GroupStatusSnapshot)
+
+	for _, group := range groups {
+		if status, ok := statuses[group.ID]; !ok || status == group.Status {
+			continue
+		}
+
+		snapshot, err := r.getStatusSnapshotWithTx(ctx, tx, group.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if snapshot != nil {
+			snapshots[group.ID] = snapshot
+		}
+	}
+
+	return snapshots, nil
+}
+
+// recordStatusChangeWithTx records the status change made on a group, comparing its current
+// state to the state captured before the change. Nothing is recorded without a captured state.
+func (r *GroupModel) recordStatusChangeWithTx(
+	ctx context.Context, tx bun.IDB, before *types.GroupStatusSnapshot, group *types.ReviewGroup,
+) error {
+	if before == nil {
+		return nil
+	}
+
+	after, err := r.getStatusSnapshotWithTx(ctx, tx, group.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.NewInsert().
+		Model(&types.GroupStatusChange{
+			GroupID:    group.ID,
+			ReviewerID: group.ReviewerID,
+			Before:     before,
+			After:      after,
+			CreatedAt:  time.Now(),
+		}).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record status change: %w", err)
+	}
+
+	return nil
+}
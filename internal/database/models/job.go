@@ -0,0 +1,337 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robalyx/rotector/internal/database/dbretry"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// JobModel handles database operations for the durable job queue.
+type JobModel struct {
+	db     *bun.DB
+	logger *zap.Logger
+}
+
+// NewJob creates a JobModel for managing queued jobs.
+func NewJob(db *bun.DB, logger *zap.Logger) *JobModel {
+	return &JobModel{
+		db:     db,
+		logger: logger.Named("db_job"),
+	}
+}
+
+// Enqueue adds jobs to the queue. Jobs whose idempotency key matches a pending
+// or leased job are skipped. Returns the number of jobs that were added.
+func (r *JobModel) Enqueue(ctx context.Context, jobs []*types.Job) (int, error) {
+	if len(jobs) == 0 {
+		return 0, nil
+	}
+
+	now := time.Now()
+	for _, job := range jobs {
+		job.Status = types.JobStatusPending
+		job.CreatedAt = now
+		job.UpdatedAt = now
+
+		if job.AvailableAt.IsZero() {
+			job.AvailableAt = now
+		}
+	}
+
+	return dbretry.Operation(ctx, func(ctx context.Context) (int, error) {
+		result, err := r.db.NewInsert().
+			Model(&jobs).
+			On("CONFLICT (idempotency_key) WHERE status IN ('pending', 'leased') DO NOTHING").
+			Exec(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to enqueue jobs: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		return int(affected), nil
+	})
+}
+
+// Lease claims the oldest available job of the given type for the owner.
+// Pending jobs and jobs whose lease expired with attempts remaining can be leased.
+// Returns types.ErrNoJobsAvailable if there is nothing to lease.
+func (r *JobModel) Lease(
+	ctx context.Context, jobType types.JobType, owner string, leaseDuration time.Duration,
+) (*types.Job, error) {
+	var job types.Job
+
+	err := dbretry.Transaction(ctx, r.db, func(ctx context.Context, tx bun.Tx) error {
+		now := time.Now()
+
+		err := tx.NewSelect().
+			Model(&job).
+			Where("type = ?", jobType).
+			WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.
+					WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+						return q.
+							Where("status = ?", types.JobStatusPending).
+							Where("available_at <= ?", now)
+					}).
+					WhereGroup(" OR ", func(q *bun.SelectQuery) *bun.SelectQuery {
+						return q.
+							Where("status = ?", types.JobStatusLeased).
+							Where("lease_expires_at < ?", now).
+							Where("attempts < max_attempts")
+					})
+			}).
+			Order("available_at ASC", "id ASC").
+			Limit(1).
+			For("UPDATE SKIP LOCKED").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		job.Status = types.JobStatusLeased
+		job.Attempts++
+		job.LeaseOwner = owner
+		job.LeaseExpiresAt = now.Add(leaseDuration)
+		job.UpdatedAt = now
+
+		_, err = tx.NewUpdate().
+			Model(&job).
+			Column("status", "attempts", "lease_owner", "lease_expires_at", "updated_at").
+			WherePK().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update job lease: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, types.ErrNoJobsAvailable
+		}
+
+		return nil, fmt.Errorf("failed to lease job: %w", err)
+	}
+
+	r.logger.Debug("Leased job",
+		zap.Int64("jobID", job.ID),
+		zap.String("type", string(job.Type)),
+		zap.Int("attempt", job.Attempts),
+		zap.String("owner", owner))
+
+	return &job, nil
+}
+
+// Heartbeat extends the lease of a job held by the owner and optionally stores
+// an updated payload as a checkpoint. Returns types.ErrJobLeaseLost if the owner
+// no longer holds the lease.
+func (r *JobModel) Heartbeat(
+	ctx context.Context, jobID int64, owner string, leaseDuration time.Duration, payload []byte,
+) error {
+	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		now := time.Now()
+
+		query := r.db.NewUpdate().
+			Model((*types.Job)(nil)).
+			Set("lease_expires_at = ?", now.Add(leaseDuration)).
+			Set("updated_at = ?", now).
+			Where("id = ?", jobID).
+			Where("status = ?", types.JobStatusLeased).
+			Where("lease_owner = ?", owner)
+
+		if payload != nil {
+			query = query.Set("payload = ?", string(payload))
+		}
+
+		return r.checkLeaseResult(query.Exec(ctx))
+	})
+}
+
+// Complete marks a job held by the owner as completed.
+func (r *JobModel) Complete(ctx context.Context, jobID int64, owner string) error {
+	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		result, err := r.db.NewUpdate().
+			Model((*types.Job)(nil)).
+			Set("status = ?", types.JobStatusCompleted).
+			Set("lease_expires_at = NULL").
+			Set("updated_at = ?", time.Now()).
+			Where("id = ?", jobID).
+			Where("status = ?", types.JobStatusLeased).
+			Where("lease_owner = ?", owner).
+			Exec(ctx)
+
+		return r.checkLeaseResult(result, err)
+	})
+}
+
+// Fail records a failed attempt of a job held by the owner. The job is retried after
+// the given delay, or dead-lettered if it has no attempts remaining.
+func (r *JobModel) Fail(ctx context.Context, jobID int64, owner string, jobErr error, retryDelay time.Duration) error {
+	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		now := time.Now()
+
+		result, err := r.db.NewUpdate().
+			Model((*types.Job)(nil)).
+			Set("status = CASE WHEN attempts >= max_attempts THEN ? ELSE ? END",
+				types.JobStatusDead, types.JobStatusPending).
+			Set("available_at = ?", now.Add(retryDelay)).
+			Set("lease_expires_at = NULL").
+			Set("last_error = ?", jobErr.Error()).
+			Set("updated_at = ?", now).
+			Where("id = ?", jobID).
+			Where("status = ?", types.JobStatusLeased).
+			Where("lease_owner = ?", owner).
+			Exec(ctx)
+
+		return r.checkLeaseResult(result, err)
+	})
+}
+
+// DeadLetterExpiredJobs dead-letters jobs whose lease expired after their last attempt.
+// Returns the number of jobs that were dead-lettered.
+func (r *JobModel) DeadLetterExpiredJobs(ctx context.Context) (int, error) {
+	return dbretry.Operation(ctx, func(ctx context.Context) (int, error) {
+		now := time.Now()
+
+		result, err := r.db.NewUpdate().
+			Model((*types.Job)(nil)).
+			Set("status = ?", types.JobStatusDead).
+			Set("last_error = ?", "lease expired on final attempt").
+			Set("lease_expires_at = NULL").
+			Set("updated_at = ?", now).
+			Where("status = ?", types.JobStatusLeased).
+			Where("lease_expires_at < ?", now).
+			Where("attempts >= max_attempts").
+			Exec(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to dead-letter expired jobs: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		return int(affected), nil
+	})
+}
+
+// GetDeadJobs retrieves the most recently dead-lettered jobs.
+func (r *JobModel) GetDeadJobs(ctx context.Context, limit int) ([]*types.Job, error) {
+	return dbretry.Operation(ctx, func(ctx context.Context) ([]*types.Job, error) {
+		var jobs []*types.Job
+
+		err := r.db.NewSelect().
+			Model(&jobs).
+			Where("status = ?", types.JobStatusDead).
+			Order("updated_at DESC").
+			Limit(limit).
+			Scan(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get dead jobs: %w", err)
+		}
+
+		return jobs, nil
+	})
+}
+
+// RequeueDeadJobs moves dead-lettered jobs back to pending with their attempts reset.
+// Jobs whose idempotency key is already active are left dead-lettered.
+func (r *JobModel) RequeueDeadJobs(ctx context.Context, jobIDs []int64) (int, error) {
+	if len(jobIDs) == 0 {
+		return 0, nil
+	}
+
+	return dbretry.Operation(ctx, func(ctx context.Context) (int, error) {
+		now := time.Now()
+
+		result, err := r.db.NewUpdate().
+			Model((*types.Job)(nil)).
+			Set("status = ?", types.JobStatusPending).
+			Set("attempts = 0").
+			Set("available_at = ?", now).
+			Set("updated_at = ?", now).
+			Where("id IN (?)", bun.In(jobIDs)).
+			Where("status = ?", types.JobStatusDead).
+			Where("NOT EXISTS (SELECT 1 FROM jobs active WHERE active.idempotency_key = job.idempotency_key "+
+				"AND active.status IN (?))", bun.In([]types.JobStatus{types.JobStatusPending, types.JobStatusLeased})).
+			Exec(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to requeue dead jobs: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		return int(affected), nil
+	})
+}
+
+// CountActiveJobs returns the number of pending and leased jobs of the given type.
+func (r *JobModel) CountActiveJobs(ctx context.Context, jobType types.JobType) (int, error) {
+	return dbretry.Operation(ctx, func(ctx context.Context) (int, error) {
+		count, err := r.db.NewSelect().
+			Model((*types.Job)(nil)).
+			Where("type = ?", jobType).
+			Where("status IN (?)", bun.In([]types.JobStatus{types.JobStatusPending, types.JobStatusLeased})).
+			Count(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to count active jobs: %w", err)
+		}
+
+		return count, nil
+	})
+}
+
+// PurgeFinishedJobs removes completed and dead-lettered jobs last updated before the cutoff.
+// Returns the number of jobs that were removed.
+func (r *JobModel) PurgeFinishedJobs(ctx context.Context, cutoff time.Time) (int, error) {
+	return dbretry.Operation(ctx, func(ctx context.Context) (int, error) {
+		result, err := r.db.NewDelete().
+			Model((*types.Job)(nil)).
+			Where("status IN (?)", bun.In([]types.JobStatus{types.JobStatusCompleted, types.JobStatusDead})).
+			Where("updated_at < ?", cutoff).
+			Exec(ctx)
+		if err != nil {
+			return 0, fmt.Errorf("failed to purge finished jobs: %w", err)
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get rows affected: %w", err)
+		}
+
+		return int(affected), nil
+	})
+}
+
+// checkLeaseResult converts an update that matched no rows into types.ErrJobLeaseLost.
+func (r *JobModel) checkLeaseResult(result sql.Result, err error) error {
+	if err != nil {
+		return err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	if affected == 0 {
+		return types.ErrJobLeaseLost
+	}
+
+	return nil
+}
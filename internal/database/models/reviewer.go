@@ -165,3 +165,128 @@ func (r *ReviewerModel) SaveReviewerInfos(ctx context.Context, reviewers []*type
 		return nil
 	})
 }
+
+// GetAssignments retrieves all category assignments of reviewers.
+func (r *ReviewerModel) GetAssignments(ctx context.Context) ([]*types.ReviewerAssignment, error) {
+	return dbretry.Operation(ctx, func(ctx context.Context) ([]*types.ReviewerAssignment, error) {
+		var assignments []*types.ReviewerAssignment
+
+		err := r.db.NewSelect().
+			Model(&assignments).
+			Order("reviewer_id", "category").
+			Scan(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get reviewer assignments: %w", err)
+		}
+
+		return assignments, nil
+	})
+}
+
+// SetAssignments replaces the category assignments of a reviewer.
+// An empty category list removes all assignments of the reviewer.
+func (r *ReviewerModel) SetAssignments(
+	ctx context.Context, reviewerID uint64, categories []enum.UserCategoryType, adminID uint64,
+) error {
+	return dbretry.Transaction(ctx, r.db, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().
+			Model((*types.ReviewerAssignment)(nil)).
+			Where("reviewer_id = ?", reviewerID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete reviewer assignments: %w", err)
+		}
+
+		if len(categories) == 0 {
+			return nil
+		}
+
+		now := time.Now()
+
+		assignments := make([]*types.ReviewerAssignment, 0, len(categories))
+		for _, category := range categories {
+			assignments = append(assignments, &types.ReviewerAssignment{
+				ReviewerID: reviewerID,
+				Category:   category,
+				AssignedBy: adminID,
+				AssignedAt: now,
+			})
+		}
+
+		_, err = tx.NewInsert().
+			Model(&assignments).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to insert reviewer assignments: %w", err)
+		}
+
+		r.logger.Debug("Updated reviewer assignments",
+			zap.Uint64("reviewerID", reviewerID),
+			zap.Int("categories", len(categories)))
+
+		return nil
+	})
+}
+
+// GetClusterAssignments retrieves all cluster assignments of reviewers.
+func (r *ReviewerModel) GetClusterAssignments(ctx context.Context) ([]*types.ReviewerClusterAssignment, error) {
+	return dbretry.Operation(ctx, func(ctx context.Context) ([]*types.ReviewerClusterAssignment, error) {
+		var assignments []*types.ReviewerClusterAssignment
+
+		err := r.db.NewSelect().
+			Model(&assignments).
+			Order("reviewer_id", "group_id").
+			Scan(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get reviewer cluster assignments: %w", err)
+		}
+
+		return assignments, nil
+	})
+}
+
+// SetClusterAssignments replaces the cluster assignments of a reviewer.
+// An empty group list removes all cluster assignments of the reviewer.
+func (r *ReviewerModel) SetClusterAssignments(
+	ctx context.Context, reviewerID uint64, groupIDs []int64, adminID uint64,
+) error {
+	return dbretry.Transaction(ctx, r.db, func(ctx context.Context, tx bun.Tx) error {
+		_, err := tx.NewDelete().
+			Model((*types.ReviewerClusterAssignment)(nil)).
+			Where("reviewer_id = ?", reviewerID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to delete reviewer cluster assignments: %w", err)
+		}
+
+		if len(groupIDs) == 0 {
+			return nil
+		}
+
+		now := time.Now()
+
+		assignments := make([]*types.ReviewerClusterAssignment, 0, len(groupIDs))
+		for _, groupID := range groupIDs {
+			assignments = append(assignments, &types.ReviewerClusterAssignment{
+				ReviewerID: reviewerID,
+				GroupID:    groupID,
+				AssignedBy: adminID,
+				AssignedAt: now,
+			})
+		}
+
+		_, err = tx.NewInsert().
+			Model(&assignments).
+			On("CONFLICT DO NOTHING").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to insert reviewer cluster assignments: %w", err)
+		}
+
+		r.logger.Debug("Updated reviewer cluster assignments",
+			zap.Uint64("reviewerID", reviewerID),
+			zap.Int("groups", len(groupIDs)))
+
+		return nil
+	})
+}
@@ -0,0 +1,289 @@
+package models
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/robalyx/rotector/internal/database/dbretry"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// ruleBacktestSampleSize is the number of most recently updated targets a back-test is run
+// against, which keeps it from scanning whole tables.
+const ruleBacktestSampleSize = 50000
+
+// RuleModel handles database operations for reviewer-suggested detection rules.
+type RuleModel struct {
+	db     *bun.DB
+	logger *zap.Logger
+}
+
+// NewRule creates a RuleModel for managing detection rules.
+func NewRule(db *bun.DB, logger *zap.Logger) *RuleModel {
+	return &RuleModel{
+		db:     db,
+		logger: logger.Named("db_rule"),
+	}
+}
+
+// CreateRule saves a new candidate rule.
+// Returns types.ErrRuleExists if the term is already pending or enabled in the same scope.
+func (r *RuleModel) CreateRule(ctx context.Context, rule *types.DetectionRule) error {
+	rule.CreatedAt = time.Now()
+
+	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		result, err := r.db.NewInsert().
+			Model(rule).
+			On("CONFLICT DO NOTHING").
+			Returning("id").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create detection rule: %w", err)
+		}
+
+		if affected, _ := result.RowsAffected(); affected == 0 {
+			return types.ErrRuleExists
+		}
+
+		r.logger.Debug("Created detection rule",
+			zap.Int64("ruleID", rule.ID),
+			zap.String("scope", string(rule.Scope)),
+			zap.Uint64("suggestedBy", rule.SuggestedBy))
+
+		return nil
+	})
+}
+
+// GetRule retrieves a rule by its ID.
+// Returns types.ErrRuleNotFound if the rule does not exist.
+func (r *RuleModel) GetRule(ctx context.Context, ruleID int64) (*types.DetectionRule, error) {
+	rule, err := dbretry.Operation(ctx, func(ctx context.Context) (*types.DetectionRule, error) {
+		var rule types.DetectionRule
+
+		err := r.db.NewSelect().
+			Model(&rule).
+			Where("id = ?", ruleID).
+			Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rule, nil
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, types.ErrRuleNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get detection rule: %w", err)
+	}
+
+	return rule, nil
+}
+
+// GetRulesByStatus retrieves a page of rules with the given status, oldest first.
+// Returns the rules and the total number of rules with the status.
+func (r *RuleModel) GetRulesByStatus(
+	ctx context.Context, status types.RuleStatus, offset, limit int,
+) ([]*types.DetectionRule, int, error) {
+	var (
+		rules []*types.DetectionRule
+		total int
+	)
+
+	err := dbretry.NoResult(ctx, func(ctx context.Context) error {
+		var err error
+
+		total, err = r.db.NewSelect().
+			Model(&rules).
+			Where("status = ?", status).
+			Order("created_at ASC", "id ASC").
+			Offset(offset).
+			Limit(limit).
+			ScanAndCount(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get detection rules: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return rules, total, nil
+}
+
+// GetEnabledRules retrieves every enabled rule of a scope.
+func (r *RuleModel) GetEnabledRules(ctx context.Context, scope types.RuleScope) ([]*types.DetectionRule, error) {
+	return dbretry.Operation(ctx, func(ctx context.Context) ([]*types.DetectionRule, error) {
+		var rules []*types.DetectionRule
+
+		err := r.db.NewSelect().
+			Model(&rules).
+			Where("status = ?", types.RuleStatusEnabled).
+			Where("scope = ?", scope).
+			Order("id ASC").
+			Scan(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get enabled detection rules: %w", err)
+		}
+
+		return rules, nil
+	})
+}
+
+// SetRuleStatus enables or rejects a pending rule.
+// Returns types.ErrRuleNotPending if the rule was already decided.
+func (r *RuleModel) SetRuleStatus(
+	ctx context.Context, ruleID int64, status types.RuleStatus, reviewerID uint64,
+) error {
+	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		result, err := r.db.NewUpdate().
+			Model((*types.DetectionRule)(nil)).
+			Set("status = ?", status).
+			Set("reviewed_by = ?", reviewerID).
+			Set("reviewed_at = ?", time.Now()).
+			Where("id = ?", ruleID).
+			Where("status = ?", types.RuleStatusPending).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update detection rule status: %w", err)
+		}
+
+		if affected, _ := result.RowsAffected(); affected == 0 {
+			return types.ErrRuleNotPending
+		}
+
+		return nil
+	})
+}
+
+// SaveBacktest stores the back-test hit counts of a rule.
+func (r *RuleModel) SaveBacktest(ctx context.Context, ruleID, flaggedHits, clearedHits int64) error {
+	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		_, err := r.db.NewUpdate().
+			Model((*types.DetectionRule)(nil)).
+			Set("flagged_hits = ?", flaggedHits).
+			Set("cleared_hits = ?", clearedHits).
+			Set("backtested_at = ?", time.Now()).
+			Where("id = ?", ruleID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to save detection rule back-test: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// GetRulesToBacktest retrieves pending rules that were never back-tested, oldest first.
+func (r *RuleModel) GetRulesToBacktest(ctx context.Context, limit int) ([]*types.DetectionRule, error) {
+	return dbretry.Operation(ctx, func(ctx context.Context) ([]*types.DetectionRule, error) {
+		var rules []*types.DetectionRule
+
+		err := r.db.NewSelect().
+			Model(&rules).
+			Where("status = ?", types.RuleStatusPending).
+			Where("backtested_at IS NULL").
+			Order("created_at ASC", "id ASC").
+			Limit(limit).
+			Scan(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get detection rules to back-test: %w", err)
+		}
+
+		return rules, nil
+	})
+}
+
+// CountRuleHits counts the recently updated targets that contain a term as a whole word or
+// phrase, split between flagged or confirmed targets and cleared targets. Groups are never
+// cleared outright, so mixed groups count as cleared. Only flagged messages are stored, so
+// messages never have cleared hits. Only the latest ruleBacktestSampleSize targets are counted.
+func (r *RuleModel) CountRuleHits(
+	ctx context.Context, scope types.RuleScope, term string,
+) (int64, int64, error) {
+	var counts struct {
+		Flagged int64
+		Cleared int64
+	}
+
+	pattern := ruleTermPattern(term)
+
+	err := dbretry.NoResult(ctx, func(ctx context.Context) error {
+		var query *bun.SelectQuery
+
+		switch scope {
+		case types.RuleScopeProfile:
+			sample := r.db.NewSelect().
+				Model((*types.User)(nil)).
+				Column("status", "display_name", "description").
+				Where("status IN (?)",
+					bun.In([]enum.UserType{enum.UserTypeFlagged, enum.UserTypeConfirmed, enum.UserTypeCleared})).
+				Order("last_updated DESC").
+				Limit(ruleBacktestSampleSize)
+
+			query = r.db.NewSelect().
+				TableExpr("(?) AS sample", sample).
+				ColumnExpr("COUNT(*) FILTER (WHERE status IN (?)) AS flagged",
+					bun.In([]enum.UserType{enum.UserTypeFlagged, enum.UserTypeConfirmed})).
+				ColumnExpr("COUNT(*) FILTER (WHERE status = ?) AS cleared", enum.UserTypeCleared).
+				Where("description ~* ? OR display_name ~* ?", pattern, pattern)
+		case types.RuleScopeGroup:
+			sample := r.db.NewSelect().
+				Model((*types.Group)(nil)).
+				Column("status", "name", "description").
+				Where("status IN (?)",
+					bun.In([]enum.GroupType{enum.GroupTypeFlagged, enum.GroupTypeConfirmed, enum.GroupTypeMixed})).
+				Order("last_updated DESC").
+				Limit(ruleBacktestSampleSize)
+
+			query = r.db.NewSelect().
+				TableExpr("(?) AS sample", sample).
+				ColumnExpr("COUNT(*) FILTER (WHERE status IN (?)) AS flagged",
+					bun.In([]enum.GroupType{enum.GroupTypeFlagged, enum.GroupTypeConfirmed})).
+				ColumnExpr("COUNT(*) FILTER (WHERE status = ?) AS cleared", enum.GroupTypeMixed).
+				Where("description ~* ? OR name ~* ?", pattern, pattern)
+		case types.RuleScopeMessage:
+			sample := r.db.NewSelect().
+				Model((*types.InappropriateMessage)(nil)).
+				Column("content").
+				Order("detected_at DESC").
+				Limit(ruleBacktestSampleSize)
+
+			query = r.db.NewSelect().
+				TableExpr("(?) AS sample", sample).
+				ColumnExpr("COUNT(*) AS flagged").
+				ColumnExpr("0 AS cleared").
+				Where("content ~* ?", pattern)
+		default:
+			return fmt.Errorf("%w: %q", types.ErrInvalidRuleScope, scope)
+		}
+
+		if err := query.Scan(ctx, &counts); err != nil {
+			return fmt.Errorf("failed to count detection rule hits: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return counts.Flagged, counts.Cleared, nil
+}
+
+// ruleTermPattern creates a case-insensitive Postgres regular expression matching the term
+// as a whole word or phrase, with the same word boundaries as types.DetectionRule.Matches.
+func ruleTermPattern(term string) string {
+	return `(^|[^[:alnum:]_])` + regexp.QuoteMeta(strings.ToLower(term)) + `($|[^[:alnum:]_])`
+}
@@ -54,6 +54,7 @@ func (r *SettingModel) GetUserSettings(ctx context.Context, userID snowflake.ID)
 				WindowStartTime: time.Unix(0, 0),
 			},
 			ReviewerStatsPeriod: enum.ReviewerStatsPeriodDaily,
+			FilterPresets:       []types.ReviewFilterPreset{},
 		}
 
 		err := r.db.NewSelect().Model(settings).
@@ -93,6 +94,8 @@ func (r *SettingModel) SaveUserSettings(ctx context.Context, settings *types.Use
 			Set("review_count = EXCLUDED.review_count").
 			Set("window_start_time = EXCLUDED.window_start_time").
 			Set("reviewer_stats_period = EXCLUDED.reviewer_stats_period").
+			Set("filter_presets = EXCLUDED.filter_presets").
+			Set("active_filter_preset = EXCLUDED.active_filter_preset").
 			Exec(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to save user settings: %w (userID=%d)", err, settings.UserID)
@@ -156,6 +159,7 @@ func (r *SettingModel) SaveBotSettings(ctx context.Context, settings *types.BotS
 			Set("welcome_message = EXCLUDED.welcome_message").
 			Set("announcement_type = EXCLUDED.announcement_type").
 			Set("announcement_message = EXCLUDED.announcement_message").
+			Set("review_policies = EXCLUDED.review_policies").
 			Exec(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to save bot settings: %w", err)
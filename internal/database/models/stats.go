@@ -25,29 +25,27 @@ func NewStats(db *bun.DB, logger *zap.Logger) *StatsModel {
 	}
 }
 
-// SaveHourlyStats saves the current statistics snapshot.
+// SaveHourlyStatsWithTx saves the current statistics snapshot using the provided transaction.
 //
-// Deprecated: Use Service().Stats().SaveHourlyStats() instead.
-func (r *StatsModel) SaveHourlyStats(ctx context.Context, stats *types.HourlyStats) error {
-	return dbretry.NoResult(ctx, func(ctx context.Context) error {
-		_, err := r.db.NewInsert().
-			Model(stats).
-			On("CONFLICT (timestamp) DO UPDATE").
-			Set("users_confirmed = EXCLUDED.users_confirmed").
-			Set("users_flagged = EXCLUDED.users_flagged").
-			Set("users_cleared = EXCLUDED.users_cleared").
-			Set("users_banned = EXCLUDED.users_banned").
-			Set("groups_confirmed = EXCLUDED.groups_confirmed").
-			Set("groups_flagged = EXCLUDED.groups_flagged").
-			Set("groups_mixed = EXCLUDED.groups_mixed").
-			Set("groups_locked = EXCLUDED.groups_locked").
-			Exec(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to save hourly stats: %w", err)
-		}
+// Deprecated: Use Service().Stats().SaveHourlyStatsWithTx() instead.
+func (r *StatsModel) SaveHourlyStatsWithTx(ctx context.Context, tx bun.Tx, stats *types.HourlyStats) error {
+	_, err := tx.NewInsert().
+		Model(stats).
+		On("CONFLICT (timestamp) DO UPDATE").
+		Set("users_confirmed = EXCLUDED.users_confirmed").
+		Set("users_flagged = EXCLUDED.users_flagged").
+		Set("users_cleared = EXCLUDED.users_cleared").
+		Set("users_banned = EXCLUDED.users_banned").
+		Set("groups_confirmed = EXCLUDED.groups_confirmed").
+		Set("groups_flagged = EXCLUDED.groups_flagged").
+		Set("groups_mixed = EXCLUDED.groups_mixed").
+		Set("groups_locked = EXCLUDED.groups_locked").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save hourly stats: %w", err)
+	}
 
-		return nil
-	})
+	return nil
 }
 
 // GetHourlyStats retrieves hourly statistics for the last 24 hours.
@@ -98,15 +98,17 @@ func (r *TrackingModel) AddUsersToGroupsTracking(ctx context.Context, groupToUse
 	})
 }
 
-// GetGroupTrackingsToCheck finds groups that haven't been checked recently
-// and have at least minFlaggedUsers. Returns groups for both hard threshold
-// (minFlaggedOverride) and percentage-based checks.
+// GetGroupTrackingsToCheck finds groups that haven't been checked recently.
+// Groups with at least minFlaggedUsers are returned first for both hard threshold
+// (minFlaggedOverride) and percentage-based checks, while groups with fewer flagged
+// users are rechecked hourly so their content can still be analyzed.
 func (r *TrackingModel) GetGroupTrackingsToCheck(
 	ctx context.Context, batchSize int, minFlaggedUsers int, minFlaggedOverride int,
 ) (map[int64][]int64, error) {
 	result := make(map[int64][]int64)
 
 	now := time.Now()
+	oneHourAgo := now.Add(-1 * time.Hour)
 	tenMinutesAgo := now.Add(-10 * time.Minute)
 	oneMinuteAgo := now.Add(-1 * time.Minute)
 
@@ -124,11 +126,13 @@ func (r *TrackingModel) GetGroupTrackingsToCheck(
 				Group("group_id")).
 			Join("JOIN user_counts ON group_member_tracking.id = user_counts.group_id").
 			Where("is_flagged = FALSE").
-			Where("user_count >= ?", minFlaggedUsers).
 			Where("(last_checked < ? AND user_count >= ?) OR "+
-				"(last_checked < ? AND user_count >= ?)",
+				"(last_checked < ? AND user_count >= ?) OR "+
+				"last_checked < ?",
 				tenMinutesAgo, minFlaggedOverride,
-				oneMinuteAgo, minFlaggedUsers).
+				oneMinuteAgo, minFlaggedUsers,
+				oneHourAgo).
+			OrderExpr("(user_count >= ?) DESC", minFlaggedUsers).
 			Order("last_checked ASC").
 			OrderExpr("user_count DESC").
 			Limit(batchSize)
@@ -573,13 +577,15 @@ func (r *TrackingModel) AddGamesToTracking(ctx context.Context, gameToUsers map[
 
 // GetGameTrackingsToCheck finds games that haven't been checked recently
 // and have at least minFlaggedUsers. Returns games for both hard threshold
-// (minFlaggedOverride) and percentage-based checks.
+// (minFlaggedOverride) and percentage-based checks. Flagged games are rescored
+// hourly whatever their user count so they are unflagged once they no longer qualify.
 func (r *TrackingModel) GetGameTrackingsToCheck(
 	ctx context.Context, batchSize int, minFlaggedUsers int, minFlaggedOverride int,
 ) (map[int64][]int64, error) {
 	result := make(map[int64][]int64)
 
 	now := time.Now()
+	oneHourAgo := now.Add(-1 * time.Hour)
 	tenMinutesAgo := now.Add(-10 * time.Minute)
 	oneMinuteAgo := now.Add(-1 * time.Minute)
 
@@ -595,15 +601,15 @@ func (r *TrackingModel) GetGameTrackingsToCheck(
 				Column("game_id").
 				ColumnExpr("COUNT(*) as user_count").
 				Group("game_id")).
-			Join("JOIN user_counts ON game_tracking.id = user_counts.game_id").
-			Where("is_flagged = FALSE").
-			Where("user_count >= ?", minFlaggedUsers).
-			Where("(last_checked < ? AND user_count >= ?) OR "+
-				"(last_checked < ? AND user_count >= ?)",
+			Join("LEFT JOIN user_counts ON game_tracking.id = user_counts.game_id").
+			Where("(is_flagged = FALSE AND ((last_checked < ? AND user_count >= ?) OR "+
+				"(last_checked < ? AND user_count >= ?))) OR "+
+				"(is_flagged = TRUE AND last_checked < ?)",
 				tenMinutesAgo, minFlaggedOverride,
-				oneMinuteAgo, minFlaggedUsers).
+				oneMinuteAgo, minFlaggedUsers,
+				oneHourAgo).
 			Order("last_checked ASC").
-			OrderExpr("user_count DESC").
+			OrderExpr("COALESCE(user_count, 0) DESC").
 			Limit(batchSize)
 
 		// Update the selected games and return their data
@@ -619,9 +625,11 @@ func (r *TrackingModel) GetGameTrackingsToCheck(
 
 		// Get flagged users for each game
 		if len(trackings) > 0 {
+			// Include games left without users so flagged ones can be unflagged
 			gameIDs := make([]int64, len(trackings))
 			for i, tracking := range trackings {
 				gameIDs[i] = tracking.ID
+				result[tracking.ID] = []int64{}
 			}
 
 			var trackingUsers []types.GameTrackingUser
@@ -709,6 +717,284 @@ func (r *TrackingModel) RemoveGamesFromTracking(ctx context.Context, gameIDs []i
 	})
 }
 
+// UpdateFlaggedGames updates the flagged state of the checked games in the tracking table,
+// flagging the suspected games and unflagging the rest.
+func (r *TrackingModel) UpdateFlaggedGames(ctx context.Context, gameIDs []int64, suspectedIDs []int64) error {
+	if len(gameIDs) == 0 {
+		return nil
+	}
+
+	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		query := r.db.NewUpdate().Model((*types.GameTracking)(nil)).
+			Where("id IN (?)", bun.In(gameIDs))
+
+		if len(suspectedIDs) > 0 {
+			query.Set("is_flagged = id IN (?)", bun.In(suspectedIDs))
+		} else {
+			query.Set("is_flagged = FALSE")
+		}
+
+		_, err := query.Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update flagged games: %w (gameCount=%d)", err, len(gameIDs))
+		}
+
+		return nil
+	})
+}
+
+// SaveGameReputations creates or updates the reputation entries for multiple games.
+func (r *TrackingModel) SaveGameReputations(ctx context.Context, reputations []*types.GameReputation) error {
+	if len(reputations) == 0 {
+		return nil
+	}
+
+	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		_, err := r.db.NewInsert().
+			Model(&reputations).
+			On("CONFLICT (id) DO UPDATE").
+			Set("root_place_id = EXCLUDED.root_place_id").
+			Set("name = EXCLUDED.name").
+			Set("place_visits = EXCLUDED.place_visits").
+			Set("flagged_users = EXCLUDED.flagged_users").
+			Set("density = EXCLUDED.density").
+			Set("score = EXCLUDED.score").
+			Set("is_suspected = EXCLUDED.is_suspected").
+			Set("last_calculated = EXCLUDED.last_calculated").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to save game reputations: %w (gameCount=%d)", err, len(reputations))
+		}
+
+		r.logger.Debug("Saved game reputations",
+			zap.Int("gameCount", len(reputations)))
+
+		return nil
+	})
+}
+
+// GetSuspectedGames returns the reputations of the given games that are marked as suspected.
+func (r *TrackingModel) GetSuspectedGames(
+	ctx context.Context, gameIDs []int64,
+) (map[int64]*types.GameReputation, error) {
+	if len(gameIDs) == 0 {
+		return make(map[int64]*types.GameReputation), nil
+	}
+
+	return dbretry.Operation(ctx, func(ctx context.Context) (map[int64]*types.GameReputation, error) {
+		var reputations []*types.GameReputation
+
+		err := r.db.NewSelect().
+			Model(&reputations).
+			Where("id IN (?)", bun.In(gameIDs)).
+			Where("is_suspected = TRUE").
+			Scan(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get suspected games: %w", err)
+		}
+
+		result := make(map[int64]*types.GameReputation, len(reputations))
+		for _, reputation := range reputations {
+			result[reputation.ID] = reputation
+		}
+
+		return result, nil
+	})
+}
+
+// GetSuspectedGamesByPlaceIDs returns the reputations of suspected games whose
+// root place is one of the given place IDs, keyed by root place ID.
+func (r *TrackingModel) GetSuspectedGamesByPlaceIDs(
+	ctx context.Context, placeIDs []int64,
+) (map[int64]*types.GameReputation, error) {
+	if len(placeIDs) == 0 {
+		return make(map[int64]*types.GameReputation), nil
+	}
+
+	return dbretry.Operation(ctx, func(ctx context.Context) (map[int64]*types.GameReputation, error) {
+		var reputations []*types.GameReputation
+
+		err := r.db.NewSelect().
+			Model(&reputations).
+			Where("root_place_id IN (?)", bun.In(placeIDs)).
+			Where("is_suspected = TRUE").
+			Scan(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get suspected games by place: %w", err)
+		}
+
+		result := make(map[int64]*types.GameReputation, len(reputations))
+		for _, reputation := range reputations {
+			result[reputation.RootPlaceID] = reputation
+		}
+
+		return result, nil
+	})
+}
+
+// GetSuspectedGameList retrieves a page of suspected games ordered by score,
+// along with the total number of suspected games.
+func (r *TrackingModel) GetSuspectedGameList(
+	ctx context.Context, offset, limit int,
+) ([]*types.GameReputation, int, error) {
+	var (
+		reputations []*types.GameReputation
+		total       int
+	)
+
+	err := dbretry.NoResult(ctx, func(ctx context.Context) error {
+		var err error
+
+		total, err = r.db.NewSelect().
+			Model(&reputations).
+			Where("is_suspected = TRUE").
+			Order("score DESC", "id ASC").
+			Offset(offset).
+			Limit(limit).
+			ScanAndCount(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get suspected game list: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return reputations, total, nil
+}
+
+// AddBadgesToTracking adds multiple users to multiple badges' tracking lists.
+func (r *TrackingModel) AddBadgesToTracking(
+	ctx context.Context, badgeToUsers map[int64][]int64, badgeInfos map[int64]*types.BadgeInfo,
+) error {
+	// Create tracking entries for bulk insert
+	trackings := make([]types.BadgeTracking, 0, len(badgeToUsers))
+	trackingUsers := make([]types.BadgeTrackingUser, 0)
+	now := time.Now()
+
+	for badgeID, userIDs := range badgeToUsers {
+		tracking := types.BadgeTracking{
+			ID:           badgeID,
+			LastAppended: now,
+		}
+		if info, ok := badgeInfos[badgeID]; ok {
+			tracking.AwarderID = info.AwarderID
+			tracking.AwardedCount = info.AwardedCount
+		}
+
+		trackings = append(trackings, tracking)
+
+		for _, userID := range userIDs {
+			trackingUsers = append(trackingUsers, types.BadgeTrackingUser{
+				BadgeID: badgeID,
+				UserID:  userID,
+			})
+		}
+	}
+
+	return dbretry.Transaction(ctx, r.db, func(ctx context.Context, tx bun.Tx) error {
+		// Lock the badges in a consistent order to prevent deadlocks
+		badgeIDs := make([]int64, 0, len(badgeToUsers))
+		for badgeID := range badgeToUsers {
+			badgeIDs = append(badgeIDs, badgeID)
+		}
+
+		slices.Sort(badgeIDs)
+
+		// Lock the rows we're going to update
+		var existing []types.BadgeTracking
+
+		err := tx.NewSelect().
+			Model(&existing).
+			Where("id IN (?)", bun.In(badgeIDs)).
+			For("UPDATE").
+			Order("id").
+			Scan(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Perform bulk insert with upsert
+		_, err = tx.NewInsert().
+			Model(&trackings).
+			On("CONFLICT (id) DO UPDATE").
+			Set("awarder_id = EXCLUDED.awarder_id").
+			Set("awarded_count = EXCLUDED.awarded_count").
+			Set("last_appended = EXCLUDED.last_appended").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add tracking entries: %w", err)
+		}
+
+		_, err = tx.NewInsert().
+			Model(&trackingUsers).
+			On("CONFLICT DO NOTHING").
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to add tracking user entries: %w", err)
+		}
+
+		r.logger.Debug("Successfully processed badge tracking updates",
+			zap.Int("badgeCount", len(badgeToUsers)))
+
+		return nil
+	})
+}
+
+// GetBadgeFlaggedHolderCounts returns the number of tracked flagged users holding each badge.
+func (r *TrackingModel) GetBadgeFlaggedHolderCounts(ctx context.Context, badgeIDs []int64) (map[int64]int, error) {
+	if len(badgeIDs) == 0 {
+		return make(map[int64]int), nil
+	}
+
+	return dbretry.Operation(ctx, func(ctx context.Context) (map[int64]int, error) {
+		var counts []struct {
+			BadgeID   int64 `bun:"badge_id"`
+			UserCount int   `bun:"user_count"`
+		}
+
+		err := r.db.NewSelect().
+			Model((*types.BadgeTrackingUser)(nil)).
+			Column("badge_id").
+			ColumnExpr("COUNT(*) AS user_count").
+			Where("badge_id IN (?)", bun.In(badgeIDs)).
+			Group("badge_id").
+			Scan(ctx, &counts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get badge flagged holder counts: %w", err)
+		}
+
+		result := make(map[int64]int, len(counts))
+		for _, count := range counts {
+			result[count.BadgeID] = count.UserCount
+		}
+
+		return result, nil
+	})
+}
+
+// RemoveUsersFromBadgeTrackingWithTx removes multiple users from badge tracking using the provided transaction.
+func (r *TrackingModel) RemoveUsersFromBadgeTrackingWithTx(ctx context.Context, tx bun.IDB, userIDs []int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	_, err := tx.NewDelete().
+		Model((*types.BadgeTrackingUser)(nil)).
+		Where("user_id IN (?)", bun.In(userIDs)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to remove users from badge tracking: %w (userCount=%d)", err, len(userIDs))
+	}
+
+	r.logger.Debug("Removed users from badge tracking",
+		zap.Int("userCount", len(userIDs)))
+
+	return nil
+}
+
 // AddGroupToExclusions adds a group to the exclusion list to prevent future tracking.
 func (r *TrackingModel) AddGroupToExclusions(ctx context.Context, groupID int64) error {
 	return dbretry.NoResult(ctx, func(ctx context.Context) error {
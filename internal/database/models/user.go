@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -39,6 +40,12 @@ func (r *UserModel) SaveUsers(ctx context.Context, tx bun.Tx, users []*types.Rev
 		return nil
 	}
 
+	// Capture the state of users whose status is about to change
+	before, err := r.getChangingStatusSnapshotsWithTx(ctx, tx, users)
+	if err != nil {
+		return err
+	}
+
 	// Extract base users
 	baseUsers := make([]*types.User, len(users))
 	for i, user := range users {
@@ -46,7 +53,7 @@ func (r *UserModel) SaveUsers(ctx context.Context, tx bun.Tx, users []*types.Rev
 	}
 
 	// Update users table with core data
-	_, err := tx.NewInsert().
+	_, err = tx.NewInsert().
 		Model(&baseUsers).
 		On("CONFLICT (id) DO UPDATE").
 		Set("uuid = EXCLUDED.uuid").
@@ -114,7 +121,13 @@ func (r *UserModel) SaveUsers(ctx context.Context, tx bun.Tx, users []*types.Rev
 		}
 	}
 
-	return nil
+	// Record the status changes without a reviewer as they were not reviewer decisions
+	changed := make([]*types.ReviewUser, 0, len(before))
+	for userID := range before {
+		changed = append(changed, &types.ReviewUser{User: &types.User{ID: userID}})
+	}
+
+	return r.recordStatusChangesWithTx(ctx, tx, before, changed)
 }
 
 // ConfirmUsers moves multiple users to confirmed status and creates verification records.
@@ -124,88 +137,100 @@ func (r *UserModel) ConfirmUsers(ctx context.Context, users []*types.ReviewUser)
 	}
 
 	return dbretry.Transaction(ctx, r.db, func(ctx context.Context, tx bun.Tx) error {
-		// Extract user IDs
-		userIDs := make([]int64, len(users))
-		for i, user := range users {
-			userIDs[i] = user.ID
-		}
+		return r.ConfirmUsersWithTx(ctx, tx, users)
+	})
+}
 
-		// Batch delete existing clearance records
-		_, err := tx.NewDelete().
-			Model((*types.UserClearance)(nil)).
-			Where("user_id IN (?)", bun.In(userIDs)).
+// ConfirmUsersWithTx moves multiple users to confirmed status and creates verification records
+// using the provided transaction.
+func (r *UserModel) ConfirmUsersWithTx(ctx context.Context, tx bun.Tx, users []*types.ReviewUser) error {
+	// Extract user IDs
+	userIDs := make([]int64, len(users))
+	for i, user := range users {
+		userIDs[i] = user.ID
+	}
+
+	// Capture the state before the decision for the audit trail
+	before, err := r.getStatusSnapshotsWithTx(ctx, tx, userIDs)
+	if err != nil {
+		return err
+	}
+
+	// Batch delete existing clearance records
+	_, err = tx.NewDelete().
+		Model((*types.UserClearance)(nil)).
+		Where("user_id IN (?)", bun.In(userIDs)).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete existing clearance records: %w", err)
+	}
+
+	// Update user statuses and categories
+	for _, user := range users {
+		_, err = tx.NewUpdate().
+			Model((*types.User)(nil)).
+			Set("status = ?", enum.UserTypeConfirmed).
+			Set("category = ?", user.Category).
+			Where("id = ?", user.ID).
 			Exec(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to delete existing clearance records: %w", err)
+			return fmt.Errorf("failed to update user status and category: %w", err)
 		}
+	}
 
-		// Update user statuses and categories
-		for _, user := range users {
-			_, err = tx.NewUpdate().
-				Model((*types.User)(nil)).
-				Set("status = ?", enum.UserTypeConfirmed).
-				Set("category = ?", user.Category).
-				Where("id = ?", user.ID).
-				Exec(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to update user status and category: %w", err)
-			}
+	// Prepare verification records
+	verifications := make([]*types.UserVerification, len(users))
+	for i, user := range users {
+		verifications[i] = &types.UserVerification{
+			UserID:     user.ID,
+			ReviewerID: user.ReviewerID,
+			VerifiedAt: time.Now(),
 		}
+	}
 
-		// Prepare verification records
-		verifications := make([]*types.UserVerification, len(users))
-		for i, user := range users {
-			verifications[i] = &types.UserVerification{
-				UserID:     user.ID,
-				ReviewerID: user.ReviewerID,
-				VerifiedAt: time.Now(),
+	// Batch insert verification records
+	_, err = tx.NewInsert().
+		Model(&verifications).
+		On("CONFLICT (user_id) DO UPDATE").
+		Set("reviewer_id = EXCLUDED.reviewer_id").
+		Set("verified_at = EXCLUDED.verified_at").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create verification records: %w", err)
+	}
+
+	// Batch update user reasons
+	var allReasons []*types.UserReason
+
+	for _, user := range users {
+		if user.Reasons != nil {
+			for reasonType, reason := range user.Reasons {
+				allReasons = append(allReasons, &types.UserReason{
+					UserID:     user.ID,
+					ReasonType: reasonType,
+					Message:    reason.Message,
+					Confidence: reason.Confidence,
+					Evidence:   reason.Evidence,
+					CreatedAt:  time.Now(),
+				})
 			}
 		}
+	}
 
-		// Batch insert verification records
+	if len(allReasons) > 0 {
 		_, err = tx.NewInsert().
-			Model(&verifications).
-			On("CONFLICT (user_id) DO UPDATE").
-			Set("reviewer_id = EXCLUDED.reviewer_id").
-			Set("verified_at = EXCLUDED.verified_at").
+			Model(&allReasons).
+			On("CONFLICT (user_id, reason_type) DO UPDATE").
+			Set("message = EXCLUDED.message").
+			Set("confidence = EXCLUDED.confidence").
+			Set("evidence = EXCLUDED.evidence").
 			Exec(ctx)
 		if err != nil {
-			return fmt.Errorf("failed to create verification records: %w", err)
-		}
-
-		// Batch update user reasons
-		var allReasons []*types.UserReason
-
-		for _, user := range users {
-			if user.Reasons != nil {
-				for reasonType, reason := range user.Reasons {
-					allReasons = append(allReasons, &types.UserReason{
-						UserID:     user.ID,
-						ReasonType: reasonType,
-						Message:    reason.Message,
-						Confidence: reason.Confidence,
-						Evidence:   reason.Evidence,
-						CreatedAt:  time.Now(),
-					})
-				}
-			}
-		}
-
-		if len(allReasons) > 0 {
-			_, err = tx.NewInsert().
-				Model(&allReasons).
-				On("CONFLICT (user_id, reason_type) DO UPDATE").
-				Set("message = EXCLUDED.message").
-				Set("confidence = EXCLUDED.confidence").
-				Set("evidence = EXCLUDED.evidence").
-				Exec(ctx)
-			if err != nil {
-				return fmt.Errorf("failed to update user reasons: %w", err)
-			}
+			return fmt.Errorf("failed to update user reasons: %w", err)
 		}
+	}
 
-		return nil
-	})
+	return r.recordStatusChangesWithTx(ctx, tx, before, users)
 }
 
 // ClearUser moves a user to cleared status and creates a clearance record.
@@ -221,8 +246,14 @@ func (r *UserModel) ClearUser(ctx context.Context, user *types.ReviewUser) error
 //
 // Deprecated: Use Service().User().ClearUserWithTx() instead.
 func (r *UserModel) ClearUserWithTx(ctx context.Context, tx bun.Tx, user *types.ReviewUser) error {
+	// Capture the state before the decision for the audit trail
+	before, err := r.getStatusSnapshotsWithTx(ctx, tx, []int64{user.ID})
+	if err != nil {
+		return err
+	}
+
 	// Delete any existing verification record
-	_, err := tx.NewDelete().
+	_, err = tx.NewDelete().
 		Model((*types.UserVerification)(nil)).
 		Where("user_id = ?", user.ID).
 		Exec(ctx)
@@ -285,7 +316,7 @@ func (r *UserModel) ClearUserWithTx(ctx context.Context, tx bun.Tx, user *types.
 		}
 	}
 
-	return nil
+	return r.recordStatusChangesWithTx(ctx, tx, before, []*types.ReviewUser{user})
 }
 
 // UpdateUsersToPastOffender updates users to past offender status while preserving their reasons and confidence.
@@ -294,8 +325,13 @@ func (r *UserModel) UpdateUsersToPastOffender(ctx context.Context, tx bun.Tx, us
 		return nil
 	}
 
+	before, err := r.getStatusSnapshotsWithTx(ctx, tx, userIDs)
+	if err != nil {
+		return err
+	}
+
 	// Update user status to past offender
-	_, err := tx.NewUpdate().
+	_, err = tx.NewUpdate().
 		Model((*types.User)(nil)).
 		Set("status = ?", enum.UserTypePastOffender).
 		Set("last_updated = ?", time.Now()).
@@ -308,7 +344,12 @@ func (r *UserModel) UpdateUsersToPastOffender(ctx context.Context, tx bun.Tx, us
 	r.logger.Debug("Updated users to past offender status",
 		zap.Int("count", len(userIDs)))
 
-	return nil
+	users := make([]*types.ReviewUser, 0, len(userIDs))
+	for _, userID := range userIDs {
+		users = append(users, &types.ReviewUser{User: &types.User{ID: userID}})
+	}
+
+	return r.recordStatusChangesWithTx(ctx, tx, before, users)
 }
 
 // GetConfirmedUsersCount returns the total number of users in confirmed_users.
@@ -341,6 +382,55 @@ func (r *UserModel) GetFlaggedUsersCount(ctx context.Context) (int, error) {
 	return count, nil
 }
 
+// GetFlaggedCountsByCategory returns the number of flagged users in each category.
+// Users without a category are counted as predatory, matching their stored value.
+func (r *UserModel) GetFlaggedCountsByCategory(ctx context.Context) (map[enum.UserCategoryType]int, error) {
+	return dbretry.Operation(ctx, func(ctx context.Context) (map[enum.UserCategoryType]int, error) {
+		var categoryCounts []struct {
+			Category enum.UserCategoryType
+			Count    int
+		}
+
+		err := r.db.NewSelect().
+			Model((*types.User)(nil)).
+			ColumnExpr("COALESCE(category, 0) AS category").
+			ColumnExpr("COUNT(*) AS count").
+			Where("status = ?", enum.UserTypeFlagged).
+			GroupExpr("COALESCE(category, 0)").
+			Scan(ctx, &categoryCounts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get flagged counts by category: %w", err)
+		}
+
+		counts := make(map[enum.UserCategoryType]int, len(categoryCounts))
+		for _, cc := range categoryCounts {
+			counts[cc.Category] = cc.Count
+		}
+
+		return counts, nil
+	})
+}
+
+// SearchUsersByName retrieves tracked users whose username starts with the given prefix.
+func (r *UserModel) SearchUsersByName(ctx context.Context, prefix string, limit int) ([]*types.User, error) {
+	return dbretry.Operation(ctx, func(ctx context.Context) ([]*types.User, error) {
+		var users []*types.User
+
+		err := r.db.NewSelect().
+			Model(&users).
+			Column("id", "name", "status").
+			Where("lower(name) LIKE ?", namePrefixPattern(prefix)).
+			OrderExpr("lower(name)").
+			Limit(limit).
+			Scan(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to search users by name: %w", err)
+		}
+
+		return users, nil
+	})
+}
+
 // GetUserByID retrieves a user by either their numeric ID or UUID.
 //
 // Deprecated: Use Service().User().GetUserByID() instead.
@@ -1361,7 +1451,7 @@ func (r *UserModel) GetUserToScan(ctx context.Context) (*types.User, error) {
 //
 // Deprecated: Use Service().User().GetUserToReview() instead.
 func (r *UserModel) GetNextToReview(
-	ctx context.Context, targetStatus enum.UserType, sortBy enum.ReviewSortBy, recentIDs []int64,
+	ctx context.Context, targetStatus enum.UserType, sortBy enum.ReviewSortBy, filter *types.ReviewQueueFilter,
 ) (*types.ReviewUser, error) {
 	var (
 		user   types.User
@@ -1374,11 +1464,64 @@ func (r *UserModel) GetNextToReview(
 			Model(&user).
 			Where("status = ?", targetStatus)
 
-		// Exclude recently reviewed IDs if any exist
-		if len(recentIDs) > 0 {
-			query.Where("id NOT IN (?)", bun.In(recentIDs))
+		// Exclude recently reviewed or claimed IDs if any exist
+		if len(filter.ExcludeIDs) > 0 {
+			query.Where("id NOT IN (?)", bun.In(filter.ExcludeIDs))
+		}
+
+		// Limit to specific IDs such as gold items if requested
+		if len(filter.OnlyIDs) > 0 {
+			query.Where("id IN (?)", bun.In(filter.OnlyIDs))
+		}
+
+		// Apply category and cluster assignments
+		if len(filter.Categories) > 0 {
+			query.Where("COALESCE(category, 0) IN (?)", bun.In(filter.Categories))
+		}
+
+		if len(filter.GroupIDs) > 0 {
+			query.Where("EXISTS (SELECT 1 FROM user_groups ug "+
+				"WHERE ug.user_id = \"user\".id AND ug.group_id IN (?))", bun.In(filter.GroupIDs))
+		}
+
+		// Apply the reviewer's filter preset
+		if filter.MinConfidence > 0 {
+			query.Where("confidence >= ?", filter.MinConfidence)
+		}
+
+		if filter.MaxConfidence > 0 {
+			query.Where("confidence <= ?", filter.MaxConfidence)
+		}
+
+		if len(filter.UserReasonTypes) > 0 {
+			query.Where("EXISTS (SELECT 1 FROM user_reasons ur "+
+				"WHERE ur.user_id = \"user\".id AND ur.reason_type IN (?))", bun.In(filter.UserReasonTypes))
+		}
+
+		if !filter.CreatedAfter.IsZero() {
+			query.Where("created_at >= ?", filter.CreatedAfter)
+		}
+
+		if !filter.CreatedBefore.IsZero() {
+			query.Where("created_at <= ?", filter.CreatedBefore)
 		}
 
+		if filter.HasSocials != nil {
+			query.Where("has_socials = ?", *filter.HasSocials)
+		}
+
+		if filter.EngineVersion != "" {
+			query.Where("engine_version = ?", filter.EngineVersion)
+		}
+
+		if filter.TouchedBy != 0 {
+			query.Where("EXISTS (SELECT 1 FROM activity_logs al "+
+				"WHERE al.user_id = \"user\".id AND al.reviewer_id = ?)", filter.TouchedBy)
+		}
+
+		// Apply open consensus decisions
+		applyDecisionFilter(query, filter, types.DecisionTargetUser, `"user".id`)
+
 		// Apply sort order
 		switch sortBy {
 		case enum.ReviewSortByConfidence:
@@ -2637,3 +2780,426 @@ func (r *UserModel) GetGlobalTargetCandidates(
 
 	return candidates, nil
 }
+
+// GetStatusChange retrieves a status change by its ID.
+// Returns types.ErrStatusChangeNotFound if the change does not exist.
+func (r *UserModel) GetStatusChange(ctx context.Context, changeID int64) (*types.UserStatusChange, error) {
+	change, err := dbretry.Operation(ctx, func(ctx context.Context) (*types.UserStatusChange, error) {
+		var change types.UserStatusChange
+
+		err := r.db.NewSelect().
+			Model(&change).
+			Where("id = ?", changeID).
+			Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &change, nil
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, types.ErrStatusChangeNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get status change: %w", err)
+	}
+
+	return change, nil
+}
+
+// GetLatestStatusChange retrieves the most recent status change of a user.
+// Returns types.ErrStatusChangeNotFound if the user has no recorded changes.
+func (r *UserModel) GetLatestStatusChange(ctx context.Context, userID int64) (*types.UserStatusChange, error) {
+	change, err := dbretry.Operation(ctx, func(ctx context.Context) (*types.UserStatusChange, error) {
+		var change types.UserStatusChange
+
+		err := r.db.NewSelect().
+			Model(&change).
+			Where("user_id = ?", userID).
+			Order("id DESC").
+			Limit(1).
+			Scan(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return &change, nil
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, types.ErrStatusChangeNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get latest status change: %w", err)
+	}
+
+	return change, nil
+}
+
+// RevertStatusChangeWithTx restores a user to the state before a status change using the
+// provided transaction. Only the latest change of a user that was not already reverted can
+// be reverted. The revert is recorded as a new status change, which is returned.
+func (r *UserModel) RevertStatusChangeWithTx(
+	ctx context.Context, tx bun.Tx, changeID int64, reviewerID uint64,
+) (*types.UserStatusChange, error) {
+	// Lock the change so concurrent reverts cannot both succeed
+	var change types.UserStatusChange
+
+	err := tx.NewSelect().
+		Model(&change).
+		Where("id = ?", changeID).
+		For("UPDATE").
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, types.ErrStatusChangeNotFound
+		}
+
+		return nil, fmt.Errorf("failed to get status change: %w", err)
+	}
+
+	if change.IsReverted() {
+		return nil, types.ErrStatusChangeReverted
+	}
+
+	// Ensure no newer decision was made on the user
+	newer, err := tx.NewSelect().
+		Model((*types.UserStatusChange)(nil)).
+		Where("user_id = ?", change.UserID).
+		Where("id > ?", change.ID).
+		Exists(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check for newer status changes: %w", err)
+	}
+
+	if newer {
+		return nil, types.ErrStatusChangeSuperseded
+	}
+
+	before, err := r.getStatusSnapshotsWithTx(ctx, tx, []int64{change.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	current, ok := before[change.UserID]
+	if !ok {
+		return nil, types.ErrUserNotFound
+	}
+
+	// Refuse to overwrite a state that changed since the decision
+	if !current.Matches(change.After) {
+		return nil, types.ErrStatusChangeStale
+	}
+
+	// Restore status, confidence and category
+	target := change.Before
+
+	_, err = tx.NewUpdate().
+		Model((*types.User)(nil)).
+		Set("status = ?", target.Status).
+		Set("confidence = ?", target.Confidence).
+		Set("category = ?", target.Category).
+		Where("id = ?", change.UserID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore user status: %w", err)
+	}
+
+	// Restore reasons
+	_, err = tx.NewDelete().
+		Model((*types.UserReason)(nil)).
+		Where("user_id = ?", change.UserID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete user reasons: %w", err)
+	}
+
+	if len(target.Reasons) > 0 {
+		reasons := make([]*types.UserReason, 0, len(target.Reasons))
+		for reasonType, reason := range target.Reasons {
+			reasons = append(reasons, &types.UserReason{
+				UserID:     change.UserID,
+				ReasonType: reasonType,
+				Message:    reason.Message,
+				Confidence: reason.Confidence,
+				Evidence:   reason.Evidence,
+				CreatedAt:  time.Now(),
+			})
+		}
+
+		_, err = tx.NewInsert().
+			Model(&reasons).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore user reasons: %w", err)
+		}
+	}
+
+	// Restore verification and clearance records
+	_, err = tx.NewDelete().
+		Model((*types.UserVerification)(nil)).
+		Where("user_id = ?", change.UserID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete verification record: %w", err)
+	}
+
+	_, err = tx.NewDelete().
+		Model((*types.UserClearance)(nil)).
+		Where("user_id = ?", change.UserID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete clearance record: %w", err)
+	}
+
+	if !target.VerifiedAt.IsZero() {
+		_, err = tx.NewInsert().
+			Model(&types.UserVerification{
+				UserID:     change.UserID,
+				ReviewerID: target.ReviewerID,
+				VerifiedAt: target.VerifiedAt,
+			}).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore verification record: %w", err)
+		}
+	}
+
+	if !target.ClearedAt.IsZero() {
+		_, err = tx.NewInsert().
+			Model(&types.UserClearance{
+				UserID:     change.UserID,
+				ReviewerID: target.ReviewerID,
+				ClearedAt:  target.ClearedAt,
+			}).
+			Exec(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore clearance record: %w", err)
+		}
+	}
+
+	// Record the revert as a new change and mark the original as reverted
+	after, err := r.getStatusSnapshotsWithTx(ctx, tx, []int64{change.UserID})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	revert := &types.UserStatusChange{
+		UserID:     change.UserID,
+		ReviewerID: reviewerID,
+		Before:     before[change.UserID],
+		After:      after[change.UserID],
+		RevertOf:   change.ID,
+		CreatedAt:  now,
+	}
+
+	_, err = tx.NewInsert().
+		Model(revert).
+		Returning("id").
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record revert: %w", err)
+	}
+
+	_, err = tx.NewUpdate().
+		Model((*types.UserStatusChange)(nil)).
+		Set("reverted_by = ?", reviewerID).
+		Set("reverted_at = ?", now).
+		Where("id = ?", change.ID).
+		Exec(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to mark status change as reverted: %w", err)
+	}
+
+	r.logger.Debug("Reverted status change",
+		zap.Int64("changeID", change.ID),
+		zap.Int64("userID", change.UserID),
+		zap.String("restoredStatus", target.Status.String()),
+		zap.Uint64("reviewerID", reviewerID))
+
+	return revert, nil
+}
+
+// getStatusSnapshotsWithTx loads the review state of the given users using the provided
+// transaction. Users that do not exist are left out of the result.
+func (r *UserModel) getStatusSnapshotsWithTx(
+	ctx context.Context, tx bun.IDB, userIDs []int64,
+) (map[int64]*types.UserStatusSnapshot, error) {
+	var users []*types.User
+
+	err := tx.NewSelect().
+		Model(&users).
+		Column("id", "status", "confidence", "category").
+		Where("id IN (?)", bun.In(userIDs)).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user states: %w", err)
+	}
+
+	snapshots := make(map[int64]*types.UserStatusSnapshot, len(users))
+	for _, user := range users {
+		snapshots[user.ID] = &types.UserStatusSnapshot{
+			Status:     user.Status,
+			Confidence: user.Confidence,
+			Category:   user.Category,
+			Reasons:    make(types.Reasons[enum.UserReasonType]),
+		}
+	}
+
+	var reasons []*types.UserReason
+
+	err = tx.NewSelect().
+		Model(&reasons).
+		Where("user_id IN (?)", bun.In(userIDs)).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user reasons: %w", err)
+	}
+
+	for _, reason := range reasons {
+		if snapshot, ok := snapshots[reason.UserID]; ok {
+			snapshot.Reasons[reason.ReasonType] = &types.Reason{
+				Message:    reason.Message,
+				Confidence: reason.Confidence,
+				Evidence:   reason.Evidence,
+			}
+		}
+	}
+
+	var verifications []*types.UserVerification
+
+	err = tx.NewSelect().
+		Model(&verifications).
+		Where("user_id IN (?)", bun.In(userIDs)).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get verification records: %w", err)
+	}
+
+	for _, verification := range verifications {
+		if snapshot, ok := snapshots[verification.UserID]; ok {
+			snapshot.ReviewerID = verification.ReviewerID
+			snapshot.VerifiedAt = verification.VerifiedAt
+		}
+	}
+
+	var clearances []*types.UserClearance
+
+	err = tx.NewSelect().
+		Model(&clearances).
+		Where("user_id IN (?)", bun.In(userIDs)).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get clearance records: %w", err)
+	}
+
+	for _, clearance := range clearances {
+		if snapshot, ok := snapshots[clearance.UserID]; ok {
+			snapshot.ReviewerID = clearance.ReviewerID
+			snapshot.ClearedAt = clearance.ClearedAt
+		}
+	}
+
+	return snapshots, nil
+}
+
+// getChangingStatusSnapshotsWithTx loads the review state of the given users whose stored
+// status differs from their new status. New users and users keeping their status are left out.
+func (r *UserModel) getChangingStatusSnapshotsWithTx(
+	ctx context.Context, tx bun.IDB, users []*types.ReviewUser,
+) (map[int64]*types.UserStatusSnapshot, error) {
+	userIDs := make([]int64, len(users))
+	for i, user := range users {
+		userIDs[i] = user.ID
+	}
+
+	var existing []*types.User
+
+	err := tx.NewSelect().
+		Model(&existing).
+		Column("id", "status").
+		Where("id IN (?)", bun.In(userIDs)).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user statuses: %w", err)
+	}
+
+	statuses := make(map[int64]enum.UserType, len(existing))
+	for _, user := range existing {
+		statuses[user.ID] = user.Status
+	}
+
+	changedIDs := make([]int64, 0, len(existing))
+
+	for _, user := range users {
+		if status, ok := statuses[user.ID]; ok && status != user.Status {
+			changedIDs = append(changedIDs, user.ID)
+		}
+	}
+
+	if len(changedIDs) == 0 {
+		return make(map[int64]*types.UserStatusSnapshot), nil
+	}
+
+	return r.getStatusSnapshotsWithTx(ctx, tx, changedIDs)
+}
+
+// recordStatusChangesWithTx records the status changes made on the given users, comparing their
+// current state to the state captured before the change. Users without a captured state are skipped.
+func (r *UserModel) recordStatusChangesWithTx(
+	ctx context.Context, tx bun.IDB, before map[int64]*types.UserStatusSnapshot, users []*types.ReviewUser,
+) error {
+	if len(before) == 0 {
+		return nil
+	}
+
+	userIDs := make([]int64, 0, len(before))
+	for userID := range before {
+		userIDs = append(userIDs, userID)
+	}
+
+	after, err := r.getStatusSnapshotsWithTx(ctx, tx, userIDs)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	changes := make([]*types.UserStatusChange, 0, len(users))
+
+	for _, user := range users {
+		beforeSnapshot, ok := before[user.ID]
+		if !ok {
+			continue
+		}
+
+		changes = append(changes, &types.UserStatusChange{
+			UserID:     user.ID,
+			ReviewerID: user.ReviewerID,
+			Before:     beforeSnapshot,
+			After:      after[user.ID],
+			CreatedAt:  now,
+		})
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	_, err = tx.NewInsert().
+		Model(&changes).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record status changes: %w", err)
+	}
+
+	return nil
+}
+
+// namePrefixPattern creates a case-insensitive LIKE pattern matching names that
+// start with the given prefix, escaping any wildcard characters in the prefix.
+func namePrefixPattern(prefix string) string {
+	escaper := strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+	return escaper.Replace(strings.ToLower(prefix)) + "%"
+}
@@ -0,0 +1,101 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robalyx/rotector/internal/database/dbretry"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+// WorkerModel handles database operations for worker history.
+type WorkerModel struct {
+	db     *bun.DB
+	logger *zap.Logger
+}
+
+// NewWorker creates a WorkerModel for recording worker history.
+func NewWorker(db *bun.DB, logger *zap.Logger) *WorkerModel {
+	return &WorkerModel{
+		db:     db,
+		logger: logger.Named("db_worker"),
+	}
+}
+
+// LogEvents stores a batch of worker events.
+func (r *WorkerModel) LogEvents(ctx context.Context, events []*types.WorkerEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		_, err := r.db.NewInsert().Model(&events).Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to log worker events: %w", err)
+		}
+
+		return nil
+	})
+}
+
+// ClaimFenceWithTx records the fencing token of the worker type's leader using the provided
+// transaction. The fence row stays locked until the transaction ends, so a concurrent write of
+// another leader waits for it. Returns types.ErrStaleFencingToken if a newer token already wrote.
+func (r *WorkerModel) ClaimFenceWithTx(ctx context.Context, tx bun.Tx, workerType string, token int64) error {
+	result, err := tx.NewRaw(`
+		INSERT INTO worker_fences (worker_type, token, updated_at)
+		VALUES (?, ?, NOW())
+		ON CONFLICT (worker_type) DO UPDATE
+		SET token = EXCLUDED.token, updated_at = EXCLUDED.updated_at
+		WHERE worker_fences.token <= EXCLUDED.token
+	`, workerType, token).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to claim worker fence: %w", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to check worker fence: %w", err)
+	}
+
+	if affected == 0 {
+		return fmt.Errorf("%w for %s: token %d", types.ErrStaleFencingToken, workerType, token)
+	}
+
+	return nil
+}
+
+// GetHistory summarizes worker events per worker slot since the given time.
+// Starts within recentWindow are counted separately to detect crash loops.
+func (r *WorkerModel) GetHistory(
+	ctx context.Context, since time.Time, recentWindow time.Duration,
+) ([]*types.WorkerHistory, error) {
+	return dbretry.Operation(ctx, func(ctx context.Context) ([]*types.WorkerHistory, error) {
+		var history []*types.WorkerHistory
+
+		recentSince := time.Now().Add(-recentWindow)
+
+		err := r.db.NewSelect().
+			TableExpr("worker_events").
+			ColumnExpr("worker_slot, worker_type").
+			ColumnExpr("COUNT(*) FILTER (WHERE event_type = ?) AS starts", types.WorkerEventStarted).
+			ColumnExpr("COUNT(*) FILTER (WHERE event_type = ? AND timestamp > ?) AS recent_starts",
+				types.WorkerEventStarted, recentSince).
+			ColumnExpr("COUNT(*) FILTER (WHERE event_type = ?) AS unhealthy_flips", types.WorkerEventUnhealthy).
+			ColumnExpr("COUNT(*) FILTER (WHERE event_type = ?) AS drains", types.WorkerEventDrained).
+			ColumnExpr("COALESCE(SUM(processed), 0) AS processed").
+			ColumnExpr("MIN(timestamp) AS first_seen, MAX(timestamp) AS last_seen").
+			Where("timestamp > ?", since).
+			GroupExpr("worker_slot, worker_type").
+			OrderExpr("worker_type, worker_slot").
+			Scan(ctx, &history)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get worker history: %w", err)
+		}
+
+		return history, nil
+	})
+}
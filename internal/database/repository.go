@@ -22,6 +22,11 @@ type Repository struct {
 	message  *models.MessageModel
 	comment  *models.CommentModel
 	cache    *models.CacheModel
+	job      *models.JobModel
+	worker   *models.WorkerModel
+	decision *models.DecisionModel
+	gold     *models.GoldModel
+	rule     *models.RuleModel
 }
 
 // NewRepository creates a new repository instance with all models.
@@ -41,6 +46,11 @@ func NewRepository(db *bun.DB, logger *zap.Logger) *Repository {
 		message:  models.NewMessage(db, logger),
 		comment:  models.NewComment(db, logger),
 		cache:    models.NewCache(db, logger),
+		job:      models.NewJob(db, logger),
+		worker:   models.NewWorker(db, logger),
+		decision: models.NewDecision(db, logger),
+		gold:     models.NewGold(db, logger),
+		rule:     models.NewRule(db, logger),
 	}
 }
 
@@ -113,3 +123,28 @@ func (r *Repository) Comment() *models.CommentModel {
 func (r *Repository) Cache() *models.CacheModel {
 	return r.cache
 }
+
+// Job returns the job model repository.
+func (r *Repository) Job() *models.JobModel {
+	return r.job
+}
+
+// Worker returns the worker history model repository.
+func (r *Repository) Worker() *models.WorkerModel {
+	return r.worker
+}
+
+// Decision returns the pending decision model repository.
+func (r *Repository) Decision() *models.DecisionModel {
+	return r.decision
+}
+
+// Gold returns the gold item model repository.
+func (r *Repository) Gold() *models.GoldModel {
+	return r.gold
+}
+
+// Rule returns the detection rule model repository.
+func (r *Repository) Rule() *models.RuleModel {
+	return r.rule
+}
@@ -8,14 +8,17 @@ import (
 
 // Service provides access to all business logic services.
 type Service struct {
-	user     *service.UserService
-	group    *service.GroupService
-	reviewer *service.ReviewerService
-	stats    *service.StatsService
-	view     *service.ViewService
-	sync     *service.SyncService
-	comment  *service.CommentService
-	cache    *service.CacheService
+	user      *service.UserService
+	group     *service.GroupService
+	reviewer  *service.ReviewerService
+	stats     *service.StatsService
+	view      *service.ViewService
+	sync      *service.SyncService
+	comment   *service.CommentService
+	cache     *service.CacheService
+	consensus *service.ConsensusService
+	gold      *service.GoldService
+	rule      *service.RuleService
 }
 
 // NewService creates a new service instance with all services.
@@ -30,18 +33,28 @@ func NewService(db *bun.DB, repository *Repository, logger *zap.Logger) *Service
 	commentModel := repository.Comment()
 	trackingModel := repository.Tracking()
 	cacheModel := repository.Cache()
+	decisionModel := repository.Decision()
+	goldModel := repository.Gold()
+	ruleModel := repository.Rule()
 
 	viewService := service.NewView(viewModel, logger)
+	goldService := service.NewGold(goldModel, logger)
+	userService := service.NewUser(
+		db, userModel, activityModel, trackingModel, cacheModel, reviewerModel, goldService, logger,
+	)
 
 	return &Service{
-		user:     service.NewUser(db, userModel, activityModel, trackingModel, cacheModel, logger),
-		group:    service.NewGroup(db, groupModel, activityModel, trackingModel, logger),
-		reviewer: service.NewReviewer(reviewerModel, viewService, logger),
-		stats:    service.NewStats(statsModel, userModel, groupModel, logger),
-		view:     viewService,
-		sync:     service.NewSync(syncModel, logger),
-		comment:  service.NewComment(commentModel, logger),
-		cache:    service.NewCache(db, cacheModel, logger),
+		user:      userService,
+		group:     service.NewGroup(db, groupModel, activityModel, trackingModel, goldService, logger),
+		reviewer:  service.NewReviewer(reviewerModel, activityModel, viewService, logger),
+		stats:     service.NewStats(statsModel, userModel, groupModel, logger),
+		view:      viewService,
+		sync:      service.NewSync(syncModel, logger),
+		comment:   service.NewComment(commentModel, logger),
+		cache:     service.NewCache(db, cacheModel, logger),
+		consensus: service.NewConsensus(decisionModel, logger),
+		gold:      goldService,
+		rule:      service.NewRule(ruleModel, logger),
 	}
 }
 
@@ -84,3 +97,18 @@ func (s *Service) Comment() *service.CommentService {
 func (s *Service) Cache() *service.CacheService {
 	return s.cache
 }
+
+// Consensus returns the consensus service.
+func (s *Service) Consensus() *service.ConsensusService {
+	return s.consensus
+}
+
+// Gold returns the gold service.
+func (s *Service) Gold() *service.GoldService {
+	return s.gold
+}
+
+// Rule returns the detection rule service.
+func (s *Service) Rule() *service.RuleService {
+	return s.rule
+}
@@ -2,11 +2,13 @@ package service
 
 import (
 	"context"
+	"hash/fnv"
 	"time"
 
 	"github.com/robalyx/rotector/internal/database/dbretry"
 	"github.com/robalyx/rotector/internal/database/models"
 	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
 	"github.com/robalyx/rotector/pkg/utils"
 	"github.com/uptrace/bun"
 	"go.uber.org/zap"
@@ -81,25 +83,134 @@ func (s *CacheService) FilterProcessedUsers(ctx context.Context, userIDs []int64
 	return unprocessedIDs, nil
 }
 
-// MarkUsersProcessed marks users as processed with calculated times based on their account age.
-func (s *CacheService) MarkUsersProcessed(ctx context.Context, userCreationDates map[int64]time.Time) error {
-	if len(userCreationDates) == 0 {
+// MarkUsersProcessed marks users as processed and schedules their next scan based on their risk.
+// Risk combines the confidence from this scan, flagged friends and groups, changes to the
+// description and friend count since the last scan, and earlier reviewer decisions.
+func (s *CacheService) MarkUsersProcessed(ctx context.Context, users []*types.ReviewUser) error {
+	return s.MarkUsersProcessedFromGroups(ctx, users, nil)
+}
+
+// MarkUsersProcessedFromGroups marks users as processed like MarkUsersProcessed and
+// records the group each user was queued from, keyed by user ID.
+func (s *CacheService) MarkUsersProcessedFromGroups(
+	ctx context.Context, users []*types.ReviewUser, sourceGroups map[int64]int64,
+) error {
+	if len(users) == 0 {
 		return nil
 	}
 
+	userIDs := make([]int64, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.ID)
+	}
+
 	return dbretry.NoResult(ctx, func(ctx context.Context) error {
+		// Get previous processing log entries to detect changes
+		previousEntries, err := s.model.GetProcessingLogs(ctx, userIDs)
+		if err != nil {
+			return err
+		}
+
+		previousMap := make(map[int64]types.UserProcessingLog, len(previousEntries))
+		for _, entry := range previousEntries {
+			previousMap[entry.UserID] = entry
+		}
+
 		now := time.Now()
-		entries := make([]*types.UserProcessingLog, 0, len(userCreationDates))
-
-		for userID, createdAt := range userCreationDates {
-			interval := utils.CalculateProcessingInterval(createdAt)
-			entries = append(entries, &types.UserProcessingLog{
-				UserID:        userID,
-				LastProcessed: now,
-				NextScanTime:  now.Add(interval),
-			})
+		entries := make([]*types.UserProcessingLog, 0, len(users))
+
+		for _, user := range users {
+			entry := &types.UserProcessingLog{
+				UserID:          user.ID,
+				LastProcessed:   now,
+				DescriptionHash: hashDescription(user.Description),
+				FriendCount:     len(user.Friends),
+				SourceGroupID:   sourceGroups[user.ID],
+			}
+
+			factors := utils.RiskFactors{
+				Confidence:        user.Confidence,
+				NeighbourhoodRisk: neighbourhoodRisk(user.Reasons),
+			}
+
+			if previous, ok := previousMap[user.ID]; ok {
+				factors.DescriptionChanged = previous.DescriptionHash != entry.DescriptionHash
+				factors.FriendCountChanged = previous.FriendCount != entry.FriendCount
+				factors.Cleared = previous.IsCleared
+				factors.Confirmed = previous.IsConfirmed
+			}
+
+			entry.RiskScore = utils.CalculateRiskScore(factors)
+			entry.NextScanTime = now.Add(utils.CalculateRiskInterval(user.CreatedAt, factors))
+			entry.IsCleared = factors.Cleared
+			entry.IsConfirmed = factors.Confirmed
+
+			entries = append(entries, entry)
 		}
 
 		return s.model.MarkUsersProcessed(ctx, entries)
 	})
 }
+
+// GetDueUsers retrieves the IDs of users that are due for a rescan, highest risk first.
+func (s *CacheService) GetDueUsers(ctx context.Context, limit int) ([]int64, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+
+	entries, err := s.model.GetDueUsers(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDs := make([]int64, 0, len(entries))
+	for _, entry := range entries {
+		userIDs = append(userIDs, entry.UserID)
+	}
+
+	if len(userIDs) > 0 {
+		s.logger.Debug("Retrieved users due for rescan",
+			zap.Int("count", len(userIDs)),
+			zap.Float64("highestRisk", entries[0].RiskScore))
+	}
+
+	return userIDs, nil
+}
+
+// DeferUsers pushes back the next scan of users that were handed to the recheck lane,
+// so they are not picked again while they wait. Processing them schedules the real next scan.
+func (s *CacheService) DeferUsers(ctx context.Context, userIDs []int64, delay time.Duration) error {
+	return s.model.DeferUsers(ctx, userIDs, time.Now().Add(delay))
+}
+
+// RemoveUsers removes the cache entries of the given users, taking them out of the rescan queue.
+func (s *CacheService) RemoveUsers(ctx context.Context, userIDs []int64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	return dbretry.Transaction(ctx, s.db, func(ctx context.Context, tx bun.Tx) error {
+		return s.model.DeleteUserCacheWithTx(ctx, tx, userIDs)
+	})
+}
+
+// neighbourhoodRisk returns the strongest friend or group reason confidence of a user.
+func neighbourhoodRisk(reasons types.Reasons[enum.UserReasonType]) float64 {
+	var risk float64
+
+	for _, reasonType := range []enum.UserReasonType{enum.UserReasonTypeFriend, enum.UserReasonTypeGroup} {
+		if reason, ok := reasons[reasonType]; ok && reason != nil {
+			risk = max(risk, reason.Confidence)
+		}
+	}
+
+	return risk
+}
+
+// hashDescription returns a hash of a user's description for change detection.
+func hashDescription(description string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(description))
+
+	return int64(h.Sum64()) //nolint:gosec // hash only needs to be stable, not positive
+}
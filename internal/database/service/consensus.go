@@ -0,0 +1,215 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/robalyx/rotector/internal/database/models"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+	"go.uber.org/zap"
+)
+
+// ConsensusConfidenceThreshold is the confidence below which a decision needs a second reviewer.
+const ConsensusConfidenceThreshold = 0.5
+
+var (
+	ErrAlreadyVoted   = errors.New("reviewer already voted on this decision")
+	ErrAwaitingAdmin  = errors.New("decision is escalated and awaiting an admin")
+	ErrDecisionRacing = errors.New("decision changed while voting")
+)
+
+// VoteOutcome describes what a vote on a decision led to.
+type VoteOutcome int
+
+const (
+	// VoteOutcomeApplied means the decision did not need consensus and can be applied.
+	VoteOutcomeApplied VoteOutcome = iota
+	// VoteOutcomePending means the vote was recorded and a second reviewer is needed.
+	VoteOutcomePending
+	// VoteOutcomeAgreed means a second reviewer agreed and the decision can be applied.
+	VoteOutcomeAgreed
+	// VoteOutcomeEscalated means the reviewers disagreed and an admin must decide.
+	VoteOutcomeEscalated
+	// VoteOutcomeResolved means an admin decided the decision and it can be applied.
+	VoteOutcomeResolved
+)
+
+// VoteResult is the result of a vote on a decision.
+type VoteResult struct {
+	Outcome  VoteOutcome
+	Decision *types.PendingDecision // Nil if the decision did not need consensus
+}
+
+// ShouldApply returns true if the voted action should be applied now.
+func (r *VoteResult) ShouldApply() bool {
+	return r.Outcome == VoteOutcomeApplied ||
+		r.Outcome == VoteOutcomeAgreed ||
+		r.Outcome == VoteOutcomeResolved
+}
+
+// LogDetails returns the votes of the decision for activity log details.
+func (r *VoteResult) LogDetails() map[string]any {
+	if r.Decision == nil {
+		return map[string]any{}
+	}
+
+	details := map[string]any{
+		"decisionId":    r.Decision.ID,
+		"consensus":     string(r.Decision.Status),
+		"consensusWhy":  r.Decision.Reason,
+		"firstReviewer": r.Decision.FirstReviewerID,
+		"firstVote":     string(r.Decision.FirstAction),
+	}
+
+	if r.Decision.SecondReviewerID != 0 {
+		details["secondReviewer"] = r.Decision.SecondReviewerID
+		details["secondVote"] = string(r.Decision.SecondAction)
+	}
+
+	if r.Decision.ResolverID != 0 {
+		details["resolver"] = r.Decision.ResolverID
+		details["resolvedVote"] = string(r.Decision.ResolvedAction)
+	}
+
+	return details
+}
+
+// ConsensusService handles two-reviewer consensus for high-impact review decisions.
+type ConsensusService struct {
+	model  *models.DecisionModel
+	logger *zap.Logger
+}
+
+// NewConsensus creates a new consensus service.
+func NewConsensus(model *models.DecisionModel, logger *zap.Logger) *ConsensusService {
+	return &ConsensusService{
+		model:  model,
+		logger: logger.Named("consensus_service"),
+	}
+}
+
+// UserConsensusReason returns why a decision on the user needs a second reviewer,
+// or an empty string if a single reviewer may decide.
+func (s *ConsensusService) UserConsensusReason(user *types.ReviewUser) string {
+	switch {
+	case user.Category == enum.UserCategoryTypeCSAM || user.Category == enum.UserCategoryTypePredatory:
+		return user.Category.String() + " category"
+	case user.Confidence < ConsensusConfidenceThreshold:
+		return fmt.Sprintf("low confidence (%.2f)", user.Confidence)
+	default:
+		return ""
+	}
+}
+
+// GroupConsensusReason returns why a decision on the group needs a second reviewer,
+// or an empty string if a single reviewer may decide.
+func (s *ConsensusService) GroupConsensusReason(group *types.ReviewGroup) string {
+	if group.Confidence < ConsensusConfidenceThreshold {
+		return fmt.Sprintf("low confidence (%.2f)", group.Confidence)
+	}
+
+	return ""
+}
+
+// GetOpenDecision retrieves the open decision of a target, or nil if there is none.
+func (s *ConsensusService) GetOpenDecision(
+	ctx context.Context, targetType types.DecisionTargetType, targetID int64,
+) (*types.PendingDecision, error) {
+	decision, err := s.model.GetOpenDecision(ctx, targetType, targetID)
+	if errors.Is(err, types.ErrDecisionNotFound) {
+		return nil, nil
+	}
+
+	return decision, err
+}
+
+// Vote records a reviewer's vote on a target. The reason is the result of
+// UserConsensusReason or GroupConsensusReason; an empty reason lets the vote apply
+// immediately unless the target already has an open decision. Escalated decisions
+// can only be resolved by an admin who did not vote on them.
+func (s *ConsensusService) Vote(
+	ctx context.Context, targetType types.DecisionTargetType, targetID int64, reason string,
+	reviewerID uint64, isAdmin bool, action types.DecisionAction,
+) (*VoteResult, error) {
+	decision, err := s.GetOpenDecision(ctx, targetType, targetID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Open a new decision if consensus is needed
+	if decision == nil {
+		if reason == "" {
+			return &VoteResult{Outcome: VoteOutcomeApplied}, nil
+		}
+
+		decision = &types.PendingDecision{
+			TargetType:      targetType,
+			TargetID:        targetID,
+			Reason:          reason,
+			FirstReviewerID: reviewerID,
+			FirstAction:     action,
+		}
+
+		err := s.model.CreateDecision(ctx, decision)
+		if errors.Is(err, types.ErrDecisionExists) {
+			return nil, ErrDecisionRacing
+		} else if err != nil {
+			return nil, err
+		}
+
+		return &VoteResult{Outcome: VoteOutcomePending, Decision: decision}, nil
+	}
+
+	// Escalated decisions wait for an admin who did not vote
+	if decision.Status == types.DecisionStatusEscalated {
+		if !isAdmin {
+			return nil, ErrAwaitingAdmin
+		}
+
+		if _, voted := decision.Votes()[reviewerID]; voted {
+			return nil, ErrAlreadyVoted
+		}
+
+		if err := s.model.ResolveDecision(ctx, decision, reviewerID, action); err != nil {
+			return nil, s.wrapVoteError(err)
+		}
+
+		return &VoteResult{Outcome: VoteOutcomeResolved, Decision: decision}, nil
+	}
+
+	// Second vote must come from a different reviewer
+	if decision.FirstReviewerID == reviewerID {
+		return nil, ErrAlreadyVoted
+	}
+
+	status := types.DecisionStatusAgreed
+	outcome := VoteOutcomeAgreed
+
+	if action != decision.FirstAction {
+		status = types.DecisionStatusEscalated
+		outcome = VoteOutcomeEscalated
+	}
+
+	if err := s.model.RecordSecondVote(ctx, decision, reviewerID, action, status); err != nil {
+		return nil, s.wrapVoteError(err)
+	}
+
+	s.logger.Debug("Recorded second vote",
+		zap.Int64("decisionID", decision.ID),
+		zap.String("firstAction", string(decision.FirstAction)),
+		zap.String("secondAction", string(action)),
+		zap.String("status", string(status)))
+
+	return &VoteResult{Outcome: outcome, Decision: decision}, nil
+}
+
+// wrapVoteError turns a lost update race into ErrDecisionRacing.
+func (s *ConsensusService) wrapVoteError(err error) error {
+	if errors.Is(err, types.ErrDecisionNotPending) {
+		return ErrDecisionRacing
+	}
+
+	return err
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/robalyx/rotector/internal/database/models"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+	"go.uber.org/zap"
+)
+
+const (
+	// GoldInjectionRate is the chance that a review queue fetch serves a gold item.
+	GoldInjectionRate = 0.05
+	// GoldDemotionThreshold is the accuracy below which reviewers are moved to training mode.
+	GoldDemotionThreshold = 0.7
+	// GoldDemotionMinAnswers is the number of gold answers needed before a reviewer can be demoted.
+	GoldDemotionMinAnswers = 10
+	// GoldDemotionWindow is the time window of gold answers used for demotion.
+	GoldDemotionWindow = 30 * 24 * time.Hour
+)
+
+// GoldService handles gold-standard items used to measure reviewer accuracy.
+type GoldService struct {
+	model  *models.GoldModel
+	logger *zap.Logger
+}
+
+// NewGold creates a new gold service.
+func NewGold(model *models.GoldModel, logger *zap.Logger) *GoldService {
+	return &GoldService{
+		model:  model,
+		logger: logger.Named("gold_service"),
+	}
+}
+
+// MarkGoldItem adds a target to the gold set with the action reviewers are expected to take.
+func (s *GoldService) MarkGoldItem(
+	ctx context.Context, targetType types.DecisionTargetType, targetID int64,
+	expectedAction types.DecisionAction, adminID uint64,
+) error {
+	return s.model.SaveGoldItem(ctx, &types.GoldItem{
+		TargetType:     targetType,
+		TargetID:       targetID,
+		ExpectedAction: expectedAction,
+		CreatedBy:      adminID,
+	})
+}
+
+// RemoveGoldItem removes a target from the gold set.
+func (s *GoldService) RemoveGoldItem(
+	ctx context.Context, targetType types.DecisionTargetType, targetID int64,
+) error {
+	return s.model.DeleteGoldItem(ctx, targetType, targetID)
+}
+
+// GetGoldItem retrieves the gold item of a target, or nil if the target is not a gold item.
+func (s *GoldService) GetGoldItem(
+	ctx context.Context, targetType types.DecisionTargetType, targetID int64,
+) (*types.GoldItem, error) {
+	item, err := s.model.GetGoldItem(ctx, targetType, targetID)
+	if errors.Is(err, types.ErrGoldItemNotFound) {
+		return nil, nil
+	}
+
+	return item, err
+}
+
+// PickGoldTargets occasionally returns the gold targets a review queue fetch should
+// be limited to. Returns nil when the fetch should use the regular queue.
+func (s *GoldService) PickGoldTargets(
+	ctx context.Context, targetType types.DecisionTargetType, recentIDs []int64,
+) []int64 {
+	if rand.Float64() >= GoldInjectionRate { //nolint:gosec // no need for cryptographic randomness
+		return nil
+	}
+
+	targetIDs, err := s.model.GetGoldTargetIDs(ctx, targetType, recentIDs)
+	if err != nil {
+		s.logger.Error("Failed to get gold targets", zap.Error(err))
+		return nil
+	}
+
+	return targetIDs
+}
+
+// RecordAnswer grades a reviewer's action if the target is a gold item.
+// Returns true if the target was a gold item, in which case the action must not be applied.
+func (s *GoldService) RecordAnswer(
+	ctx context.Context, targetType types.DecisionTargetType, targetID int64,
+	reviewerID uint64, action types.DecisionAction,
+) (bool, error) {
+	item, err := s.GetGoldItem(ctx, targetType, targetID)
+	if err != nil || item == nil {
+		return false, err
+	}
+
+	err = s.model.SaveGoldResult(ctx, &types.GoldResult{
+		GoldItemID:      item.ID,
+		ReviewerID:      reviewerID,
+		TargetType:      targetType,
+		TargetID:        targetID,
+		SubmittedAction: action,
+		Correct:         action == item.ExpectedAction,
+	})
+	if err != nil {
+		return true, err
+	}
+
+	s.logger.Debug("Recorded gold answer",
+		zap.Uint64("reviewerID", reviewerID),
+		zap.String("targetType", string(targetType)),
+		zap.Int64("targetID", targetID),
+		zap.Bool("correct", action == item.ExpectedAction))
+
+	return true, nil
+}
+
+// GetReviewerAccuracies retrieves the gold item accuracy of reviewers for a stats period.
+func (s *GoldService) GetReviewerAccuracies(
+	ctx context.Context, reviewerIDs []uint64, period enum.ReviewerStatsPeriod,
+) (map[uint64]*types.ReviewerAccuracy, error) {
+	return s.model.GetReviewerAccuracies(ctx, reviewerIDs, statsPeriodStart(period))
+}
+
+// ShouldDemote checks if a reviewer answered enough gold items recently with an
+// accuracy below GoldDemotionThreshold to be restricted to training mode.
+func (s *GoldService) ShouldDemote(ctx context.Context, reviewerID uint64) (bool, error) {
+	accuracies, err := s.model.GetReviewerAccuracies(
+		ctx, []uint64{reviewerID}, time.Now().Add(-GoldDemotionWindow),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	accuracy, ok := accuracies[reviewerID]
+	if !ok || accuracy.Answered < GoldDemotionMinAnswers {
+		return false, nil
+	}
+
+	return accuracy.Rate() < GoldDemotionThreshold, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/robalyx/rotector/internal/database/dbretry"
@@ -20,6 +21,7 @@ type GroupService struct {
 	model    *models.GroupModel
 	activity *models.ActivityModel
 	tracking *models.TrackingModel
+	gold     *GoldService
 	logger   *zap.Logger
 }
 
@@ -29,6 +31,7 @@ func NewGroup(
 	model *models.GroupModel,
 	activity *models.ActivityModel,
 	tracking *models.TrackingModel,
+	gold *GoldService,
 	logger *zap.Logger,
 ) *GroupService {
 	return &GroupService{
@@ -36,6 +39,7 @@ func NewGroup(
 		model:    model,
 		activity: activity,
 		tracking: tracking,
+		gold:     gold,
 		logger:   logger.Named("group_service"),
 	}
 }
@@ -68,9 +72,50 @@ func (s *GroupService) MixGroup(ctx context.Context, group *types.ReviewGroup, r
 	return nil
 }
 
-// GetGroupToReview finds a group to review based on the sort method and target mode.
+// RevertDecision restores a group to the state before a status change. Reviewers may only
+// revert their own latest decision within DecisionUndoWindow, while admins may revert any
+// latest decision. Returns the status change recording the revert.
+func (s *GroupService) RevertDecision(
+	ctx context.Context, changeID int64, reviewerID uint64, isAdmin bool,
+) (*types.GroupStatusChange, error) {
+	change, err := s.model.GetStatusChange(ctx, changeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAdmin {
+		if change.ReviewerID != reviewerID {
+			return nil, ErrNotDecisionOwner
+		}
+
+		if time.Since(change.CreatedAt) > DecisionUndoWindow {
+			return nil, ErrUndoWindowExpired
+		}
+	}
+
+	var revert *types.GroupStatusChange
+
+	err = dbretry.Transaction(ctx, s.db, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+
+		revert, err = s.model.RevertStatusChangeWithTx(ctx, tx, changeID, reviewerID)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return revert, nil
+}
+
+// GetGroupToReview finds a group to review based on the sort method, target mode and
+// the reviewer's filter preset, which may be nil. Groups claimed by other reviewers and
+// groups with an open decision the reviewer voted on are skipped, and escalated
+// decisions are only served to admins, who get them first.
 func (s *GroupService) GetGroupToReview(
-	ctx context.Context, sortBy enum.ReviewSortBy, targetMode enum.ReviewTargetMode, reviewerID uint64,
+	ctx context.Context, sortBy enum.ReviewSortBy, targetMode enum.ReviewTargetMode,
+	reviewerID uint64, isAdmin bool, claimedIDs []int64, preset *types.ReviewFilterPreset,
 ) (*types.ReviewGroup, error) {
 	// Get recently reviewed group IDs
 	recentIDs, err := s.activity.GetRecentlyReviewedIDs(ctx, reviewerID, true, 50)
@@ -80,6 +125,13 @@ func (s *GroupService) GetGroupToReview(
 		recentIDs = []int64{} // Continue without filtering if there's an error
 	}
 
+	filter := &types.ReviewQueueFilter{
+		ExcludeIDs:    append(recentIDs, claimedIDs...),
+		VoterID:       reviewerID,
+		SkipEscalated: !isAdmin,
+	}
+	preset.ApplyTo(filter, reviewerID, time.Now())
+
 	// Determine target status based on mode
 	var targetStatus enum.GroupType
 
@@ -92,8 +144,33 @@ func (s *GroupService) GetGroupToReview(
 		targetStatus = enum.GroupTypeMixed
 	}
 
+	var result *types.ReviewGroup
+
+	// Serve escalated decisions to admins before anything else
+	if isAdmin {
+		escalatedFilter := *filter
+		escalatedFilter.OnlyEscalated = true
+
+		result, err = s.model.GetNextToReview(ctx, targetStatus, sortBy, &escalatedFilter)
+	}
+
+	// Occasionally serve a gold item to measure the accuracy of non-admins
+	if result == nil && !isAdmin {
+		if goldIDs := s.gold.PickGoldTargets(ctx, types.DecisionTargetGroup, filter.ExcludeIDs); len(goldIDs) > 0 {
+			result, err = s.model.GetNextToReview(ctx, targetStatus, sortBy, &types.ReviewQueueFilter{
+				ExcludeIDs:    filter.ExcludeIDs,
+				OnlyIDs:       goldIDs,
+				VoterID:       filter.VoterID,
+				SkipEscalated: filter.SkipEscalated,
+			})
+		}
+	}
+
 	// Get next group to review
-	result, err := s.model.GetNextToReview(ctx, targetStatus, sortBy, recentIDs)
+	if result == nil {
+		result, err = s.model.GetNextToReview(ctx, targetStatus, sortBy, filter)
+	}
+
 	if err != nil {
 		if errors.Is(err, types.ErrNoGroupsToReview) {
 			// If no groups found with primary status, try other statuses in order
@@ -109,7 +186,7 @@ func (s *GroupService) GetGroupToReview(
 			}
 
 			for _, status := range fallbackStatuses {
-				result, err = s.model.GetNextToReview(ctx, status, sortBy, recentIDs)
+				result, err = s.model.GetNextToReview(ctx, status, sortBy, filter)
 				if err == nil {
 					break
 				}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"maps"
+	"time"
 
 	"github.com/robalyx/rotector/internal/database/models"
 	"github.com/robalyx/rotector/internal/database/types"
@@ -9,23 +11,29 @@ import (
 	"go.uber.org/zap"
 )
 
+// FatigueMaxReviewGap is the longest time between two decisions that still counts as one sitting.
+const FatigueMaxReviewGap = 10 * time.Minute
+
 // ReviewerService handles reviewer-related business logic.
 type ReviewerService struct {
-	model  *models.ReviewerModel
-	views  *ViewService
-	logger *zap.Logger
+	model    *models.ReviewerModel
+	activity *models.ActivityModel
+	views    *ViewService
+	logger   *zap.Logger
 }
 
 // NewReviewer creates a new reviewer service.
 func NewReviewer(
 	model *models.ReviewerModel,
+	activity *models.ActivityModel,
 	views *ViewService,
 	logger *zap.Logger,
 ) *ReviewerService {
 	return &ReviewerService{
-		model:  model,
-		views:  views,
-		logger: logger.Named("reviewer_service"),
+		model:    model,
+		activity: activity,
+		views:    views,
+		logger:   logger.Named("reviewer_service"),
 	}
 }
 
@@ -48,3 +56,140 @@ func (s *ReviewerService) GetReviewerStats(
 	// Get stats from the model
 	return s.model.GetReviewerStats(ctx, period, cursor, limit)
 }
+
+// GetAssignments retrieves the assigned categories of each reviewer.
+func (s *ReviewerService) GetAssignments(ctx context.Context) (map[uint64][]enum.UserCategoryType, error) {
+	assignments, err := s.model.GetAssignments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint64][]enum.UserCategoryType)
+	for _, assignment := range assignments {
+		result[assignment.ReviewerID] = append(result[assignment.ReviewerID], assignment.Category)
+	}
+
+	return result, nil
+}
+
+// SetAssignments replaces the assigned categories of a reviewer.
+func (s *ReviewerService) SetAssignments(
+	ctx context.Context, reviewerID uint64, categories []enum.UserCategoryType, adminID uint64,
+) error {
+	return s.model.SetAssignments(ctx, reviewerID, categories, adminID)
+}
+
+// GetClusterAssignments retrieves the groups whose members are assigned to each reviewer.
+func (s *ReviewerService) GetClusterAssignments(ctx context.Context) (map[uint64][]int64, error) {
+	assignments, err := s.model.GetClusterAssignments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[uint64][]int64)
+	for _, assignment := range assignments {
+		result[assignment.ReviewerID] = append(result[assignment.ReviewerID], assignment.GroupID)
+	}
+
+	return result, nil
+}
+
+// SetClusterAssignments replaces the groups whose members are assigned to a reviewer.
+func (s *ReviewerService) SetClusterAssignments(
+	ctx context.Context, reviewerID uint64, groupIDs []int64, adminID uint64,
+) error {
+	return s.model.SetClusterAssignments(ctx, reviewerID, groupIDs, adminID)
+}
+
+// assignedCategories returns the categories assigned to the reviewer.
+func assignedCategories(assignments []*types.ReviewerAssignment, reviewerID uint64) []enum.UserCategoryType {
+	var categories []enum.UserCategoryType
+
+	for _, assignment := range assignments {
+		if assignment.ReviewerID == reviewerID {
+			categories = append(categories, assignment.Category)
+		}
+	}
+
+	return categories
+}
+
+// assignedClusters returns the groups whose members are assigned to the reviewer.
+func assignedClusters(assignments []*types.ReviewerClusterAssignment, reviewerID uint64) []int64 {
+	var groupIDs []int64
+
+	for _, assignment := range assignments {
+		if assignment.ReviewerID == reviewerID {
+			groupIDs = append(groupIDs, assignment.GroupID)
+		}
+	}
+
+	return groupIDs
+}
+
+// GetFastStreak counts the consecutive fast decisions a reviewer made since the given time,
+// using the fast review threshold of their policy. Training mode does not allow decisions, so
+// the users and groups the reviewer moved through are counted instead.
+// Returns 0 if the policy has no streak limit.
+func (s *ReviewerService) GetFastStreak(
+	ctx context.Context, reviewerID uint64, since time.Time, policy types.ReviewPolicy, trainingMode bool,
+) (int, error) {
+	if policy.MaxFastStreak <= 0 || policy.FastReviewThreshold <= 0 {
+		return 0, nil
+	}
+
+	activityTypes := enum.DecisionActivityTypes
+	if trainingMode {
+		activityTypes = enum.TrainingActivityTypes
+	}
+
+	timestamps, err := s.activity.GetActivityTimes(ctx, reviewerID, activityTypes, since, policy.MaxFastStreak+1)
+	if err != nil {
+		return 0, err
+	}
+
+	return types.CountFastStreak(timestamps, policy.FastReviewThreshold), nil
+}
+
+// GetReviewerFatigue retrieves the decision pace of reviewers for a time period.
+// Fast decisions are counted with the threshold of the policy that applies to each reviewer.
+func (s *ReviewerService) GetReviewerFatigue(
+	ctx context.Context, settings *types.BotSetting, reviewerIDs []uint64, period enum.ReviewerStatsPeriod,
+) (map[uint64]*types.ReviewerFatigue, error) {
+	// Group reviewers sharing a threshold so each threshold needs one query
+	byThreshold := make(map[time.Duration][]uint64)
+	for _, reviewerID := range reviewerIDs {
+		threshold := settings.ReviewPolicy(reviewerID).FastReviewThreshold
+		byThreshold[threshold] = append(byThreshold[threshold], reviewerID)
+	}
+
+	since := statsPeriodStart(period)
+	result := make(map[uint64]*types.ReviewerFatigue, len(reviewerIDs))
+
+	for threshold, ids := range byThreshold {
+		fatigues, err := s.activity.GetReviewerFatigue(ctx, ids, since, threshold, FatigueMaxReviewGap)
+		if err != nil {
+			return nil, err
+		}
+
+		maps.Copy(result, fatigues)
+	}
+
+	return result, nil
+}
+
+// statsPeriodStart returns the start of a reviewer stats period.
+func statsPeriodStart(period enum.ReviewerStatsPeriod) time.Time {
+	since := time.Now()
+
+	switch period {
+	case enum.ReviewerStatsPeriodDaily:
+		since = since.AddDate(0, 0, -1)
+	case enum.ReviewerStatsPeriodWeekly:
+		since = since.AddDate(0, 0, -7)
+	case enum.ReviewerStatsPeriodMonthly:
+		since = since.AddDate(0, -1, 0)
+	}
+
+	return since
+}
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/robalyx/rotector/internal/database/models"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/pkg/utils"
+	"go.uber.org/zap"
+)
+
+const (
+	// RuleTermMinLength is the minimum length of a rule term, as short terms match too much.
+	RuleTermMinLength = 3
+	// RuleTermMaxLength is the maximum length of a rule term.
+	RuleTermMaxLength = 100
+)
+
+// RuleService handles detection rules suggested by reviewers.
+type RuleService struct {
+	model  *models.RuleModel
+	logger *zap.Logger
+}
+
+// NewRule creates a new rule service.
+func NewRule(model *models.RuleModel, logger *zap.Logger) *RuleService {
+	return &RuleService{
+		model:  model,
+		logger: logger.Named("rule_service"),
+	}
+}
+
+// SuggestRule normalizes the term of a candidate rule and saves it for admin approval.
+// The maintenance worker back-tests the rule against existing targets in the background.
+func (s *RuleService) SuggestRule(ctx context.Context, rule *types.DetectionRule) error {
+	rule.Term = utils.NewTextNormalizer().Normalize(strings.Join(strings.Fields(rule.Term), " "))
+	if len(rule.Term) < RuleTermMinLength || len(rule.Term) > RuleTermMaxLength {
+		return fmt.Errorf("%w: must be between %d and %d characters",
+			types.ErrInvalidRuleTerm, RuleTermMinLength, RuleTermMaxLength)
+	}
+
+	if !slices.Contains(types.RuleScopes, rule.Scope) {
+		return fmt.Errorf("%w: %q", types.ErrInvalidRuleScope, rule.Scope)
+	}
+
+	rule.Status = types.RuleStatusPending
+
+	return s.model.CreateRule(ctx, rule)
+}
+
+// BacktestPending back-tests up to limit pending rules that were never back-tested.
+// Returns the number of rules back-tested. A failed back-test is logged and retried
+// on the next call.
+func (s *RuleService) BacktestPending(ctx context.Context, limit int) (int, error) {
+	rules, err := s.model.GetRulesToBacktest(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	tested := 0
+
+	for _, rule := range rules {
+		if err := s.Backtest(ctx, rule); err != nil {
+			s.logger.Error("Failed to back-test suggested rule",
+				zap.Error(err),
+				zap.Int64("ruleID", rule.ID))
+
+			continue
+		}
+
+		tested++
+	}
+
+	return tested, nil
+}
+
+// Backtest counts the flagged and cleared targets a rule would have matched and stores the result.
+func (s *RuleService) Backtest(ctx context.Context, rule *types.DetectionRule) error {
+	flaggedHits, clearedHits, err := s.model.CountRuleHits(ctx, rule.Scope, rule.Term)
+	if err != nil {
+		return err
+	}
+
+	if err := s.model.SaveBacktest(ctx, rule.ID, flaggedHits, clearedHits); err != nil {
+		return err
+	}
+
+	rule.FlaggedHits = flaggedHits
+	rule.ClearedHits = clearedHits
+
+	return nil
+}
+
+// ReviewRule enables or rejects a pending rule.
+func (s *RuleService) ReviewRule(ctx context.Context, ruleID int64, enable bool, adminID uint64) error {
+	status := types.RuleStatusRejected
+	if enable {
+		status = types.RuleStatusEnabled
+	}
+
+	if err := s.model.SetRuleStatus(ctx, ruleID, status, adminID); err != nil {
+		return err
+	}
+
+	s.logger.Info("Reviewed detection rule",
+		zap.Int64("ruleID", ruleID),
+		zap.String("status", string(status)),
+		zap.Uint64("adminID", adminID))
+
+	return nil
+}
+
+// GetPendingRules retrieves a page of the approval queue.
+func (s *RuleService) GetPendingRules(
+	ctx context.Context, offset, limit int,
+) ([]*types.DetectionRule, int, error) {
+	return s.model.GetRulesByStatus(ctx, types.RuleStatusPending, offset, limit)
+}
+
+// GetEnabledRules retrieves the rules of a scope that detection should match.
+func (s *RuleService) GetEnabledRules(ctx context.Context, scope types.RuleScope) ([]*types.DetectionRule, error) {
+	return s.model.GetEnabledRules(ctx, scope)
+}
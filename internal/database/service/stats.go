@@ -7,6 +7,7 @@ import (
 
 	"github.com/robalyx/rotector/internal/database/models"
 	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
 	"go.uber.org/zap"
 )
 
@@ -79,8 +80,8 @@ func (s *StatsService) GetCurrentCounts(ctx context.Context) (*types.UserCounts,
 	return userCounts, groupCounts, nil
 }
 
-// SaveHourlyStats saves the current statistics snapshot.
-func (s *StatsService) SaveHourlyStats(ctx context.Context) error {
+// SaveHourlyStatsWithTx saves the current statistics snapshot using the provided transaction.
+func (s *StatsService) SaveHourlyStatsWithTx(ctx context.Context, tx bun.Tx) error {
 	// Get current stats
 	stats, err := s.GetCurrentStats(ctx)
 	if err != nil {
@@ -88,7 +89,7 @@ func (s *StatsService) SaveHourlyStats(ctx context.Context) error {
 	}
 
 	// Save stats to database
-	if err := s.model.SaveHourlyStats(ctx, stats); err != nil {
+	if err := s.model.SaveHourlyStatsWithTx(ctx, tx, stats); err != nil {
 		return fmt.Errorf("failed to save hourly stats: %w", err)
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -13,11 +14,21 @@ import (
 	"github.com/robalyx/rotector/internal/database/models"
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
+	"github.com/robalyx/rotector/internal/setup/telemetry/tracing"
+	"github.com/robalyx/rotector/pkg/utils"
 	"github.com/sourcegraph/conc/pool"
 	"github.com/uptrace/bun"
 	"go.uber.org/zap"
 )
 
+// DecisionUndoWindow is how long reviewers may undo their own decisions.
+const DecisionUndoWindow = 15 * time.Minute
+
+var (
+	ErrNotDecisionOwner  = errors.New("decision was made by another reviewer")
+	ErrUndoWindowExpired = errors.New("decision is too old to undo")
+)
+
 // UserService handles user-related business logic.
 type UserService struct {
 	db       *bun.DB
@@ -25,6 +36,8 @@ type UserService struct {
 	activity *models.ActivityModel
 	tracking *models.TrackingModel
 	cache    *models.CacheModel
+	reviewer *models.ReviewerModel
+	gold     *GoldService
 	logger   *zap.Logger
 }
 
@@ -35,6 +48,8 @@ func NewUser(
 	activity *models.ActivityModel,
 	tracking *models.TrackingModel,
 	cache *models.CacheModel,
+	reviewer *models.ReviewerModel,
+	gold *GoldService,
 	logger *zap.Logger,
 ) *UserService {
 	return &UserService{
@@ -43,6 +58,8 @@ func NewUser(
 		activity: activity,
 		tracking: tracking,
 		cache:    cache,
+		reviewer: reviewer,
+		gold:     gold,
 		logger:   logger.Named("user_service"),
 	}
 }
@@ -64,12 +81,25 @@ func (s *UserService) ConfirmUsers(ctx context.Context, users []*types.ReviewUse
 		user.Status = enum.UserTypeConfirmed
 	}
 
-	// Update user statuses and create verification records
-	if err := s.model.ConfirmUsers(ctx, users); err != nil {
-		return err
+	userIDs := make([]int64, len(users))
+	for i, user := range users {
+		userIDs[i] = user.ID
 	}
 
-	return nil
+	return dbretry.Transaction(ctx, s.db, func(ctx context.Context, tx bun.Tx) error {
+		// Update user statuses and create verification records
+		if err := s.model.ConfirmUsersWithTx(ctx, tx, users); err != nil {
+			return err
+		}
+
+		// Remove confirmed users from the rescan queue
+		if err := s.cache.MarkUsersConfirmedWithTx(ctx, tx, userIDs); err != nil {
+			s.logger.Error("Failed to record confirmation in processing logs", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
 }
 
 // ClearUser moves a user to cleared status and creates a clearance record.
@@ -108,9 +138,77 @@ func (s *UserService) ClearUserWithTx(ctx context.Context, tx bun.Tx, user *type
 		return err
 	}
 
+	// Remove user from badge tracking
+	if err := s.tracking.RemoveUsersFromBadgeTrackingWithTx(ctx, tx, []int64{user.ID}); err != nil {
+		s.logger.Error("Failed to remove user from badge tracking", zap.Error(err))
+		return err
+	}
+
+	// Lower the user's rescan priority
+	err := s.cache.MarkUsersClearedWithTx(
+		ctx, tx, []int64{user.ID}, utils.ClearedRiskMultiplier, time.Now().Add(utils.MaxProcessingInterval),
+	)
+	if err != nil {
+		s.logger.Error("Failed to record clearance in processing logs", zap.Error(err))
+		return err
+	}
+
 	return nil
 }
 
+// RevertDecision restores a user to the state before a status change. Reviewers may only
+// revert their own latest decision within DecisionUndoWindow, while admins may revert any
+// latest decision. Group, asset, game and badge tracking removed by a clear is not restored
+// and is rebuilt as the user is rescanned. Returns the status change recording the revert.
+func (s *UserService) RevertDecision(
+	ctx context.Context, changeID int64, reviewerID uint64, isAdmin bool,
+) (*types.UserStatusChange, error) {
+	change, err := s.model.GetStatusChange(ctx, changeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAdmin {
+		if change.ReviewerID != reviewerID {
+			return nil, ErrNotDecisionOwner
+		}
+
+		if time.Since(change.CreatedAt) > DecisionUndoWindow {
+			return nil, ErrUndoWindowExpired
+		}
+	}
+
+	var revert *types.UserStatusChange
+
+	err = dbretry.Transaction(ctx, s.db, func(ctx context.Context, tx bun.Tx) error {
+		var err error
+
+		revert, err = s.model.RevertStatusChangeWithTx(ctx, tx, changeID, reviewerID)
+		if err != nil {
+			return err
+		}
+
+		// Restore the reviewer decision in the processing logs
+		userIDs := []int64{revert.UserID}
+
+		switch revert.After.Status {
+		case enum.UserTypeConfirmed:
+			return s.cache.MarkUsersConfirmedWithTx(ctx, tx, userIDs)
+		case enum.UserTypeCleared:
+			return s.cache.MarkUsersClearedWithTx(
+				ctx, tx, userIDs, utils.ClearedRiskMultiplier, time.Now().Add(utils.MaxProcessingInterval),
+			)
+		default:
+			return s.cache.ClearReviewDecisionWithTx(ctx, tx, userIDs)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return revert, nil
+}
+
 // UpdateToPastOffender updates users to past offender status when they become clean.
 func (s *UserService) UpdateToPastOffender(ctx context.Context, userIDs []int64) error {
 	if len(userIDs) == 0 {
@@ -139,6 +237,11 @@ func (s *UserService) UpdateToPastOffender(ctx context.Context, userIDs []int64)
 			return err
 		}
 
+		if err := s.tracking.RemoveUsersFromBadgeTrackingWithTx(ctx, tx, userIDs); err != nil {
+			s.logger.Error("Failed to remove users from badge tracking", zap.Error(err))
+			return err
+		}
+
 		return nil
 	})
 }
@@ -255,9 +358,15 @@ func (s *UserService) GetUsersByIDs(
 	return users, nil
 }
 
-// GetUserToReview finds a user to review based on the sort method and target mode.
+// GetUserToReview finds a user to review based on the sort method, target mode and
+// the reviewer's filter preset, which may be nil. Users claimed by other reviewers are
+// skipped, while members of the reviewer's assigned clusters and users in the reviewer's
+// assigned categories come first. Assignments only change the order, so users assigned to
+// an absent reviewer are still served to others. Users with an open decision the reviewer
+// voted on are skipped, and escalated decisions are only served to admins, who get them first.
 func (s *UserService) GetUserToReview(
-	ctx context.Context, sortBy enum.ReviewSortBy, targetMode enum.ReviewTargetMode, reviewerID uint64,
+	ctx context.Context, sortBy enum.ReviewSortBy, targetMode enum.ReviewTargetMode,
+	reviewerID uint64, isAdmin bool, claimedIDs []int64, preset *types.ReviewFilterPreset,
 ) (*types.ReviewUser, error) {
 	// Get recently reviewed user IDs
 	recentIDs, err := s.activity.GetRecentlyReviewedIDs(ctx, reviewerID, false, 50)
@@ -267,6 +376,33 @@ func (s *UserService) GetUserToReview(
 		recentIDs = []int64{} // Continue without filtering if there's an error
 	}
 
+	filter := &types.ReviewQueueFilter{
+		ExcludeIDs:    append(recentIDs, claimedIDs...),
+		VoterID:       reviewerID,
+		SkipEscalated: !isAdmin,
+	}
+	preset.ApplyTo(filter, reviewerID, time.Now())
+
+	// Look up the category and cluster assignments of the reviewer
+	var (
+		ownCategories []enum.UserCategoryType
+		ownClusters   []int64
+	)
+
+	assignments, err := s.reviewer.GetAssignments(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get reviewer assignments", zap.Error(err))
+	} else {
+		ownCategories = assignedCategories(assignments, reviewerID)
+	}
+
+	clusterAssignments, err := s.reviewer.GetClusterAssignments(ctx)
+	if err != nil {
+		s.logger.Error("Failed to get reviewer cluster assignments", zap.Error(err))
+	} else {
+		ownClusters = assignedClusters(clusterAssignments, reviewerID)
+	}
+
 	// Determine target status based on mode
 	var targetStatus enum.UserType
 
@@ -279,8 +415,56 @@ func (s *UserService) GetUserToReview(
 		targetStatus = enum.UserTypeCleared
 	}
 
+	var result *types.ReviewUser
+
+	// Serve escalated decisions to admins before anything else
+	if isAdmin {
+		escalatedFilter := *filter
+		escalatedFilter.OnlyEscalated = true
+
+		result, err = s.model.GetNextToReview(ctx, targetStatus, sortBy, &escalatedFilter)
+	}
+
+	// Occasionally serve a gold item to measure the accuracy of non-admins
+	if result == nil && !isAdmin {
+		if goldIDs := s.gold.PickGoldTargets(ctx, types.DecisionTargetUser, filter.ExcludeIDs); len(goldIDs) > 0 {
+			result, err = s.model.GetNextToReview(ctx, targetStatus, sortBy, &types.ReviewQueueFilter{
+				ExcludeIDs:    filter.ExcludeIDs,
+				OnlyIDs:       goldIDs,
+				VoterID:       filter.VoterID,
+				SkipEscalated: filter.SkipEscalated,
+			})
+		}
+	}
+
+	// Serve members of the reviewer's assigned clusters first
+	if result == nil && len(ownClusters) > 0 {
+		clusterFilter := *filter
+		clusterFilter.GroupIDs = ownClusters
+
+		result, err = s.model.GetNextToReview(ctx, targetStatus, sortBy, &clusterFilter)
+	}
+
+	// Serve users in the reviewer's assigned categories next, keeping only
+	// those the preset asks for when it limits categories
+	if len(filter.Categories) > 0 {
+		ownCategories = slices.DeleteFunc(ownCategories, func(category enum.UserCategoryType) bool {
+			return !slices.Contains(filter.Categories, category)
+		})
+	}
+
+	if result == nil && len(ownCategories) > 0 {
+		ownFilter := *filter
+		ownFilter.Categories = ownCategories
+
+		result, err = s.model.GetNextToReview(ctx, targetStatus, sortBy, &ownFilter)
+	}
+
 	// Get next user to review
-	result, err := s.model.GetNextToReview(ctx, targetStatus, sortBy, recentIDs)
+	if result == nil {
+		result, err = s.model.GetNextToReview(ctx, targetStatus, sortBy, filter)
+	}
+
 	if err != nil {
 		if errors.Is(err, types.ErrNoUsersToReview) {
 			// If no users found with primary status, try other statuses in order
@@ -296,7 +480,7 @@ func (s *UserService) GetUserToReview(
 			}
 
 			for _, status := range fallbackStatuses {
-				result, err = s.model.GetNextToReview(ctx, status, sortBy, recentIDs)
+				result, err = s.model.GetNextToReview(ctx, status, sortBy, filter)
 				if err == nil {
 					break
 				}
@@ -514,13 +698,17 @@ func (s *UserService) GetUsersRelationships(
 // SaveUsers handles the business logic for saving users.
 //
 //nolint:gocyclo // not important
-func (s *UserService) SaveUsers(ctx context.Context, users map[int64]*types.ReviewUser) error {
+func (s *UserService) SaveUsers(ctx context.Context, users map[int64]*types.ReviewUser) (err error) {
 	// Get list of user IDs to check
 	userIDs := make([]int64, 0, len(users))
 	for id := range users {
 		userIDs = append(userIDs, id)
 	}
 
+	ctx, span := tracing.Start(ctx, "UserService.SaveUsers",
+		tracing.BatchSize(len(users)), tracing.UserIDs(userIDs))
+	defer func() { tracing.End(span, err) }()
+
 	// Get existing users with all their data
 	existingUsers, err := s.model.GetUsersByIDs(
 		ctx,
@@ -729,6 +917,11 @@ func (s *UserService) DeleteUsersWithTx(ctx context.Context, tx bun.Tx, userIDs
 		return 0, err
 	}
 
+	if err := s.tracking.RemoveUsersFromBadgeTrackingWithTx(ctx, tx, userIDs); err != nil {
+		s.logger.Error("Failed to remove users from badge tracking", zap.Error(err))
+		return 0, err
+	}
+
 	// Delete core user data
 	affected, err := s.model.DeleteUsersWithTx(ctx, tx, userIDs)
 	if err != nil {
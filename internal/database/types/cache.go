@@ -9,9 +9,15 @@ type UserFriendCount struct {
 	LastUpdated time.Time `bun:",notnull" json:"lastUpdated"` // When the count was last cached
 }
 
-// UserProcessingLog tracks when users were last processed to prevent duplicate work.
+// UserProcessingLog tracks when users were last processed and when they should be scanned again.
 type UserProcessingLog struct {
-	UserID        int64     `bun:",pk"      json:"userId"`        // User ID
-	LastProcessed time.Time `bun:",notnull" json:"lastProcessed"` // When the user was last processed
-	NextScanTime  time.Time `bun:",notnull" json:"nextScanTime"`  // When the user can be scanned again
+	UserID          int64     `bun:",pk"                    json:"userId"`          // User ID
+	LastProcessed   time.Time `bun:",notnull"               json:"lastProcessed"`   // When the user was last processed
+	NextScanTime    time.Time `bun:",notnull"               json:"nextScanTime"`    // When the user can be scanned again
+	RiskScore       float64   `bun:",notnull,default:0"     json:"riskScore"`       // Risk score used to prioritize rescans
+	DescriptionHash int64     `bun:",notnull,default:0"     json:"descriptionHash"` // Hash of the last scanned description
+	FriendCount     int       `bun:",notnull,default:0"     json:"friendCount"`     // Friend count at the last scan
+	IsCleared       bool      `bun:",notnull,default:false" json:"isCleared"`       // Whether a reviewer cleared the user
+	IsConfirmed     bool      `bun:",notnull,default:false" json:"isConfirmed"`     // Whether a reviewer confirmed the user
+	SourceGroupID   int64     `bun:",notnull,default:0"     json:"sourceGroupId"`   // Group the user was queued from, if any
 }
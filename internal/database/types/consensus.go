@@ -0,0 +1,78 @@
+package types
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrDecisionNotFound   = errors.New("pending decision not found")
+	ErrDecisionNotPending = errors.New("decision is no longer pending")
+	ErrDecisionExists     = errors.New("an open decision already exists for this target")
+)
+
+// DecisionTargetType identifies whether a decision applies to a user or a group.
+type DecisionTargetType string
+
+const (
+	// DecisionTargetUser is a decision on a user.
+	DecisionTargetUser DecisionTargetType = "user"
+	// DecisionTargetGroup is a decision on a group.
+	DecisionTargetGroup DecisionTargetType = "group"
+)
+
+// DecisionAction is the action a reviewer voted for.
+type DecisionAction string
+
+const (
+	// DecisionActionConfirm votes to confirm the target.
+	DecisionActionConfirm DecisionAction = "confirm"
+	// DecisionActionClear votes to clear the target.
+	DecisionActionClear DecisionAction = "clear"
+	// DecisionActionMix votes to mark a group as mixed.
+	DecisionActionMix DecisionAction = "mix"
+)
+
+// DecisionStatus represents the lifecycle state of a consensus decision.
+type DecisionStatus string
+
+const (
+	// DecisionStatusPending means the decision is waiting for a second reviewer.
+	DecisionStatusPending DecisionStatus = "pending"
+	// DecisionStatusAgreed means a second reviewer agreed and the decision was applied.
+	DecisionStatusAgreed DecisionStatus = "agreed"
+	// DecisionStatusEscalated means the reviewers disagreed and an admin must decide.
+	DecisionStatusEscalated DecisionStatus = "escalated"
+	// DecisionStatusResolved means an admin decided an escalated decision.
+	DecisionStatusResolved DecisionStatus = "resolved"
+)
+
+// PendingDecision records the votes of a review decision that needs two reviewers.
+type PendingDecision struct {
+	ID               int64              `bun:",pk,autoincrement"  json:"id"`
+	TargetType       DecisionTargetType `bun:",notnull"           json:"targetType"`
+	TargetID         int64              `bun:",notnull"           json:"targetId"`
+	Reason           string             `bun:",notnull"           json:"reason"`
+	FirstReviewerID  uint64             `bun:",notnull"           json:"firstReviewerId"`
+	FirstAction      DecisionAction     `bun:",notnull"           json:"firstAction"`
+	FirstVotedAt     time.Time          `bun:",notnull"           json:"firstVotedAt"`
+	SecondReviewerID uint64             `bun:",nullzero"          json:"secondReviewerId"`
+	SecondAction     DecisionAction     `bun:",nullzero"          json:"secondAction"`
+	SecondVotedAt    time.Time          `bun:",nullzero"          json:"secondVotedAt"`
+	ResolverID       uint64             `bun:",nullzero"          json:"resolverId"`
+	ResolvedAction   DecisionAction     `bun:",nullzero"          json:"resolvedAction"`
+	ResolvedAt       time.Time          `bun:",nullzero"          json:"resolvedAt"`
+	Status           DecisionStatus     `bun:",notnull"           json:"status"`
+	CreatedAt        time.Time          `bun:",notnull"           json:"createdAt"`
+	UpdatedAt        time.Time          `bun:",notnull"           json:"updatedAt"`
+}
+
+// Votes returns the recorded votes keyed by reviewer ID.
+func (d *PendingDecision) Votes() map[uint64]DecisionAction {
+	votes := map[uint64]DecisionAction{d.FirstReviewerID: d.FirstAction}
+	if d.SecondReviewerID != 0 {
+		votes[d.SecondReviewerID] = d.SecondAction
+	}
+
+	return votes
+}
@@ -77,4 +77,36 @@ const (
 
 	// ActivityTypeGroupQueued tracks when a group is queued for review.
 	ActivityTypeGroupQueued
+	// ActivityTypeUserDecisionVoted tracks a vote on a user decision awaiting a second reviewer.
+	ActivityTypeUserDecisionVoted
+	// ActivityTypeUserDecisionEscalated tracks when reviewers disagree on a user decision.
+	ActivityTypeUserDecisionEscalated
+	// ActivityTypeGroupDecisionVoted tracks a vote on a group decision awaiting a second reviewer.
+	ActivityTypeGroupDecisionVoted
+	// ActivityTypeGroupDecisionEscalated tracks when reviewers disagree on a group decision.
+	ActivityTypeGroupDecisionEscalated
+	// ActivityTypeUserDecisionReverted tracks when a decision on a user is undone or reverted.
+	ActivityTypeUserDecisionReverted
+	// ActivityTypeGroupDecisionReverted tracks when a decision on a group is undone or reverted.
+	ActivityTypeGroupDecisionReverted
 )
+
+// DecisionActivityTypes lists the activities that record a reviewer deciding on a user or group.
+var DecisionActivityTypes = []ActivityType{
+	ActivityTypeUserConfirmed,
+	ActivityTypeUserCleared,
+	ActivityTypeGroupConfirmed,
+	ActivityTypeGroupConfirmedCustom,
+	ActivityTypeGroupMixed,
+	ActivityTypeUserDecisionVoted,
+	ActivityTypeUserDecisionEscalated,
+	ActivityTypeGroupDecisionVoted,
+	ActivityTypeGroupDecisionEscalated,
+}
+
+// TrainingActivityTypes lists the activities that record a reviewer in training mode moving
+// on to a new user or group, as training mode does not allow decisions.
+var TrainingActivityTypes = []ActivityType{
+	ActivityTypeUserViewed,
+	ActivityTypeGroupViewed,
+}
@@ -7,11 +7,11 @@ import (
 	"strings"
 )
 
-const _ActivityTypeName = "AllUserViewedUserLookupUserConfirmedUserClearedUserSkippedUserQueuedRemoved1Removed2UserDeletedGroupViewedGroupLookupGroupConfirmedGroupConfirmedCustomGroupMixedGroupSkippedRemoved3Removed4GroupDeletedUserLookupDiscordAppealSubmittedAppealClaimedAppealAcceptedAppealRejectedAppealClosedAppealReopenedUserDataDeletedUserBlacklistedDiscordUserBannedDiscordUserUnbannedBotSettingUpdatedGuildBansGroupQueued"
+const _ActivityTypeName = "AllUserViewedUserLookupUserConfirmedUserClearedUserSkippedUserQueuedRemoved1Removed2UserDeletedGroupViewedGroupLookupGroupConfirmedGroupConfirmedCustomGroupMixedGroupSkippedRemoved3Removed4GroupDeletedUserLookupDiscordAppealSubmittedAppealClaimedAppealAcceptedAppealRejectedAppealClosedAppealReopenedUserDataDeletedUserBlacklistedDiscordUserBannedDiscordUserUnbannedBotSettingUpdatedGuildBansGroupQueuedUserDecisionVotedUserDecisionEscalatedGroupDecisionVotedGroupDecisionEscalatedUserDecisionRevertedGroupDecisionReverted"
 
-var _ActivityTypeIndex = [...]uint16{0, 3, 13, 23, 36, 47, 58, 68, 76, 84, 95, 106, 117, 131, 151, 161, 173, 181, 189, 201, 218, 233, 246, 260, 274, 286, 300, 315, 330, 347, 366, 383, 392, 403}
+var _ActivityTypeIndex = [...]uint16{0, 3, 13, 23, 36, 47, 58, 68, 76, 84, 95, 106, 117, 131, 151, 161, 173, 181, 189, 201, 218, 233, 246, 260, 274, 286, 300, 315, 330, 347, 366, 383, 392, 403, 420, 441, 459, 481, 501, 522}
 
-const _ActivityTypeLowerName = "alluservieweduserlookupuserconfirmedusercleareduserskippeduserqueuedremoved1removed2userdeletedgroupviewedgrouplookupgroupconfirmedgroupconfirmedcustomgroupmixedgroupskippedremoved3removed4groupdeleteduserlookupdiscordappealsubmittedappealclaimedappealacceptedappealrejectedappealclosedappealreopeneduserdatadeleteduserblacklisteddiscorduserbanneddiscorduserunbannedbotsettingupdatedguildbansgroupqueued"
+const _ActivityTypeLowerName = "alluservieweduserlookupuserconfirmedusercleareduserskippeduserqueuedremoved1removed2userdeletedgroupviewedgrouplookupgroupconfirmedgroupconfirmedcustomgroupmixedgroupskippedremoved3removed4groupdeleteduserlookupdiscordappealsubmittedappealclaimedappealacceptedappealrejectedappealclosedappealreopeneduserdatadeleteduserblacklisteddiscorduserbanneddiscorduserunbannedbotsettingupdatedguildbansgroupqueueduserdecisionvoteduserdecisionescalatedgroupdecisionvotedgroupdecisionescalateduserdecisionrevertedgroupdecisionreverted"
 
 func (i ActivityType) String() string {
 	if i < 0 || i >= ActivityType(len(_ActivityTypeIndex)-1) {
@@ -57,9 +57,15 @@ func _ActivityTypeNoOp() {
 	_ = x[ActivityTypeBotSettingUpdated-(30)]
 	_ = x[ActivityTypeGuildBans-(31)]
 	_ = x[ActivityTypeGroupQueued-(32)]
+	_ = x[ActivityTypeUserDecisionVoted-(33)]
+	_ = x[ActivityTypeUserDecisionEscalated-(34)]
+	_ = x[ActivityTypeGroupDecisionVoted-(35)]
+	_ = x[ActivityTypeGroupDecisionEscalated-(36)]
+	_ = x[ActivityTypeUserDecisionReverted-(37)]
+	_ = x[ActivityTypeGroupDecisionReverted-(38)]
 }
 
-var _ActivityTypeValues = []ActivityType{ActivityTypeAll, ActivityTypeUserViewed, ActivityTypeUserLookup, ActivityTypeUserConfirmed, ActivityTypeUserCleared, ActivityTypeUserSkipped, ActivityTypeUserQueued, ActivityTypeRemoved1, ActivityTypeRemoved2, ActivityTypeUserDeleted, ActivityTypeGroupViewed, ActivityTypeGroupLookup, ActivityTypeGroupConfirmed, ActivityTypeGroupConfirmedCustom, ActivityTypeGroupMixed, ActivityTypeGroupSkipped, ActivityTypeRemoved3, ActivityTypeRemoved4, ActivityTypeGroupDeleted, ActivityTypeUserLookupDiscord, ActivityTypeAppealSubmitted, ActivityTypeAppealClaimed, ActivityTypeAppealAccepted, ActivityTypeAppealRejected, ActivityTypeAppealClosed, ActivityTypeAppealReopened, ActivityTypeUserDataDeleted, ActivityTypeUserBlacklisted, ActivityTypeDiscordUserBanned, ActivityTypeDiscordUserUnbanned, ActivityTypeBotSettingUpdated, ActivityTypeGuildBans, ActivityTypeGroupQueued}
+var _ActivityTypeValues = []ActivityType{ActivityTypeAll, ActivityTypeUserViewed, ActivityTypeUserLookup, ActivityTypeUserConfirmed, ActivityTypeUserCleared, ActivityTypeUserSkipped, ActivityTypeUserQueued, ActivityTypeRemoved1, ActivityTypeRemoved2, ActivityTypeUserDeleted, ActivityTypeGroupViewed, ActivityTypeGroupLookup, ActivityTypeGroupConfirmed, ActivityTypeGroupConfirmedCustom, ActivityTypeGroupMixed, ActivityTypeGroupSkipped, ActivityTypeRemoved3, ActivityTypeRemoved4, ActivityTypeGroupDeleted, ActivityTypeUserLookupDiscord, ActivityTypeAppealSubmitted, ActivityTypeAppealClaimed, ActivityTypeAppealAccepted, ActivityTypeAppealRejected, ActivityTypeAppealClosed, ActivityTypeAppealReopened, ActivityTypeUserDataDeleted, ActivityTypeUserBlacklisted, ActivityTypeDiscordUserBanned, ActivityTypeDiscordUserUnbanned, ActivityTypeBotSettingUpdated, ActivityTypeGuildBans, ActivityTypeGroupQueued, ActivityTypeUserDecisionVoted, ActivityTypeUserDecisionEscalated, ActivityTypeGroupDecisionVoted, ActivityTypeGroupDecisionEscalated, ActivityTypeUserDecisionReverted, ActivityTypeGroupDecisionReverted}
 
 var _ActivityTypeNameToValueMap = map[string]ActivityType{
 	_ActivityTypeName[0:3]:          ActivityTypeAll,
@@ -128,6 +134,18 @@ var _ActivityTypeNameToValueMap = map[string]ActivityType{
 	_ActivityTypeLowerName[383:392]: ActivityTypeGuildBans,
 	_ActivityTypeName[392:403]:      ActivityTypeGroupQueued,
 	_ActivityTypeLowerName[392:403]: ActivityTypeGroupQueued,
+	_ActivityTypeName[403:420]:      ActivityTypeUserDecisionVoted,
+	_ActivityTypeLowerName[403:420]: ActivityTypeUserDecisionVoted,
+	_ActivityTypeName[420:441]:      ActivityTypeUserDecisionEscalated,
+	_ActivityTypeLowerName[420:441]: ActivityTypeUserDecisionEscalated,
+	_ActivityTypeName[441:459]:      ActivityTypeGroupDecisionVoted,
+	_ActivityTypeLowerName[441:459]: ActivityTypeGroupDecisionVoted,
+	_ActivityTypeName[459:481]:      ActivityTypeGroupDecisionEscalated,
+	_ActivityTypeLowerName[459:481]: ActivityTypeGroupDecisionEscalated,
+	_ActivityTypeName[481:501]:      ActivityTypeUserDecisionReverted,
+	_ActivityTypeLowerName[481:501]: ActivityTypeUserDecisionReverted,
+	_ActivityTypeName[501:522]:      ActivityTypeGroupDecisionReverted,
+	_ActivityTypeLowerName[501:522]: ActivityTypeGroupDecisionReverted,
 }
 
 var _ActivityTypeNames = []string{
@@ -164,6 +182,12 @@ var _ActivityTypeNames = []string{
 	_ActivityTypeName[366:383],
 	_ActivityTypeName[383:392],
 	_ActivityTypeName[392:403],
+	_ActivityTypeName[403:420],
+	_ActivityTypeName[420:441],
+	_ActivityTypeName[441:459],
+	_ActivityTypeName[459:481],
+	_ActivityTypeName[481:501],
+	_ActivityTypeName[501:522],
 }
 
 // ActivityTypeString retrieves an enum value from the enum constants string name.
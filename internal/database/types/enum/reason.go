@@ -31,7 +31,8 @@ const (
 // IsAutoAnalyzedReason returns true if the reason type is automatically analyzed by the system.
 func IsAutoAnalyzedReason(reasonType UserReasonType) bool {
 	switch reasonType {
-	case UserReasonTypeProfile, UserReasonTypeFriend, UserReasonTypeOutfit, UserReasonTypeGroup, UserReasonTypeCondo:
+	case UserReasonTypeProfile, UserReasonTypeFriend, UserReasonTypeOutfit, UserReasonTypeGroup, UserReasonTypeCondo,
+		UserReasonTypeFavorites, UserReasonTypeBadges, UserReasonTypeCreations:
 		return true
 	default:
 		return false
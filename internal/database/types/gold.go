@@ -0,0 +1,47 @@
+package types
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrGoldItemNotFound = errors.New("gold item not found")
+
+// GoldItem is a user or group with a verdict known by admins. Gold items are
+// mixed into review queues to measure how often reviewers reach the same verdict.
+type GoldItem struct {
+	ID             int64              `bun:",pk,autoincrement" json:"id"`
+	TargetType     DecisionTargetType `bun:",notnull"          json:"targetType"`
+	TargetID       int64              `bun:",notnull"          json:"targetId"`
+	ExpectedAction DecisionAction     `bun:",notnull"          json:"expectedAction"`
+	CreatedBy      uint64             `bun:",notnull"          json:"createdBy"`
+	CreatedAt      time.Time          `bun:",notnull"          json:"createdAt"`
+}
+
+// GoldResult records the answer of a reviewer on a gold item.
+type GoldResult struct {
+	ID              int64              `bun:",pk,autoincrement" json:"id"`
+	GoldItemID      int64              `bun:",notnull"          json:"goldItemId"`
+	ReviewerID      uint64             `bun:",notnull"          json:"reviewerId"`
+	TargetType      DecisionTargetType `bun:",notnull"          json:"targetType"`
+	TargetID        int64              `bun:",notnull"          json:"targetId"`
+	SubmittedAction DecisionAction     `bun:",notnull"          json:"submittedAction"`
+	Correct         bool               `bun:",notnull"          json:"correct"`
+	CreatedAt       time.Time          `bun:",notnull"          json:"createdAt"`
+}
+
+// ReviewerAccuracy summarizes the gold item answers of a reviewer.
+type ReviewerAccuracy struct {
+	ReviewerID uint64 `json:"reviewerId"`
+	Answered   int64  `json:"answered"`
+	Correct    int64  `json:"correct"`
+}
+
+// Rate returns the fraction of gold items the reviewer answered correctly.
+func (a *ReviewerAccuracy) Rate() float64 {
+	if a.Answered == 0 {
+		return 0
+	}
+
+	return float64(a.Correct) / float64(a.Answered)
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+var (
+	ErrNoJobsAvailable = errors.New("no jobs available")
+	ErrJobLeaseLost    = errors.New("job lease lost")
+)
+
+// JobType identifies the kind of work a job represents.
+type JobType string
+
+const (
+	// JobTypeFriendScan scans the friends of a confirmed user.
+	JobTypeFriendScan JobType = "friend_scan"
+	// JobTypeGroupScan scans the members of a group starting from a cursor.
+	JobTypeGroupScan JobType = "group_scan"
+)
+
+// JobStatus represents the lifecycle state of a job.
+type JobStatus string
+
+const (
+	// JobStatusPending means the job is waiting to be leased.
+	JobStatusPending JobStatus = "pending"
+	// JobStatusLeased means a worker currently holds the job.
+	JobStatusLeased JobStatus = "leased"
+	// JobStatusCompleted means the job finished successfully.
+	JobStatusCompleted JobStatus = "completed"
+	// JobStatusDead means the job ran out of attempts and was dead-lettered.
+	JobStatusDead JobStatus = "dead"
+)
+
+// Job represents a unit of work in the durable job queue.
+type Job struct {
+	ID             int64           `bun:",pk,autoincrement"  json:"id"`
+	Type           JobType         `bun:",notnull"           json:"type"`
+	Payload        json.RawMessage `bun:"type:jsonb,notnull" json:"payload"`
+	IdempotencyKey string          `bun:",notnull"           json:"idempotencyKey"`
+	Status         JobStatus       `bun:",notnull"           json:"status"`
+	Attempts       int             `bun:",notnull"           json:"attempts"`
+	MaxAttempts    int             `bun:",notnull"           json:"maxAttempts"`
+	LeaseOwner     string          `bun:",notnull"           json:"leaseOwner"`
+	LeaseExpiresAt time.Time       `bun:",nullzero"          json:"leaseExpiresAt"`
+	AvailableAt    time.Time       `bun:",notnull"           json:"availableAt"`
+	LastError      string          `bun:",notnull"           json:"lastError"`
+	CreatedAt      time.Time       `bun:",notnull"           json:"createdAt"`
+	UpdatedAt      time.Time       `bun:",notnull"           json:"updatedAt"`
+}
+
+// FriendScanPayload is the payload of a friend scan job.
+type FriendScanPayload struct {
+	UserID int64 `json:"userId"`
+}
+
+// GroupScanPayload is the payload of a group scan job.
+type GroupScanPayload struct {
+	GroupID int64  `json:"groupId"`
+	Cursor  string `json:"cursor"`
+}
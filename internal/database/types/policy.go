@@ -0,0 +1,206 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidPolicyField = errors.New("invalid review policy field")
+	ErrInvalidPolicyValue = errors.New("invalid review policy value")
+)
+
+// ReviewerRole identifies which review policy applies to a Discord user.
+type ReviewerRole string
+
+const (
+	// ReviewerRoleTrainee applies to users who are neither reviewers nor admins.
+	ReviewerRoleTrainee ReviewerRole = "trainee"
+	// ReviewerRoleReviewer applies to official reviewers.
+	ReviewerRoleReviewer ReviewerRole = "reviewer"
+	// ReviewerRoleAdmin applies to admins.
+	ReviewerRoleAdmin ReviewerRole = "admin"
+)
+
+// ReviewerRoles lists every reviewer role in display order.
+var ReviewerRoles = []ReviewerRole{ReviewerRoleTrainee, ReviewerRoleReviewer, ReviewerRoleAdmin}
+
+// ReviewPolicy controls when a reviewer is sent on a break or asked to solve a CAPTCHA.
+// A zero count or duration disables the rule it belongs to. In training mode, where no
+// decisions are made, moving on to the next user or group counts as a decision.
+type ReviewPolicy struct {
+	MaxReviews          int           `json:"maxReviews"`          // Reviews allowed per window before a break
+	Window              time.Duration `json:"window"`              // Window the reviews are counted in
+	BreakDuration       time.Duration `json:"breakDuration"`       // Length of a break
+	FastReviewThreshold time.Duration `json:"fastReviewThreshold"` // Decisions quicker than this count as fast
+	MaxFastStreak       int           `json:"maxFastStreak"`       // Consecutive fast decisions before a break
+	CaptchaInterval     int           `json:"captchaInterval"`     // Reviews between CAPTCHA verifications
+}
+
+// ReviewPolicies stores the configured review policy of each role.
+type ReviewPolicies map[ReviewerRole]ReviewPolicy
+
+// DefaultReviewPolicy returns the policy used when none is configured for a role.
+func DefaultReviewPolicy(role ReviewerRole) ReviewPolicy {
+	policy := ReviewPolicy{
+		MaxReviews:          40,
+		Window:              time.Hour,
+		BreakDuration:       10 * time.Minute,
+		FastReviewThreshold: 4 * time.Second,
+		MaxFastStreak:       15,
+	}
+
+	switch role {
+	case ReviewerRoleTrainee:
+		policy.CaptchaInterval = 10
+	case ReviewerRoleAdmin:
+		policy.FastReviewThreshold = 0
+		policy.MaxFastStreak = 0
+	case ReviewerRoleReviewer:
+	}
+
+	return policy
+}
+
+// ParseReviewPolicy parses space-separated key=value pairs on top of a base policy.
+// Keys are reviews, window, break, fast, streak and captcha.
+func ParseReviewPolicy(text string, base ReviewPolicy) (ReviewPolicy, error) {
+	policy := base
+
+	for field := range strings.FieldsSeq(text) {
+		key, value, found := strings.Cut(field, "=")
+		if !found {
+			return base, fmt.Errorf("%w: %q", ErrInvalidPolicyField, field)
+		}
+
+		var err error
+
+		switch strings.ToLower(key) {
+		case "reviews":
+			policy.MaxReviews, err = parsePolicyCount(value)
+		case "window":
+			policy.Window, err = parsePolicyDuration(value)
+		case "break":
+			policy.BreakDuration, err = parsePolicyDuration(value)
+		case "fast":
+			policy.FastReviewThreshold, err = parsePolicyDuration(value)
+		case "streak":
+			policy.MaxFastStreak, err = parsePolicyCount(value)
+		case "captcha":
+			policy.CaptchaInterval, err = parsePolicyCount(value)
+		default:
+			return base, fmt.Errorf("%w: %q", ErrInvalidPolicyField, key)
+		}
+
+		if err != nil {
+			return base, fmt.Errorf("%w for %s: %w", ErrInvalidPolicyValue, key, err)
+		}
+	}
+
+	if (policy.MaxReviews > 0 || policy.MaxFastStreak > 0) && policy.BreakDuration <= 0 {
+		return base, fmt.Errorf("%w: break must be set when breaks are enabled", ErrInvalidPolicyValue)
+	}
+
+	if policy.MaxReviews > 0 && policy.Window <= 0 {
+		return base, fmt.Errorf("%w: window must be set when reviews is enabled", ErrInvalidPolicyValue)
+	}
+
+	return policy, nil
+}
+
+// String formats the policy in the format accepted by ParseReviewPolicy.
+func (p ReviewPolicy) String() string {
+	return fmt.Sprintf("reviews=%d window=%s break=%s fast=%s streak=%d captcha=%d",
+		p.MaxReviews,
+		formatPolicyDuration(p.Window),
+		formatPolicyDuration(p.BreakDuration),
+		formatPolicyDuration(p.FastReviewThreshold),
+		p.MaxFastStreak,
+		p.CaptchaInterval,
+	)
+}
+
+// ReviewerFatigue summarizes how quickly a reviewer made decisions over a period.
+// Only the time between consecutive decisions of the same sitting is counted.
+type ReviewerFatigue struct {
+	ReviewerID    uint64  `json:"reviewerId"`
+	Reviews       int64   `json:"reviews"`
+	AvgSeconds    float64 `json:"avgSeconds"`
+	MedianSeconds float64 `json:"medianSeconds"`
+	FastReviews   int64   `json:"fastReviews"`
+}
+
+// FastRate returns the fraction of timed decisions that were faster than the policy threshold.
+func (f *ReviewerFatigue) FastRate() float64 {
+	if f.Reviews == 0 {
+		return 0
+	}
+
+	return float64(f.FastReviews) / float64(f.Reviews)
+}
+
+// CountFastStreak counts the consecutive decisions that were each made within the
+// threshold of the previous one, starting from the most recent decision.
+// Timestamps must be ordered from newest to oldest.
+func CountFastStreak(timestamps []time.Time, threshold time.Duration) int {
+	if threshold <= 0 {
+		return 0
+	}
+
+	streak := 0
+
+	for i := 0; i+1 < len(timestamps); i++ {
+		if timestamps[i].Sub(timestamps[i+1]) >= threshold {
+			break
+		}
+
+		streak++
+	}
+
+	return streak
+}
+
+// parsePolicyCount parses a non-negative count.
+func parsePolicyCount(value string) (int, error) {
+	count, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if count < 0 {
+		return 0, fmt.Errorf("%d is negative", count)
+	}
+
+	return count, nil
+}
+
+// parsePolicyDuration parses a non-negative duration such as 90s or 1h30m.
+func parsePolicyDuration(value string) (time.Duration, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if duration < 0 {
+		return 0, fmt.Errorf("%s is negative", duration)
+	}
+
+	return duration, nil
+}
+
+// formatPolicyDuration formats a duration without trailing zero units.
+func formatPolicyDuration(d time.Duration) string {
+	text := d.String()
+	if strings.HasSuffix(text, "m0s") {
+		text = strings.TrimSuffix(text, "0s")
+	}
+
+	if strings.HasSuffix(text, "h0m") {
+		text = strings.TrimSuffix(text, "0m")
+	}
+
+	return text
+}
@@ -0,0 +1,156 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/database/types"
+)
+
+func TestParseReviewPolicy(t *testing.T) {
+	t.Parallel()
+
+	base := types.DefaultReviewPolicy(types.ReviewerRoleReviewer)
+
+	tests := []struct {
+		name    string
+		input   string
+		want    types.ReviewPolicy
+		wantErr error
+	}{
+		{
+			name:  "empty input keeps base",
+			input: "",
+			want:  base,
+		},
+		{
+			name:  "partial update",
+			input: "reviews=60 Fast=2s",
+			want: types.ReviewPolicy{
+				MaxReviews:          60,
+				Window:              base.Window,
+				BreakDuration:       base.BreakDuration,
+				FastReviewThreshold: 2 * time.Second,
+				MaxFastStreak:       base.MaxFastStreak,
+			},
+		},
+		{
+			name:  "disable everything",
+			input: "reviews=0 window=0s break=0s fast=0s streak=0 captcha=0",
+			want:  types.ReviewPolicy{},
+		},
+		{
+			name:    "unknown field",
+			input:   "sleep=8h",
+			wantErr: types.ErrInvalidPolicyField,
+		},
+		{
+			name:    "missing separator",
+			input:   "reviews",
+			wantErr: types.ErrInvalidPolicyField,
+		},
+		{
+			name:    "negative count",
+			input:   "streak=-1",
+			wantErr: types.ErrInvalidPolicyValue,
+		},
+		{
+			name:    "breaks without duration",
+			input:   "break=0s",
+			wantErr: types.ErrInvalidPolicyValue,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy, err := types.ParseReviewPolicy(tt.input, base)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Expected %v, got %v", tt.wantErr, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if policy != tt.want {
+				t.Errorf("Expected %+v, got %+v", tt.want, policy)
+			}
+		})
+	}
+}
+
+func TestReviewPolicyString(t *testing.T) {
+	t.Parallel()
+
+	policy := types.DefaultReviewPolicy(types.ReviewerRoleTrainee)
+
+	formatted := policy.String()
+	if formatted != "reviews=40 window=1h break=10m fast=4s streak=15 captcha=10" {
+		t.Errorf("Unexpected format %q", formatted)
+	}
+
+	// Formatted policies must parse back into the same policy
+	parsed, err := types.ParseReviewPolicy(formatted, types.ReviewPolicy{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if parsed != policy {
+		t.Errorf("Expected %+v, got %+v", policy, parsed)
+	}
+}
+
+func TestBotSettingReviewPolicy(t *testing.T) {
+	t.Parallel()
+
+	custom := types.ReviewPolicy{MaxReviews: 10, Window: time.Hour, BreakDuration: time.Minute}
+	settings := &types.BotSetting{
+		ReviewerIDs:    []uint64{1},
+		AdminIDs:       []uint64{2},
+		ReviewPolicies: types.ReviewPolicies{types.ReviewerRoleReviewer: custom},
+	}
+
+	if role := settings.RoleOf(3); role != types.ReviewerRoleTrainee {
+		t.Errorf("Expected trainee role, got %s", role)
+	}
+
+	if policy := settings.ReviewPolicy(1); policy != custom {
+		t.Errorf("Expected configured policy, got %+v", policy)
+	}
+
+	if policy := settings.ReviewPolicy(2); policy != types.DefaultReviewPolicy(types.ReviewerRoleAdmin) {
+		t.Errorf("Expected default admin policy, got %+v", policy)
+	}
+}
+
+func TestCountFastStreak(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	timestamps := []time.Time{
+		now,
+		now.Add(-2 * time.Second),
+		now.Add(-4 * time.Second),
+		now.Add(-30 * time.Second),
+		now.Add(-31 * time.Second),
+	}
+
+	if streak := types.CountFastStreak(timestamps, 3*time.Second); streak != 2 {
+		t.Errorf("Expected streak of 2, got %d", streak)
+	}
+
+	if streak := types.CountFastStreak(timestamps, 0); streak != 0 {
+		t.Errorf("Expected no streak with a disabled threshold, got %d", streak)
+	}
+
+	if streak := types.CountFastStreak(timestamps[:1], time.Minute); streak != 0 {
+		t.Errorf("Expected no streak with a single decision, got %d", streak)
+	}
+}
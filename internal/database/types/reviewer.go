@@ -1,6 +1,17 @@
 package types
 
-import "time"
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robalyx/rotector/internal/database/types/enum"
+)
+
+// ErrInvalidFilterRange indicates that the range filters of a preset could not be parsed.
+var ErrInvalidFilterRange = errors.New("invalid filter range")
 
 // ReviewerStats represents statistics for a reviewer's activity.
 type ReviewerStats struct {
@@ -24,3 +35,198 @@ type ReviewerInfo struct {
 	DisplayName string    `bun:",notnull"`
 	UpdatedAt   time.Time `bun:",notnull"`
 }
+
+// ReviewerAssignment assigns a user category to a reviewer. Flagged users in an
+// assigned category are served to the assigned reviewers first.
+type ReviewerAssignment struct {
+	ReviewerID uint64                `bun:",pk"      json:"reviewerId"`
+	Category   enum.UserCategoryType `bun:",pk"      json:"category"`
+	AssignedBy uint64                `bun:",notnull" json:"assignedBy"`
+	AssignedAt time.Time             `bun:",notnull" json:"assignedAt"`
+}
+
+// ReviewerClusterAssignment assigns a cluster of users, the members of a group, to a
+// reviewer. Flagged members of an assigned group are served to the assigned reviewers first.
+type ReviewerClusterAssignment struct {
+	ReviewerID uint64    `bun:",pk"      json:"reviewerId"`
+	GroupID    int64     `bun:",pk"      json:"groupId"`
+	AssignedBy uint64    `bun:",notnull" json:"assignedBy"`
+	AssignedAt time.Time `bun:",notnull" json:"assignedAt"`
+}
+
+// ReviewQueueFilter narrows down the targets a review queue fetch may return.
+type ReviewQueueFilter struct {
+	ExcludeIDs       []int64                 // Targets recently reviewed or claimed by other reviewers
+	OnlyIDs          []int64                 // Limits the fetch to these targets such as gold items
+	Categories       []enum.UserCategoryType // Limits users to these categories
+	GroupIDs         []int64                 // Limits users to members of these groups
+	MinConfidence    float64                 // Zero means no lower bound
+	MaxConfidence    float64                 // Zero means no upper bound
+	UserReasonTypes  []enum.UserReasonType   // Limits users to those with any of these reasons
+	GroupReasonTypes []enum.GroupReasonType  // Limits groups to those with any of these reasons
+	CreatedAfter     time.Time               // Limits users to accounts created after this time
+	CreatedBefore    time.Time               // Limits users to accounts created before this time
+	HasSocials       *bool                   // Limits users by whether they have social links
+	EngineVersion    string                  // Limits users to this engine version
+	TouchedBy        uint64                  // Limits targets to those with activity by this reviewer
+	VoterID          uint64                  // Skips targets with an open decision this reviewer voted on
+	SkipEscalated    bool                    // Skips targets whose decision awaits an admin
+	OnlyEscalated    bool                    // Limits targets to those whose decision awaits an admin
+}
+
+// ReviewFilterPreset is a saved set of review queue filters. Filters that only exist
+// for users such as categories and account age are ignored by the group queue.
+type ReviewFilterPreset struct {
+	Name              string                  `json:"name"`
+	Categories        []enum.UserCategoryType `json:"categories,omitempty"`
+	UserReasonTypes   []enum.UserReasonType   `json:"userReasonTypes,omitempty"`
+	GroupReasonTypes  []enum.GroupReasonType  `json:"groupReasonTypes,omitempty"`
+	MinConfidence     float64                 `json:"minConfidence,omitempty"`
+	MaxConfidence     float64                 `json:"maxConfidence,omitempty"`
+	MinAccountAgeDays int                     `json:"minAccountAgeDays,omitempty"`
+	MaxAccountAgeDays int                     `json:"maxAccountAgeDays,omitempty"`
+	HasSocials        *bool                   `json:"hasSocials,omitempty"`
+	EngineVersion     string                  `json:"engineVersion,omitempty"`
+	TouchedByMe       bool                    `json:"touchedByMe,omitempty"`
+}
+
+// ApplyTo narrows a review queue filter with the preset. A nil preset leaves the filter unchanged.
+func (p *ReviewFilterPreset) ApplyTo(filter *ReviewQueueFilter, reviewerID uint64, now time.Time) {
+	if p == nil {
+		return
+	}
+
+	filter.Categories = p.Categories
+	filter.UserReasonTypes = p.UserReasonTypes
+	filter.GroupReasonTypes = p.GroupReasonTypes
+	filter.MinConfidence = p.MinConfidence
+	filter.MaxConfidence = p.MaxConfidence
+	filter.HasSocials = p.HasSocials
+	filter.EngineVersion = p.EngineVersion
+
+	// Older accounts were created further in the past
+	if p.MinAccountAgeDays > 0 {
+		filter.CreatedBefore = now.AddDate(0, 0, -p.MinAccountAgeDays)
+	}
+
+	if p.MaxAccountAgeDays > 0 {
+		filter.CreatedAfter = now.AddDate(0, 0, -p.MaxAccountAgeDays)
+	}
+
+	if p.TouchedByMe {
+		filter.TouchedBy = reviewerID
+	}
+}
+
+// ParseRanges reads the range filters of the preset from text such as
+// "confidence=0.7-1 age=0-30 engine=2.1.0". Either side of a range may be left empty.
+func (p *ReviewFilterPreset) ParseRanges(input string) error {
+	for field := range strings.FieldsSeq(strings.ToLower(input)) {
+		key, value, found := strings.Cut(field, "=")
+		if !found || value == "" {
+			return fmt.Errorf("%w: %s", ErrInvalidFilterRange, field)
+		}
+
+		switch key {
+		case "confidence":
+			minValue, maxValue, err := parseRange(value, func(s string) (float64, error) {
+				return strconv.ParseFloat(s, 64)
+			})
+			if err != nil || minValue < 0 || maxValue > 1 {
+				return fmt.Errorf("%w: %s", ErrInvalidFilterRange, field)
+			}
+
+			p.MinConfidence, p.MaxConfidence = minValue, maxValue
+		case "age":
+			minValue, maxValue, err := parseRange(value, strconv.Atoi)
+			if err != nil || minValue < 0 {
+				return fmt.Errorf("%w: %s", ErrInvalidFilterRange, field)
+			}
+
+			p.MinAccountAgeDays, p.MaxAccountAgeDays = minValue, maxValue
+		case "engine":
+			p.EngineVersion = value
+		default:
+			return fmt.Errorf("%w: unknown filter %q", ErrInvalidFilterRange, key)
+		}
+	}
+
+	return nil
+}
+
+// FormatRanges formats the range filters of the preset in the form read by ParseRanges.
+func (p *ReviewFilterPreset) FormatRanges() string {
+	var parts []string
+
+	if p.MinConfidence > 0 || p.MaxConfidence > 0 {
+		parts = append(parts, "confidence="+formatRange(p.MinConfidence, p.MaxConfidence, func(v float64) string {
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		}))
+	}
+
+	if p.MinAccountAgeDays > 0 || p.MaxAccountAgeDays > 0 {
+		parts = append(parts, "age="+formatRange(p.MinAccountAgeDays, p.MaxAccountAgeDays, strconv.Itoa))
+	}
+
+	if p.EngineVersion != "" {
+		parts = append(parts, "engine="+p.EngineVersion)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// parseRange parses a "min-max" range where either side may be empty.
+func parseRange[T int | float64](value string, parse func(string) (T, error)) (T, T, error) {
+	var minValue, maxValue T
+
+	rawMin, rawMax, found := strings.Cut(value, "-")
+	if !found {
+		return minValue, maxValue, ErrInvalidFilterRange
+	}
+
+	var err error
+
+	if rawMin != "" {
+		if minValue, err = parse(rawMin); err != nil {
+			return minValue, maxValue, err
+		}
+	}
+
+	if rawMax != "" {
+		if maxValue, err = parse(rawMax); err != nil {
+			return minValue, maxValue, err
+		}
+
+		if maxValue < minValue {
+			return minValue, maxValue, ErrInvalidFilterRange
+		}
+	}
+
+	return minValue, maxValue, nil
+}
+
+// formatRange formats a range where zero values are left empty.
+func formatRange[T int | float64](minValue, maxValue T, format func(T) string) string {
+	var rawMin, rawMax string
+
+	if minValue > 0 {
+		rawMin = format(minValue)
+	}
+
+	if maxValue > 0 {
+		rawMax = format(maxValue)
+	}
+
+	return rawMin + "-" + rawMax
+}
+
+// ReviewerWorkload summarizes the assignments and active claims of a reviewer.
+type ReviewerWorkload struct {
+	ReviewerID     uint64
+	Username       string
+	Categories     []enum.UserCategoryType
+	Clusters       []int64 // Groups whose members are assigned to the reviewer
+	PendingUsers   int     // Flagged users in the assigned categories
+	ClaimedUserID  int64   // Zero if the reviewer holds no user claim
+	ClaimedGroupID int64   // Zero if the reviewer holds no group claim
+}
@@ -0,0 +1,154 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/database/types"
+)
+
+func TestReviewFilterPresetParseRanges(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		input   string
+		want    types.ReviewFilterPreset
+		wantErr bool
+	}{
+		{
+			name:  "empty input",
+			input: "",
+			want:  types.ReviewFilterPreset{},
+		},
+		{
+			name:  "all filters",
+			input: "confidence=0.7-1 age=0-30 engine=2.1.0",
+			want: types.ReviewFilterPreset{
+				MinConfidence:     0.7,
+				MaxConfidence:     1,
+				MaxAccountAgeDays: 30,
+				EngineVersion:     "2.1.0",
+			},
+		},
+		{
+			name:  "open ranges",
+			input: "Confidence=0.5- age=-7",
+			want: types.ReviewFilterPreset{
+				MinConfidence:     0.5,
+				MaxAccountAgeDays: 7,
+			},
+		},
+		{
+			name:    "confidence above one",
+			input:   "confidence=0.5-2",
+			wantErr: true,
+		},
+		{
+			name:    "reversed range",
+			input:   "age=30-7",
+			wantErr: true,
+		},
+		{
+			name:    "missing separator",
+			input:   "age=30",
+			wantErr: true,
+		},
+		{
+			name:    "unknown filter",
+			input:   "friends=1-2",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var preset types.ReviewFilterPreset
+
+			err := preset.ParseRanges(tt.input)
+			if tt.wantErr {
+				if !errors.Is(err, types.ErrInvalidFilterRange) {
+					t.Errorf("Expected ErrInvalidFilterRange, got %v", err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if preset.MinConfidence != tt.want.MinConfidence || preset.MaxConfidence != tt.want.MaxConfidence ||
+				preset.MinAccountAgeDays != tt.want.MinAccountAgeDays ||
+				preset.MaxAccountAgeDays != tt.want.MaxAccountAgeDays ||
+				preset.EngineVersion != tt.want.EngineVersion {
+				t.Errorf("Expected %+v, got %+v", tt.want, preset)
+			}
+		})
+	}
+}
+
+func TestReviewFilterPresetFormatRanges(t *testing.T) {
+	t.Parallel()
+
+	preset := types.ReviewFilterPreset{
+		MinConfidence:     0.75,
+		MaxAccountAgeDays: 30,
+		EngineVersion:     "2.1.0",
+	}
+
+	formatted := preset.FormatRanges()
+	if formatted != "confidence=0.75- age=-30 engine=2.1.0" {
+		t.Errorf("Unexpected format %q", formatted)
+	}
+
+	// Formatted ranges must parse back into the same preset
+	var parsed types.ReviewFilterPreset
+	if err := parsed.ParseRanges(formatted); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if parsed.MinConfidence != preset.MinConfidence || parsed.MaxAccountAgeDays != preset.MaxAccountAgeDays ||
+		parsed.EngineVersion != preset.EngineVersion {
+		t.Errorf("Expected %+v, got %+v", preset, parsed)
+	}
+}
+
+func TestReviewFilterPresetApplyTo(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	preset := &types.ReviewFilterPreset{
+		MinAccountAgeDays: 7,
+		MaxAccountAgeDays: 30,
+		TouchedByMe:       true,
+	}
+
+	filter := &types.ReviewQueueFilter{}
+	preset.ApplyTo(filter, 42, now)
+
+	if !filter.CreatedBefore.Equal(now.AddDate(0, 0, -7)) {
+		t.Errorf("Unexpected created before %v", filter.CreatedBefore)
+	}
+
+	if !filter.CreatedAfter.Equal(now.AddDate(0, 0, -30)) {
+		t.Errorf("Unexpected created after %v", filter.CreatedAfter)
+	}
+
+	if filter.TouchedBy != 42 {
+		t.Errorf("Expected touched by 42, got %d", filter.TouchedBy)
+	}
+
+	// A nil preset leaves the filter unchanged
+	var empty *types.ReviewFilterPreset
+
+	unchanged := &types.ReviewQueueFilter{}
+	empty.ApplyTo(unchanged, 42, now)
+
+	if unchanged.TouchedBy != 0 {
+		t.Errorf("Expected nil preset to leave the filter unchanged")
+	}
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	ErrRuleNotFound     = errors.New("detection rule not found")
+	ErrRuleNotPending   = errors.New("detection rule is no longer pending")
+	ErrRuleExists       = errors.New("detection rule already exists for this scope")
+	ErrInvalidRuleScope = errors.New("invalid detection rule scope")
+	ErrInvalidRuleTerm  = errors.New("invalid detection rule term")
+)
+
+const (
+	// RuleUntestedConfidence is the confidence given to matches of rules whose back-test had no hits.
+	RuleUntestedConfidence = 0.5
+	// RuleConfidencePriorWeight is the number of back-test hits that weigh as much as
+	// RuleUntestedConfidence, so rules with few hits stay close to it.
+	RuleConfidencePriorWeight = 10
+)
+
+// RuleScope identifies the content a detection rule is matched against.
+type RuleScope string
+
+const (
+	// RuleScopeProfile matches the display names and descriptions of users.
+	RuleScopeProfile RuleScope = "profile"
+	// RuleScopeGroup matches the names and descriptions of groups.
+	RuleScopeGroup RuleScope = "group"
+	// RuleScopeMessage matches Discord messages.
+	RuleScopeMessage RuleScope = "message"
+)
+
+// RuleScopes lists every rule scope in display order.
+var RuleScopes = []RuleScope{RuleScopeProfile, RuleScopeGroup, RuleScopeMessage}
+
+// RuleStatus represents the lifecycle state of a detection rule.
+type RuleStatus string
+
+const (
+	// RuleStatusPending means the rule is a candidate waiting for admin approval.
+	RuleStatusPending RuleStatus = "pending"
+	// RuleStatusEnabled means an admin approved the rule and detection uses it.
+	RuleStatusEnabled RuleStatus = "enabled"
+	// RuleStatusRejected means an admin rejected the rule.
+	RuleStatusRejected RuleStatus = "rejected"
+)
+
+// DetectionRule is a term promoted by a reviewer from the evidence of a target.
+// Rules start as candidates and are only matched once an admin enables them.
+type DetectionRule struct {
+	ID           int64              `bun:",pk,autoincrement" json:"id"`
+	Term         string             `bun:",notnull"          json:"term"`
+	Scope        RuleScope          `bun:",notnull"          json:"scope"`
+	Notes        string             `bun:",notnull"          json:"notes"`
+	SourceType   DecisionTargetType `bun:",notnull"          json:"sourceType"`
+	SourceID     int64              `bun:",notnull"          json:"sourceId"`
+	SuggestedBy  uint64             `bun:",notnull"          json:"suggestedBy"`
+	Status       RuleStatus         `bun:",notnull"          json:"status"`
+	FlaggedHits  int64              `bun:",notnull"          json:"flaggedHits"`
+	ClearedHits  int64              `bun:",notnull"          json:"clearedHits"`
+	BacktestedAt time.Time          `bun:",nullzero"         json:"backtestedAt"`
+	ReviewedBy   uint64             `bun:",nullzero"         json:"reviewedBy"`
+	ReviewedAt   time.Time          `bun:",nullzero"         json:"reviewedAt"`
+	CreatedAt    time.Time          `bun:",notnull"          json:"createdAt"`
+}
+
+// Matches checks if the text contains the term of the rule as a whole word or phrase, ignoring
+// case. Terms are normalized when suggested, so the text should be normalized the same way.
+func (r *DetectionRule) Matches(text string) bool {
+	term := strings.ToLower(r.Term)
+	if term == "" {
+		return false
+	}
+
+	text = strings.ToLower(text)
+
+	for offset := 0; offset < len(text); {
+		index := strings.Index(text[offset:], term)
+		if index < 0 {
+			return false
+		}
+
+		start := offset + index
+		if isWordBoundary(text, start, start+len(term)) {
+			return true
+		}
+
+		_, size := utf8.DecodeRuneInString(text[start:])
+		offset = start + size
+	}
+
+	return false
+}
+
+// Precision returns the fraction of back-test hits on flagged or confirmed targets.
+// Returns 0 if the rule has no hits.
+func (r *DetectionRule) Precision() float64 {
+	total := r.FlaggedHits + r.ClearedHits
+	if total == 0 {
+		return 0
+	}
+
+	return float64(r.FlaggedHits) / float64(total)
+}
+
+// MatchConfidence returns the confidence of a match of the rule. The back-test precision is
+// weighed against RuleUntestedConfidence by the number of hits, so a handful of hits cannot
+// give a rule full confidence. Rules whose back-test had no hits use RuleUntestedConfidence.
+func (r *DetectionRule) MatchConfidence() float64 {
+	hits := float64(r.FlaggedHits + r.ClearedHits)
+	prior := RuleUntestedConfidence * RuleConfidencePriorWeight
+
+	return (float64(r.FlaggedHits) + prior) / (hits + RuleConfidencePriorWeight)
+}
+
+// isWordBoundary checks if the text between start and end is not surrounded by word characters.
+func isWordBoundary(text string, start, end int) bool {
+	if before, _ := utf8.DecodeLastRuneInString(text[:start]); start > 0 && isWordRune(before) {
+		return false
+	}
+
+	if after, _ := utf8.DecodeRuneInString(text[end:]); end < len(text) && isWordRune(after) {
+		return false
+	}
+
+	return true
+}
+
+// isWordRune checks if a rune is part of a word.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
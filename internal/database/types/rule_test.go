@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/robalyx/rotector/internal/database/types"
+)
+
+func TestDetectionRuleMatches(t *testing.T) {
+	t.Parallel()
+
+	rule := &types.DetectionRule{Term: "Add Me On"}
+
+	if !rule.Matches("pls add me on the other app") {
+		t.Error("Expected the rule to match regardless of case")
+	}
+
+	if rule.Matches("adding me later") {
+		t.Error("Expected the rule not to match unrelated text")
+	}
+
+	if rule.Matches("readd me online") {
+		t.Error("Expected the rule not to match inside other words")
+	}
+
+	if !rule.Matches("(add me on) discord") {
+		t.Error("Expected the rule to match next to punctuation")
+	}
+
+	short := &types.DetectionRule{Term: "ass"}
+	if short.Matches("first class pass") {
+		t.Error("Expected a short term not to match inside other words")
+	}
+
+	if !short.Matches("class ass class") {
+		t.Error("Expected a short term to match as a whole word after a partial match")
+	}
+
+	empty := &types.DetectionRule{}
+	if empty.Matches("anything") {
+		t.Error("Expected a rule without a term to never match")
+	}
+}
+
+func TestDetectionRulePrecision(t *testing.T) {
+	t.Parallel()
+
+	rule := &types.DetectionRule{FlaggedHits: 3, ClearedHits: 1}
+	if rule.Precision() != 0.75 {
+		t.Errorf("Expected precision 0.75, got %v", rule.Precision())
+	}
+
+	untested := &types.DetectionRule{}
+	if untested.Precision() != 0 {
+		t.Errorf("Expected precision 0 without hits, got %v", untested.Precision())
+	}
+}
+
+func TestDetectionRuleMatchConfidence(t *testing.T) {
+	t.Parallel()
+
+	untested := &types.DetectionRule{}
+	if untested.MatchConfidence() != types.RuleUntestedConfidence {
+		t.Errorf("Expected untested confidence, got %v", untested.MatchConfidence())
+	}
+
+	// Low precision lowers the confidence below the untested confidence
+	noisy := &types.DetectionRule{FlaggedHits: 1, ClearedHits: 3}
+	if confidence := noisy.MatchConfidence(); confidence >= types.RuleUntestedConfidence {
+		t.Errorf("Expected confidence below %v, got %v", types.RuleUntestedConfidence, confidence)
+	}
+
+	// A handful of hits cannot give a rule full confidence
+	few := &types.DetectionRule{FlaggedHits: 3}
+	if confidence := few.MatchConfidence(); confidence > 0.7 {
+		t.Errorf("Expected confidence at most 0.7 from 3 hits, got %v", confidence)
+	}
+
+	// Many hits bring the confidence close to the precision
+	many := &types.DetectionRule{FlaggedHits: 990}
+	if confidence := many.MatchConfidence(); confidence < 0.99 || confidence > 1 {
+		t.Errorf("Expected confidence close to 1 from 990 hits, got %v", confidence)
+	}
+}
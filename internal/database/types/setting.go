@@ -38,6 +38,8 @@ type UserSetting struct {
 	CaptchaUsage        CaptchaUsage             `bun:",embed"`
 	ReviewBreak         ReviewBreak              `bun:",embed"`
 	ReviewerStatsPeriod enum.ReviewerStatsPeriod `bun:",notnull"`
+	FilterPresets       []ReviewFilterPreset     `bun:"type:jsonb,notnull,default:'[]'"`
+	ActiveFilterPreset  string                   `bun:",notnull,default:''"`
 }
 
 // Announcement stores the dashboard announcement configuration.
@@ -54,6 +56,7 @@ type BotSetting struct {
 	SessionLimit   uint64              `bun:",notnull"`
 	WelcomeMessage string              `bun:",notnull,default:''"`
 	Announcement   Announcement        `bun:",embed"`
+	ReviewPolicies ReviewPolicies      `bun:"type:jsonb,notnull,default:'{}'"`
 	ReviewerMap    map[uint64]struct{} `bun:"-"` // In-memory map for O(1) lookups
 	AdminMap       map[uint64]struct{} `bun:"-"` // In-memory map for O(1) lookups
 	lastRefresh    time.Time           `bun:"-"` // In-memory cache control
@@ -87,6 +90,32 @@ func (s *BotSetting) IsReviewer(userID uint64) bool {
 	return exists
 }
 
+// RoleOf returns the reviewer role of the given user ID.
+func (s *BotSetting) RoleOf(userID uint64) ReviewerRole {
+	switch {
+	case s.IsAdmin(userID):
+		return ReviewerRoleAdmin
+	case s.IsReviewer(userID):
+		return ReviewerRoleReviewer
+	default:
+		return ReviewerRoleTrainee
+	}
+}
+
+// PolicyForRole returns the configured review policy of a role or its default.
+func (s *BotSetting) PolicyForRole(role ReviewerRole) ReviewPolicy {
+	if policy, ok := s.ReviewPolicies[role]; ok {
+		return policy
+	}
+
+	return DefaultReviewPolicy(role)
+}
+
+// ReviewPolicy returns the review policy that applies to the given user ID.
+func (s *BotSetting) ReviewPolicy(userID uint64) ReviewPolicy {
+	return s.PolicyForRole(s.RoleOf(userID))
+}
+
 // NeedsRefresh checks if the settings need to be refreshed.
 func (s *BotSetting) NeedsRefresh() bool {
 	return time.Since(s.lastRefresh) > 5*time.Minute
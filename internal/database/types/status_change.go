@@ -0,0 +1,122 @@
+package types
+
+import (
+	"errors"
+	"time"
+
+	"github.com/robalyx/rotector/internal/database/types/enum"
+)
+
+var (
+	ErrStatusChangeNotFound   = errors.New("status change not found")
+	ErrStatusChangeReverted   = errors.New("status change was already reverted")
+	ErrStatusChangeSuperseded = errors.New("a newer status change exists")
+	ErrStatusChangeStale      = errors.New("the state changed since the status change")
+)
+
+// UserStatusSnapshot captures the review state of a user at a point in time.
+type UserStatusSnapshot struct {
+	Status     enum.UserType                `json:"status"`
+	Confidence float64                      `json:"confidence"`
+	Category   enum.UserCategoryType        `json:"category"`
+	Reasons    Reasons[enum.UserReasonType] `json:"reasons"`
+	ReviewerID uint64                       `json:"reviewerId,omitempty"`
+	VerifiedAt time.Time                    `json:"verifiedAt"`
+	ClearedAt  time.Time                    `json:"clearedAt"`
+}
+
+// Matches returns true if both snapshots hold the same status, confidence, category and reasons.
+func (s *UserStatusSnapshot) Matches(other *UserStatusSnapshot) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.Status == other.Status &&
+		s.Confidence == other.Confidence &&
+		s.Category == other.Category &&
+		reasonsMatch(s.Reasons, other.Reasons)
+}
+
+// UserStatusChange records a status change on a user with the state before and after it.
+// Changes made by workers have no reviewer.
+type UserStatusChange struct {
+	ID         int64               `bun:",pk,autoincrement"  json:"id"`
+	UserID     int64               `bun:",notnull"           json:"userId"`
+	ReviewerID uint64              `bun:",notnull"           json:"reviewerId"`
+	Before     *UserStatusSnapshot `bun:"type:jsonb,notnull" json:"before"`
+	After      *UserStatusSnapshot `bun:"type:jsonb,notnull" json:"after"`
+	RevertOf   int64               `bun:",nullzero"          json:"revertOf"`   // ID of the change this change reverted
+	RevertedBy uint64              `bun:",nullzero"          json:"revertedBy"` // Reviewer who reverted this change
+	RevertedAt time.Time           `bun:",nullzero"          json:"revertedAt"`
+	CreatedAt  time.Time           `bun:",notnull"           json:"createdAt"`
+}
+
+// IsReverted returns true if the change was reverted.
+func (c *UserStatusChange) IsReverted() bool {
+	return !c.RevertedAt.IsZero()
+}
+
+// GroupStatusSnapshot captures the review state of a group at a point in time.
+type GroupStatusSnapshot struct {
+	Status     enum.GroupType                `json:"status"`
+	Confidence float64                       `json:"confidence"`
+	Reasons    Reasons[enum.GroupReasonType] `json:"reasons"`
+	ReviewerID uint64                        `json:"reviewerId,omitempty"`
+	VerifiedAt time.Time                     `json:"verifiedAt"`
+	MixedAt    time.Time                     `json:"mixedAt"`
+}
+
+// Matches returns true if both snapshots hold the same status, confidence and reasons.
+func (s *GroupStatusSnapshot) Matches(other *GroupStatusSnapshot) bool {
+	if s == nil || other == nil {
+		return s == other
+	}
+
+	return s.Status == other.Status &&
+		s.Confidence == other.Confidence &&
+		reasonsMatch(s.Reasons, other.Reasons)
+}
+
+// GroupStatusChange records a status change on a group with the state before and after it.
+// Changes made by workers have no reviewer.
+type GroupStatusChange struct {
+	ID         int64                `bun:",pk,autoincrement"  json:"id"`
+	GroupID    int64                `bun:",notnull"           json:"groupId"`
+	ReviewerID uint64               `bun:",notnull"           json:"reviewerId"`
+	Before     *GroupStatusSnapshot `bun:"type:jsonb,notnull" json:"before"`
+	After      *GroupStatusSnapshot `bun:"type:jsonb,notnull" json:"after"`
+	RevertOf   int64                `bun:",nullzero"          json:"revertOf"`   // ID of the change this change reverted
+	RevertedBy uint64               `bun:",nullzero"          json:"revertedBy"` // Reviewer who reverted this change
+	RevertedAt time.Time            `bun:",nullzero"          json:"revertedAt"`
+	CreatedAt  time.Time            `bun:",notnull"           json:"createdAt"`
+}
+
+// IsReverted returns true if the change was reverted.
+func (c *GroupStatusChange) IsReverted() bool {
+	return !c.RevertedAt.IsZero()
+}
+
+// reasonsMatch returns true if both reason sets hold the same reason types with the same
+// messages and confidences.
+func reasonsMatch[T ReasonType](a, b Reasons[T]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for reasonType, reason := range a {
+		other, ok := b[reasonType]
+		if !ok {
+			return false
+		}
+
+		if (reason == nil) != (other == nil) {
+			return false
+		}
+
+		if reason != nil && (reason.Message != other.Message || reason.Confidence != other.Confidence) {
+			return false
+		}
+	}
+
+	return true
+}
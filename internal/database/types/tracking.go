@@ -45,6 +45,34 @@ type GameTrackingUser struct {
 	UserID int64 `bun:",pk"`
 }
 
+// BadgeTracking monitors badges held by multiple flagged users.
+type BadgeTracking struct {
+	ID           int64     `bun:",pk"`
+	AwarderID    int64     `bun:",notnull"` // Place ID that awards the badge
+	AwardedCount int64     `bun:",notnull"`
+	LastAppended time.Time `bun:",notnull"`
+}
+
+// BadgeTrackingUser represents a flagged user who holds a tracked badge.
+type BadgeTrackingUser struct {
+	BadgeID int64 `bun:",pk"`
+	UserID  int64 `bun:",pk"`
+}
+
+// GameReputation stores the computed reputation of a tracked game based on
+// how densely flagged users favorite it relative to its visit count.
+type GameReputation struct {
+	ID             int64     `bun:",pk"` // Universe ID
+	RootPlaceID    int64     `bun:",notnull,default:0"`
+	Name           string    `bun:",notnull"`
+	PlaceVisits    int64     `bun:",notnull"`
+	FlaggedUsers   int       `bun:",notnull"`
+	Density        float64   `bun:",notnull"` // Flagged favoriters per 1,000 visits
+	Score          float64   `bun:",notnull"`
+	IsSuspected    bool      `bun:",notnull,default:false"`
+	LastCalculated time.Time `bun:",notnull"`
+}
+
 // GroupTrackingExclusion stores group IDs that should be excluded from tracking.
 type GroupTrackingExclusion struct {
 	GroupID int64 `bun:",pk"`
@@ -197,6 +197,36 @@ type UserBadge struct {
 	Badge   any   `bun:",notnull" json:"badge"`
 }
 
+// BadgeInfo contains information about a badge awarded to a user.
+type BadgeInfo struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	AwarderID    int64  `json:"awarderId"`            // Place ID that awards the badge
+	UniverseID   int64  `json:"universeId,omitempty"` // Universe of the game that awards the badge
+	AwardedCount int64  `json:"awardedCount"`
+}
+
+// CreationType identifies what kind of content a user created.
+type CreationType string
+
+const (
+	// CreationTypePlace is a place created by the user or a group they own.
+	CreationTypePlace CreationType = "Place"
+	// CreationTypeAsset is a catalog item uploaded by the user.
+	CreationTypeAsset CreationType = "Asset"
+)
+
+// CreationInfo contains information about content created by a user.
+type CreationInfo struct {
+	ID           int64        `json:"id"`
+	Type         CreationType `json:"type"`
+	Name         string       `json:"name"`
+	Description  string       `json:"description"`
+	GroupID      int64        `json:"groupId,omitempty"` // Owning group for group places
+	ThumbnailURL string       `json:"thumbnailUrl,omitempty"`
+}
+
 // UserVerification stores verification data for confirmed users.
 type UserVerification struct {
 	UserID     int64     `bun:",pk"      json:"userId"`
@@ -236,7 +266,8 @@ type ReviewUser struct {
 	Games         []*apiTypes.Game              `json:"games,omitempty"`
 	Inventory     []*apiTypes.InventoryAsset    `json:"inventory,omitempty"`
 	Favorites     []*apiTypes.Game              `json:"favorites,omitempty"`
-	Badges        []any                         `json:"badges,omitempty"`
+	Badges        []*BadgeInfo                  `json:"badges,omitempty"`
+	Creations     []*CreationInfo               `json:"creations,omitempty"`
 }
 
 // GlobalTargetCandidate represents a candidate for global target selection in the war system.
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrStaleFencingToken indicates a newer leader of the worker type has already written.
+var ErrStaleFencingToken = errors.New("stale fencing token")
+
+// WorkerEventType identifies the kind of worker lifecycle event.
+type WorkerEventType string
+
+const (
+	// WorkerEventStarted is recorded when a worker starts or restarts.
+	WorkerEventStarted WorkerEventType = "started"
+	// WorkerEventStopped is recorded when a worker stops.
+	WorkerEventStopped WorkerEventType = "stopped"
+	// WorkerEventDrained is recorded when a worker stops for a drain request.
+	WorkerEventDrained WorkerEventType = "drained"
+	// WorkerEventHealthy is recorded when a worker recovers.
+	WorkerEventHealthy WorkerEventType = "healthy"
+	// WorkerEventUnhealthy is recorded when a worker crashes or becomes unhealthy.
+	WorkerEventUnhealthy WorkerEventType = "unhealthy"
+)
+
+// WorkerEvent records a worker lifecycle transition or health flip.
+// Processed holds the items processed since the previous event of the worker.
+type WorkerEvent struct {
+	Sequence   int64           `bun:",pk,autoincrement"`
+	Timestamp  time.Time       `bun:",notnull,pk"`
+	WorkerID   string          `bun:",notnull"`
+	WorkerSlot string          `bun:",notnull"` // Stable across process restarts
+	WorkerType string          `bun:",notnull"`
+	EventType  WorkerEventType `bun:",notnull"`
+	Task       string          `bun:",notnull"`
+	Processed  int64           `bun:",notnull"`
+}
+
+// WorkerHistory summarizes the events of a single worker slot over a time window.
+// A slot keeps its identity across process restarts so crash loops can be detected.
+type WorkerHistory struct {
+	WorkerSlot     string    `bun:"worker_slot"     json:"workerSlot"`
+	WorkerType     string    `bun:"worker_type"     json:"workerType"`
+	Starts         int       `bun:"starts"          json:"starts"`
+	RecentStarts   int       `bun:"recent_starts"   json:"recentStarts"`
+	UnhealthyFlips int       `bun:"unhealthy_flips" json:"unhealthyFlips"`
+	Drains         int       `bun:"drains"          json:"drains"`
+	Processed      int64     `bun:"processed"       json:"processed"`
+	FirstSeen      time.Time `bun:"first_seen"      json:"firstSeen"`
+	LastSeen       time.Time `bun:"last_seen"       json:"lastSeen"`
+}
@@ -378,6 +378,11 @@ func (s *Scanner) AnalyzeAndFlagUser(
 			continue
 		}
 
+		// Fall back to detection rules enabled by admins
+		if flaggedUser == nil || len(flaggedUser.Messages) == 0 {
+			flaggedUser = s.matchMessageRules(ctx, messages)
+		}
+
 		// Check if this user was flagged
 		if flaggedUser != nil && len(flaggedUser.Messages) > 0 {
 			s.logger.Info("User has inappropriate messages",
@@ -457,6 +462,65 @@ func (s *Scanner) AnalyzeAndFlagUser(
 	return nil
 }
 
+// matchMessageRules flags messages that match a detection rule enabled by an admin.
+// Returns nil when no message matches.
+func (s *Scanner) matchMessageRules(ctx context.Context, messages []*ai.MessageContent) *ai.FlaggedMessageUser {
+	rules, err := s.db.Service().Rule().GetEnabledRules(ctx, types.RuleScopeMessage)
+	if err != nil {
+		s.logger.Error("Failed to get enabled detection rules", zap.Error(err))
+		return nil
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	var (
+		flagged    []ai.FlaggedMessage
+		confidence float64
+	)
+
+	normalizer := utils.NewTextNormalizer()
+
+	for _, message := range messages {
+		var (
+			terms             []string
+			messageConfidence float64
+		)
+
+		content := normalizer.Normalize(message.Content)
+
+		for _, rule := range rules {
+			if rule.Matches(content) {
+				terms = append(terms, rule.Term)
+				messageConfidence = max(messageConfidence, rule.MatchConfidence())
+			}
+		}
+
+		if len(terms) == 0 {
+			continue
+		}
+
+		flagged = append(flagged, ai.FlaggedMessage{
+			MessageID:  message.MessageID,
+			Content:    message.Content,
+			Reason:     "Matches reviewer-approved detection terms: " + strings.Join(terms, ", "),
+			Confidence: messageConfidence,
+		})
+		confidence = max(confidence, messageConfidence)
+	}
+
+	if len(flagged) == 0 {
+		return nil
+	}
+
+	return &ai.FlaggedMessageUser{
+		Reason:     "Messages match reviewer-approved detection terms",
+		Messages:   flagged,
+		Confidence: confidence,
+	}
+}
+
 // flagRobloxAccount creates or updates a flagged user record for a Roblox account linked to a Discord user.
 func (s *Scanner) flagRobloxAccount(
 	ctx context.Context, discordUserID uint64, robloxUserID int64, guildCount int,
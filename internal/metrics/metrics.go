@@ -0,0 +1,293 @@
+// Package metrics defines the Prometheus metrics exported by all services.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "rotector"
+
+// Status labels shared by request metrics.
+const (
+	StatusSuccess = "success"
+	StatusError   = "error"
+	StatusBlocked = "blocked"
+)
+
+const (
+	// maxReviewerLabels is the number of distinct reviewers given their own label.
+	maxReviewerLabels = 100
+	// maxProxyLabels is the number of distinct proxies given their own label.
+	maxProxyLabels = 200
+	// otherLabel replaces label values seen after a label's limit is reached.
+	otherLabel = "other"
+)
+
+var (
+	reviewerLabels = newBoundedLabel(maxReviewerLabels)
+	proxyLabels    = newBoundedLabel(maxProxyLabels)
+
+	// activeSessionsSource reports the number of active bot sessions when scraped.
+	activeSessionsSource atomic.Pointer[func() float64]
+)
+
+var (
+	// AIRequests counts AI chat completion requests by model and outcome.
+	AIRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ai",
+		Name:      "requests_total",
+		Help:      "Total AI chat completion requests by model and status.",
+	}, []string{"model", "status"})
+
+	// AIRequestDuration tracks AI chat completion latency by model.
+	AIRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "ai",
+		Name:      "request_duration_seconds",
+		Help:      "AI chat completion request latency by model.",
+		Buckets:   []float64{0.5, 1, 2.5, 5, 10, 20, 30, 45, 60, 90},
+	}, []string{"model"})
+
+	// AITokens counts AI tokens used by model and token type.
+	AITokens = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ai",
+		Name:      "tokens_total",
+		Help:      "Total AI tokens used by model and type (prompt, completion, reasoning).",
+	}, []string{"model", "type"})
+
+	// AICost counts the estimated AI cost in USD by model.
+	AICost = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "ai",
+		Name:      "cost_usd_total",
+		Help:      "Estimated AI cost in USD by model.",
+	}, []string{"model"})
+
+	// RobloxRequests counts Roblox API requests by endpoint, route and status.
+	RobloxRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "roblox",
+		Name:      "requests_total",
+		Help:      "Total Roblox API requests by endpoint, route and status.",
+	}, []string{"endpoint", "route", "status"})
+
+	// RobloxRequestDuration tracks Roblox API latency by endpoint and route.
+	RobloxRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "roblox",
+		Name:      "request_duration_seconds",
+		Help:      "Roblox API request latency by endpoint and route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"endpoint", "route"})
+
+	// ProxyRequests counts Roblox API requests by proxy and status. Proxies past
+	// maxProxyLabels share the "other" label to keep cardinality bounded.
+	ProxyRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "roblox",
+		Name:      "proxy_requests_total",
+		Help:      "Total Roblox API requests by proxy and status.",
+	}, []string{"proxy", "status"})
+
+	// CloudflareOperations counts D1 and R2 operations by service, operation and status.
+	CloudflareOperations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cloudflare",
+		Name:      "operations_total",
+		Help:      "Total Cloudflare D1 and R2 operations by service, operation and status.",
+	}, []string{"service", "operation", "status"})
+
+	// CloudflareOperationDuration tracks D1 and R2 latency by service and operation.
+	CloudflareOperationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "cloudflare",
+		Name:      "operation_duration_seconds",
+		Help:      "Cloudflare D1 and R2 operation latency by service and operation.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"service", "operation"})
+
+	// UsersProcessed counts users processed by each worker type.
+	UsersProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "worker",
+		Name:      "users_processed_total",
+		Help:      "Total users processed by worker type.",
+	}, []string{"worker"})
+
+	// UsersFlagged counts users flagged by each worker type.
+	UsersFlagged = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "worker",
+		Name:      "users_flagged_total",
+		Help:      "Total users flagged by worker type.",
+	}, []string{"worker"})
+
+	// ReviewActions counts moderation actions by reviewer and action type. Reviewers
+	// past maxReviewerLabels share the "other" label to keep cardinality bounded.
+	ReviewActions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "review",
+		Name:      "actions_total",
+		Help:      "Total review actions by reviewer and action type.",
+	}, []string{"reviewer", "action"})
+
+	// QueueDepth tracks the number of users waiting in each queue lane.
+	QueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "queue",
+		Name:      "depth",
+		Help:      "Users waiting in the processing queue by lane.",
+	}, []string{"lane"})
+
+	// ActiveSessions reports the number of active bot sessions at scrape time.
+	ActiveSessions = promauto.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "bot",
+		Name:      "active_sessions",
+		Help:      "Number of active bot sessions.",
+	}, func() float64 {
+		if source := activeSessionsSource.Load(); source != nil {
+			return (*source)()
+		}
+
+		return 0
+	})
+
+	// DBQueryDuration tracks database query latency by operation.
+	DBQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "Database query latency by operation.",
+		Buckets:   []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+	}, []string{"operation"})
+
+	// DBQueryErrors counts failed database queries by operation.
+	DBQueryErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_errors_total",
+		Help:      "Total failed database queries by operation.",
+	}, []string{"operation"})
+)
+
+// Handler returns the HTTP handler that serves all registered metrics.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveAIRequest records the outcome and latency of an AI request.
+func ObserveAIRequest(model, status string, duration time.Duration) {
+	AIRequests.WithLabelValues(model, status).Inc()
+	AIRequestDuration.WithLabelValues(model).Observe(duration.Seconds())
+}
+
+// AddAIUsage records the tokens and estimated cost of an AI request.
+func AddAIUsage(model string, promptTokens, completionTokens, reasoningTokens int64, cost float64) {
+	AITokens.WithLabelValues(model, "prompt").Add(float64(promptTokens))
+	AITokens.WithLabelValues(model, "completion").Add(float64(completionTokens))
+	AITokens.WithLabelValues(model, "reasoning").Add(float64(reasoningTokens))
+	AICost.WithLabelValues(model).Add(cost)
+}
+
+// ObserveRobloxRequest records the outcome and latency of a Roblox API request.
+// The status is the HTTP status code, or "error" if no response was received.
+func ObserveRobloxRequest(endpoint, route, proxy string, statusCode int, err error, duration time.Duration) {
+	status := StatusError
+	if err == nil {
+		status = strconv.Itoa(statusCode)
+	}
+
+	RobloxRequests.WithLabelValues(endpoint, route, status).Inc()
+	RobloxRequestDuration.WithLabelValues(endpoint, route).Observe(duration.Seconds())
+	ProxyRequests.WithLabelValues(proxyLabels.value(proxy), status).Inc()
+}
+
+// ObserveCloudflareOperation records the outcome and latency of a D1 or R2 operation.
+func ObserveCloudflareOperation(service, operation string, err error, duration time.Duration) {
+	CloudflareOperations.WithLabelValues(service, operation, statusOf(err)).Inc()
+	CloudflareOperationDuration.WithLabelValues(service, operation).Observe(duration.Seconds())
+}
+
+// AddUsersProcessed records the number of users processed and flagged by a worker.
+func AddUsersProcessed(worker string, processed, flagged int) {
+	UsersProcessed.WithLabelValues(worker).Add(float64(processed))
+	UsersFlagged.WithLabelValues(worker).Add(float64(flagged))
+}
+
+// IncReviewAction records a review action taken by a reviewer.
+func IncReviewAction(reviewerID uint64, action string) {
+	ReviewActions.WithLabelValues(reviewerLabels.value(strconv.FormatUint(reviewerID, 10)), action).Inc()
+}
+
+// SetQueueDepth records the number of users waiting in a queue lane.
+func SetQueueDepth(lane string, count int) {
+	QueueDepth.WithLabelValues(lane).Set(float64(count))
+}
+
+// SetActiveSessionsSource sets the function used to count active sessions when scraped.
+func SetActiveSessionsSource(source func() float64) {
+	activeSessionsSource.Store(&source)
+}
+
+// ObserveDBQuery records the latency and outcome of a database query.
+func ObserveDBQuery(operation string, err error, duration time.Duration) {
+	DBQueryDuration.WithLabelValues(operation).Observe(duration.Seconds())
+
+	if err != nil {
+		DBQueryErrors.WithLabelValues(operation).Inc()
+	}
+}
+
+// statusOf converts an error into a status label.
+func statusOf(err error) string {
+	if err != nil {
+		return StatusError
+	}
+
+	return StatusSuccess
+}
+
+// boundedLabel limits the number of distinct values a label can take. The first
+// values seen keep their own label and later values are reported as "other".
+type boundedLabel struct {
+	mu    sync.Mutex
+	limit int
+	seen  map[string]struct{}
+}
+
+// newBoundedLabel creates a boundedLabel that allows up to limit distinct values.
+func newBoundedLabel(limit int) *boundedLabel {
+	return &boundedLabel{
+		limit: limit,
+		seen:  make(map[string]struct{}, limit),
+	}
+}
+
+// value returns the label value to use for v.
+func (b *boundedLabel) value(v string) string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, ok := b.seen[v]; ok {
+		return v
+	}
+
+	if len(b.seen) >= b.limit {
+		return otherLabel
+	}
+
+	b.seen[v] = struct{}{}
+
+	return v
+}
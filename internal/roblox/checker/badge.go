@@ -0,0 +1,226 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/robalyx/rotector/internal/database"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+	"github.com/robalyx/rotector/internal/setup"
+	"go.uber.org/zap"
+)
+
+// BadgeCheckerParams contains all the parameters needed for badge checker processing.
+type BadgeCheckerParams struct {
+	Users      []*types.ReviewUser                          `json:"users"`
+	ReasonsMap map[int64]types.Reasons[enum.UserReasonType] `json:"reasonsMap"`
+}
+
+// badgeMatch describes a suspicious badge held by a user.
+type badgeMatch struct {
+	badge    *types.BadgeInfo
+	game     *types.GameReputation
+	density  float64
+	strength float64
+}
+
+// BadgeChecker flags users holding badges awarded by suspected games or
+// badges that are disproportionately held by flagged users.
+type BadgeChecker struct {
+	db                     database.Client
+	logger                 *zap.Logger
+	maxBadgeAwardedTrack   int64
+	minBadgeFlaggedHolders int
+	minBadgeFlaggedDensity float64
+}
+
+// NewBadgeChecker creates a BadgeChecker.
+func NewBadgeChecker(app *setup.App, logger *zap.Logger) *BadgeChecker {
+	return &BadgeChecker{
+		db:                     app.DB,
+		logger:                 logger.Named("badge_checker"),
+		maxBadgeAwardedTrack:   app.Config.Worker.ThresholdLimits.MaxBadgeAwardedTrack,
+		minBadgeFlaggedHolders: app.Config.Worker.ThresholdLimits.MinBadgeFlaggedHolders,
+		minBadgeFlaggedDensity: app.Config.Worker.ThresholdLimits.MinBadgeFlaggedDensity,
+	}
+}
+
+// ProcessUsers adds badge reasons to users holding suspicious badges.
+func (c *BadgeChecker) ProcessUsers(ctx context.Context, params *BadgeCheckerParams) error {
+	existingFlags := len(params.ReasonsMap)
+
+	// Collect unique badge, place and universe IDs across all users
+	badgeIDSet := make(map[int64]struct{})
+	placeIDSet := make(map[int64]struct{})
+	universeIDSet := make(map[int64]struct{})
+
+	for _, user := range params.Users {
+		for _, badge := range user.Badges {
+			placeIDSet[badge.AwarderID] = struct{}{}
+
+			if badge.UniverseID != 0 {
+				universeIDSet[badge.UniverseID] = struct{}{}
+			}
+
+			if badge.AwardedCount <= c.maxBadgeAwardedTrack {
+				badgeIDSet[badge.ID] = struct{}{}
+			}
+		}
+	}
+
+	if len(placeIDSet) == 0 {
+		return nil
+	}
+
+	placeIDs := make([]int64, 0, len(placeIDSet))
+	for placeID := range placeIDSet {
+		placeIDs = append(placeIDs, placeID)
+	}
+
+	universeIDs := make([]int64, 0, len(universeIDSet))
+	for universeID := range universeIDSet {
+		universeIDs = append(universeIDs, universeID)
+	}
+
+	badgeIDs := make([]int64, 0, len(badgeIDSet))
+	for badgeID := range badgeIDSet {
+		badgeIDs = append(badgeIDs, badgeID)
+	}
+
+	// Look up suspected games awarding these badges by root place and by universe
+	suspectedByPlace, err := c.db.Model().Tracking().GetSuspectedGamesByPlaceIDs(ctx, placeIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get suspected games by place: %w", err)
+	}
+
+	suspectedByUniverse, err := c.db.Model().Tracking().GetSuspectedGames(ctx, universeIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get suspected games by universe: %w", err)
+	}
+
+	// Look up how many flagged users hold each badge
+	holderCounts, err := c.db.Model().Tracking().GetBadgeFlaggedHolderCounts(ctx, badgeIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get badge holder counts: %w", err)
+	}
+
+	for _, user := range params.Users {
+		matches := c.findSuspiciousBadges(user.Badges, suspectedByPlace, suspectedByUniverse, holderCounts)
+		if len(matches) == 0 {
+			continue
+		}
+
+		confidence := c.calculateUserConfidence(matches)
+
+		evidence := make([]string, 0, len(matches))
+		for _, match := range matches {
+			if match.game != nil {
+				evidence = append(evidence, fmt.Sprintf("%s (%d) from %s (%d)",
+					match.badge.Name, match.badge.ID, match.game.Name, match.game.ID))
+			} else {
+				evidence = append(evidence, fmt.Sprintf("%s (%d) from place %d",
+					match.badge.Name, match.badge.ID, match.badge.AwarderID))
+			}
+		}
+
+		if _, exists := params.ReasonsMap[user.ID]; !exists {
+			params.ReasonsMap[user.ID] = make(types.Reasons[enum.UserReasonType])
+		}
+
+		params.ReasonsMap[user.ID].Add(enum.UserReasonTypeBadges, &types.Reason{
+			Message:    fmt.Sprintf("Earned %d badge(s) linked to suspected condo or ERP games.", len(matches)),
+			Confidence: confidence,
+			Evidence:   evidence,
+		})
+
+		c.logger.Debug("User flagged for suspicious badges",
+			zap.Int64("userID", user.ID),
+			zap.Int("suspiciousBadges", len(matches)),
+			zap.Float64("confidence", confidence))
+	}
+
+	c.logger.Info("Finished processing badges",
+		zap.Int("totalUsers", len(params.Users)),
+		zap.Int("suspectedGames", len(suspectedByPlace)+len(suspectedByUniverse)),
+		zap.Int("trackedBadges", len(holderCounts)),
+		zap.Int("newFlags", len(params.ReasonsMap)-existingFlags))
+
+	return nil
+}
+
+// findSuspiciousBadges returns the badges that come from suspected games or
+// are densely held by flagged users, strongest evidence first. Games are matched
+// by the awarding universe, or by root place when the universe is unknown or untracked.
+func (c *BadgeChecker) findSuspiciousBadges(
+	badges []*types.BadgeInfo, suspectedByPlace, suspectedByUniverse map[int64]*types.GameReputation,
+	holderCounts map[int64]int,
+) []*badgeMatch {
+	matches := make([]*badgeMatch, 0)
+
+	for _, badge := range badges {
+		match := &badgeMatch{badge: badge}
+
+		game, ok := suspectedByUniverse[badge.UniverseID]
+		if !ok {
+			game, ok = suspectedByPlace[badge.AwarderID]
+		}
+
+		if ok {
+			match.game = game
+			match.strength = game.Score
+		}
+
+		if badge.AwardedCount <= c.maxBadgeAwardedTrack {
+			flaggedHolders := holderCounts[badge.ID]
+			density := calculateGameDensity(flaggedHolders, badge.AwardedCount)
+
+			if flaggedHolders >= c.minBadgeFlaggedHolders && density >= c.minBadgeFlaggedDensity {
+				match.density = density
+				match.strength = math.Max(match.strength, math.Min(density/(c.minBadgeFlaggedDensity*2), 1.0))
+			}
+		}
+
+		if match.game != nil || match.density > 0 {
+			matches = append(matches, match)
+		}
+	}
+
+	// Sort by strength so the strongest evidence is shown first
+	sort.Slice(matches, func(i, j int) bool {
+		return matches[i].strength > matches[j].strength
+	})
+
+	return matches
+}
+
+// calculateUserConfidence computes a confidence score based on the suspicious badges a user holds.
+func (c *BadgeChecker) calculateUserConfidence(matches []*badgeMatch) float64 {
+	var base float64
+
+	switch {
+	case len(matches) >= 5:
+		base = 0.90
+	case len(matches) == 4:
+		base = 0.80
+	case len(matches) == 3:
+		base = 0.70
+	case len(matches) == 2:
+		base = 0.55
+	default:
+		base = 0.35
+	}
+
+	// Weight by the average strength of the matched badges
+	var totalStrength float64
+	for _, match := range matches {
+		totalStrength += match.strength
+	}
+
+	avgStrength := totalStrength / float64(len(matches))
+	confidence := base * (0.5 + avgStrength/2)
+
+	return math.Round(confidence*100) / 100
+}
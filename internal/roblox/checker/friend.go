@@ -10,6 +10,7 @@ import (
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
 	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/setup/telemetry/tracing"
 	"go.uber.org/zap"
 )
 
@@ -57,6 +58,9 @@ func NewFriendChecker(app *setup.App, logger *zap.Logger) *FriendChecker {
 
 // ProcessUsers checks multiple users' friends concurrently and updates reasonsMap.
 func (c *FriendChecker) ProcessUsers(ctx context.Context, params *FriendCheckerParams) {
+	ctx, span := tracing.Start(ctx, "FriendChecker.ProcessUsers", tracing.BatchSize(len(params.Users)))
+	defer span.End()
+
 	existingFlags := len(params.ReasonsMap)
 
 	// Track users that exceed confidence threshold
@@ -169,6 +173,8 @@ func (c *FriendChecker) ProcessUsers(ctx context.Context, params *FriendCheckerP
 		}
 	}
 
+	span.SetAttributes(tracing.FlaggedCount(len(params.ReasonsMap) - existingFlags))
+
 	c.logger.Info("Finished processing friends",
 		zap.Int("totalUsers", len(params.Users)),
 		zap.Int("analyzedUsers", len(usersToAnalyze)),
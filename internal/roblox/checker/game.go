@@ -0,0 +1,279 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/robalyx/rotector/internal/database"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+	"github.com/robalyx/rotector/internal/setup"
+	"go.uber.org/zap"
+)
+
+// GameCheckerParams contains all the parameters needed for game checker processing.
+type GameCheckerParams struct {
+	Users      []*types.ReviewUser                          `json:"users"`
+	ReasonsMap map[int64]types.Reasons[enum.UserReasonType] `json:"reasonsMap"`
+}
+
+// GameChecker scores games by how densely flagged users favorite them and
+// flags users whose favorites contain suspected games.
+type GameChecker struct {
+	db                     database.Client
+	logger                 *zap.Logger
+	maxGameVisitsTrack     int64
+	minGameFlaggedUsers    int
+	minGameFlaggedDensity  float64
+	minGameFlaggedOverride int
+}
+
+// NewGameChecker creates a GameChecker.
+func NewGameChecker(app *setup.App, logger *zap.Logger) *GameChecker {
+	return &GameChecker{
+		db:                     app.DB,
+		logger:                 logger.Named("game_checker"),
+		maxGameVisitsTrack:     app.Config.Worker.ThresholdLimits.MaxGameVisitsTrack,
+		minGameFlaggedUsers:    app.Config.Worker.ThresholdLimits.MinGameFlaggedUsers,
+		minGameFlaggedDensity:  app.Config.Worker.ThresholdLimits.MinGameFlaggedDensity,
+		minGameFlaggedOverride: app.Config.Worker.ThresholdLimits.MinGameFlaggedOverride,
+	}
+}
+
+// CheckGameReputations calculates the reputation of tracked games from the flagged users
+// who favorited them. Games exceeding the visit limit are removed from tracking.
+// Returns the reputations of all games that were scored.
+func (c *GameChecker) CheckGameReputations(
+	ctx context.Context, gameDetails []*apiTypes.GameDetailResponse, gameToFlaggedUsers map[int64][]int64,
+) map[int64]*types.GameReputation {
+	reputations := make(map[int64]*types.GameReputation)
+	popularGameIDs := make([]int64, 0)
+	now := time.Now()
+
+	for _, game := range gameDetails {
+		// Skip games that are too popular to track
+		if game.Visits > c.maxGameVisitsTrack {
+			popularGameIDs = append(popularGameIDs, game.ID)
+			continue
+		}
+
+		flaggedUsers := gameToFlaggedUsers[game.ID]
+		density := calculateGameDensity(len(flaggedUsers), game.Visits)
+
+		reputations[game.ID] = &types.GameReputation{
+			ID:             game.ID,
+			RootPlaceID:    game.RootPlaceID,
+			Name:           game.Name,
+			PlaceVisits:    game.Visits,
+			FlaggedUsers:   len(flaggedUsers),
+			Density:        density,
+			IsSuspected:    c.isSuspectedGame(len(flaggedUsers), density),
+			LastCalculated: now,
+		}
+	}
+
+	// Remove popular games from tracking
+	if len(popularGameIDs) > 0 {
+		if err := c.db.Model().Tracking().RemoveGamesFromTracking(ctx, popularGameIDs); err != nil {
+			c.logger.Error("Failed to remove popular games from tracking",
+				zap.Error(err),
+				zap.Int64s("gameIDs", popularGameIDs))
+		} else {
+			c.logger.Info("Removed popular games from tracking",
+				zap.Int("count", len(popularGameIDs)))
+		}
+	}
+
+	if len(reputations) == 0 {
+		return reputations
+	}
+
+	// Collect all unique flagged user IDs
+	allFlaggedUserIDs := make([]int64, 0)
+
+	for gameID := range reputations {
+		allFlaggedUserIDs = append(allFlaggedUserIDs, gameToFlaggedUsers[gameID]...)
+	}
+
+	// Get user data for score calculation
+	users, err := c.db.Model().User().GetUsersByIDs(
+		ctx, allFlaggedUserIDs, types.UserFieldBasic|types.UserFieldConfidence,
+	)
+	if err != nil {
+		c.logger.Error("Failed to get user confidence data", zap.Error(err))
+		return reputations
+	}
+
+	// Calculate score for each game
+	for gameID, reputation := range reputations {
+		reputation.Score = c.calculateGameScore(gameToFlaggedUsers[gameID], users, reputation.Density)
+	}
+
+	return reputations
+}
+
+// ProcessUsers adds favorites reasons to users who favorited suspected games.
+func (c *GameChecker) ProcessUsers(ctx context.Context, params *GameCheckerParams) error {
+	existingFlags := len(params.ReasonsMap)
+
+	// Collect unique favorite game IDs across all users
+	gameIDSet := make(map[int64]struct{})
+
+	for _, user := range params.Users {
+		for _, game := range user.Favorites {
+			gameIDSet[game.ID] = struct{}{}
+		}
+	}
+
+	if len(gameIDSet) == 0 {
+		return nil
+	}
+
+	gameIDs := make([]int64, 0, len(gameIDSet))
+	for gameID := range gameIDSet {
+		gameIDs = append(gameIDs, gameID)
+	}
+
+	// Look up which of these games are suspected
+	suspectedGames, err := c.db.Model().Tracking().GetSuspectedGames(ctx, gameIDs)
+	if err != nil {
+		return fmt.Errorf("failed to get suspected games: %w", err)
+	}
+
+	if len(suspectedGames) == 0 {
+		return nil
+	}
+
+	for _, user := range params.Users {
+		// Find suspected games in the user's favorites
+		matches := make([]*types.GameReputation, 0)
+
+		for _, game := range user.Favorites {
+			if reputation, ok := suspectedGames[game.ID]; ok {
+				matches = append(matches, reputation)
+			}
+		}
+
+		if len(matches) == 0 {
+			continue
+		}
+
+		// Sort by score so the strongest evidence is shown first
+		sort.Slice(matches, func(i, j int) bool {
+			return matches[i].Score > matches[j].Score
+		})
+
+		confidence := c.calculateUserConfidence(matches)
+
+		evidence := make([]string, 0, len(matches))
+		for _, match := range matches {
+			evidence = append(evidence, fmt.Sprintf("%s (%d)", match.Name, match.ID))
+		}
+
+		if _, exists := params.ReasonsMap[user.ID]; !exists {
+			params.ReasonsMap[user.ID] = make(types.Reasons[enum.UserReasonType])
+		}
+
+		// Favorites reasons are rebuilt every run, so this is dropped once the games are unfavorited
+		params.ReasonsMap[user.ID].AddWithSource(enum.UserReasonTypeFavorites, &types.Reason{
+			Message:    fmt.Sprintf("Favorited %d suspected condo or ERP game(s).", len(matches)),
+			Confidence: confidence,
+			Evidence:   evidence,
+		}, "Game")
+
+		c.logger.Debug("User flagged for suspected favorite games",
+			zap.Int64("userID", user.ID),
+			zap.Int("suspectedGames", len(matches)),
+			zap.Float64("confidence", confidence))
+	}
+
+	c.logger.Info("Finished processing favorite games",
+		zap.Int("totalUsers", len(params.Users)),
+		zap.Int("suspectedGames", len(suspectedGames)),
+		zap.Int("newFlags", len(params.ReasonsMap)-existingFlags))
+
+	return nil
+}
+
+// isSuspectedGame determines whether a game should be marked as suspected.
+func (c *GameChecker) isSuspectedGame(flaggedCount int, density float64) bool {
+	if flaggedCount >= c.minGameFlaggedOverride {
+		return true
+	}
+
+	return flaggedCount >= c.minGameFlaggedUsers && density >= c.minGameFlaggedDensity
+}
+
+// calculateGameScore computes the reputation score for a game based on its flagged favoriters.
+func (c *GameChecker) calculateGameScore(
+	flaggedUsers []int64, users map[int64]*types.ReviewUser, density float64,
+) float64 {
+	var (
+		totalConfidence float64
+		validUserCount  int
+	)
+
+	for _, userID := range flaggedUsers {
+		if user, exists := users[userID]; exists {
+			totalConfidence += user.Confidence
+			validUserCount++
+		}
+	}
+
+	if validUserCount == 0 {
+		return 0
+	}
+
+	// Calculate average confidence
+	avgConfidence := totalConfidence / float64(validUserCount)
+
+	// Apply 20% boost if game is far denser than required or exceeds override threshold
+	if density >= c.minGameFlaggedDensity*2 || len(flaggedUsers) >= c.minGameFlaggedOverride {
+		avgConfidence *= 1.2
+	}
+
+	// Clamp confidence between 0 and 1
+	avgConfidence = math.Min(avgConfidence, 1.0)
+
+	// Round confidence to 2 decimal places
+	return math.Round(avgConfidence*100) / 100
+}
+
+// calculateUserConfidence computes a confidence score based on the suspected games a user favorited.
+func (c *GameChecker) calculateUserConfidence(matches []*types.GameReputation) float64 {
+	var base float64
+
+	switch {
+	case len(matches) >= 5:
+		base = 0.95
+	case len(matches) == 4:
+		base = 0.85
+	case len(matches) == 3:
+		base = 0.75
+	case len(matches) == 2:
+		base = 0.60
+	default:
+		base = 0.40
+	}
+
+	// Weight by the average score of the matched games
+	var totalScore float64
+	for _, match := range matches {
+		totalScore += match.Score
+	}
+
+	avgScore := totalScore / float64(len(matches))
+	confidence := base * (0.5 + avgScore/2)
+
+	return math.Round(confidence*100) / 100
+}
+
+// calculateGameDensity returns the number of flagged favoriters per 1,000 visits.
+func calculateGameDensity(flaggedCount int, visits int64) float64 {
+	density := float64(flaggedCount) / float64(max(visits, 1)) * 1000
+	return math.Round(density*1000) / 1000
+}
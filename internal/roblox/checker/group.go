@@ -2,18 +2,40 @@ package checker
 
 import (
 	"context"
+	"fmt"
+	"hash/fnv"
+	"maps"
 	"math"
 	"time"
 
+	"github.com/bytedance/sonic"
 	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/redis/rueidis"
 	"github.com/robalyx/rotector/internal/ai"
 	"github.com/robalyx/rotector/internal/database"
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
+	"github.com/robalyx/rotector/internal/redis"
 	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/setup/telemetry/tracing"
+	"github.com/robalyx/rotector/internal/translator"
+	"github.com/robalyx/rotector/pkg/utils"
 	"go.uber.org/zap"
 )
 
+const (
+	// groupContentCacheTTL is how long an analyzed group's content is trusted before it is analyzed again.
+	groupContentCacheTTL = 24 * time.Hour
+	// groupContentKeyPrefix is the Redis key prefix for analyzed group content.
+	groupContentKeyPrefix = "group_content:"
+)
+
+// groupContentEntry remembers the analyzed content of a group.
+type groupContentEntry struct {
+	Hash    uint64                              `json:"hash"`
+	Reasons types.Reasons[enum.GroupReasonType] `json:"reasons"` // Nil if the content had no violations
+}
+
 // GroupCheckerParams contains all the parameters needed for group checker processing.
 type GroupCheckerParams struct {
 	Users                    []*types.ReviewUser                          `json:"users"`
@@ -39,19 +61,31 @@ type GroupCheckResult struct {
 type GroupChecker struct {
 	db                   database.Client
 	groupReasonAnalyzer  *ai.GroupReasonAnalyzer
+	groupAnalyzer        *ai.GroupAnalyzer
+	ruleChecker          *RuleChecker
 	logger               *zap.Logger
+	contentCache         rueidis.Client
 	maxGroupMembersTrack int64
 	minFlaggedOverride   int
 	minFlaggedPercentage float64
 }
 
 // NewGroupChecker creates a GroupChecker with database access for looking up
-// flagged group information.
-func NewGroupChecker(app *setup.App, logger *zap.Logger) *GroupChecker {
+// flagged group information. The translator is shared with the caller's other analyzers.
+func NewGroupChecker(app *setup.App, translator *translator.Translator, logger *zap.Logger) *GroupChecker {
+	// Get Redis client for the group content cache
+	cacheClient, err := app.RedisManager.GetClient(redis.CacheDBIndex)
+	if err != nil {
+		logger.Fatal("Failed to get Redis client for group content cache", zap.Error(err))
+	}
+
 	return &GroupChecker{
 		db:                   app.DB,
 		groupReasonAnalyzer:  ai.NewGroupReasonAnalyzer(app, logger),
+		groupAnalyzer:        ai.NewGroupAnalyzer(app, translator, logger),
+		ruleChecker:          NewRuleChecker(app, logger),
 		logger:               logger.Named("group_checker"),
+		contentCache:         cacheClient,
 		maxGroupMembersTrack: app.Config.Worker.ThresholdLimits.MaxGroupMembersTrack,
 		minFlaggedOverride:   app.Config.Worker.ThresholdLimits.MinFlaggedOverride,
 		minFlaggedPercentage: app.Config.Worker.ThresholdLimits.MinFlaggedPercentage,
@@ -59,11 +93,14 @@ func NewGroupChecker(app *setup.App, logger *zap.Logger) *GroupChecker {
 }
 
 // CheckGroupPercentages analyzes groups to find those exceeding the flagged user threshold.
+// Group names, descriptions and shouts are analyzed for every tracked group so groups
+// advertising violations are flagged even when not enough of their members are flagged yet.
 func (c *GroupChecker) CheckGroupPercentages(
 	ctx context.Context, groupInfos []*apiTypes.GroupResponse, groupToFlaggedUsers map[int64][]int64,
 ) map[int64]*types.ReviewGroup {
 	flaggedGroups := make(map[int64]*types.ReviewGroup)
 	largeGroupIDs := make([]int64, 0)
+	trackedGroups := make([]*apiTypes.GroupResponse, 0, len(groupInfos))
 
 	// Identify groups that exceed thresholds
 	for _, groupInfo := range groupInfos {
@@ -73,6 +110,7 @@ func (c *GroupChecker) CheckGroupPercentages(
 			continue
 		}
 
+		trackedGroups = append(trackedGroups, groupInfo)
 		flaggedUsers := groupToFlaggedUsers[groupInfo.ID]
 
 		var reason string
@@ -122,6 +160,17 @@ func (c *GroupChecker) CheckGroupPercentages(
 		}
 	}
 
+	// Analyze group content and merge the resulting reasons
+	contentFlagged := c.checkGroupContent(ctx, trackedGroups, flaggedGroups)
+
+	// Match detection rules enabled by admins
+	ruleMatched, err := c.ruleChecker.ProcessGroups(ctx, trackedGroups, flaggedGroups)
+	if err != nil {
+		c.logger.Error("Failed to check group detection rules", zap.Error(err))
+	}
+
+	maps.Copy(contentFlagged, ruleMatched)
+
 	// If no groups were flagged, return empty map
 	if len(flaggedGroups) == 0 {
 		return flaggedGroups
@@ -130,7 +179,11 @@ func (c *GroupChecker) CheckGroupPercentages(
 	// Collect all unique flagged user IDs
 	allFlaggedUserIDs := make([]int64, 0)
 
-	for groupID := range flaggedGroups {
+	for groupID, group := range flaggedGroups {
+		if _, ok := group.Reasons[enum.GroupReasonTypeMember]; !ok {
+			continue
+		}
+
 		if flaggedUsers, ok := groupToFlaggedUsers[groupID]; ok {
 			allFlaggedUserIDs = append(allFlaggedUserIDs, flaggedUsers...)
 		}
@@ -147,15 +200,164 @@ func (c *GroupChecker) CheckGroupPercentages(
 
 	// Calculate average confidence for each flagged group
 	for groupID, group := range flaggedGroups {
+		memberReason, ok := group.Reasons[enum.GroupReasonTypeMember]
+		if !ok {
+			continue
+		}
+
 		group.Confidence = c.calculateGroupConfidence(groupToFlaggedUsers[groupID], users)
-		if memberReason, ok := group.Reasons[enum.GroupReasonTypeMember]; ok {
-			memberReason.Confidence = group.Confidence
+		memberReason.Confidence = group.Confidence
+
+		// Combine with content reasons if the group was also flagged for its content
+		if _, ok := contentFlagged[groupID]; ok {
+			group.Confidence = utils.CalculateConfidence(group.Reasons)
 		}
 	}
 
 	return flaggedGroups
 }
 
+// checkGroupContent analyzes the name, description and shout of groups and adds
+// the resulting reasons to flaggedGroups, creating entries for groups not flagged yet.
+// Groups whose content has not changed since their last analysis reuse the cached reasons.
+// Returns the IDs of groups flagged for their content.
+func (c *GroupChecker) checkGroupContent(
+	ctx context.Context, groupInfos []*apiTypes.GroupResponse, flaggedGroups map[int64]*types.ReviewGroup,
+) map[int64]struct{} {
+	contentFlagged := make(map[int64]struct{})
+	contentReasons := make(map[int64]types.Reasons[enum.GroupReasonType])
+
+	// Reuse the reasons of groups whose content was already analyzed
+	groupsToAnalyze := make([]*apiTypes.GroupResponse, 0, len(groupInfos))
+	hashes := make(map[int64]uint64, len(groupInfos))
+	cached := c.getCachedGroupContent(ctx, groupInfos)
+
+	for _, groupInfo := range groupInfos {
+		hash := hashGroupContent(groupInfo)
+
+		entry, ok := cached[groupInfo.ID]
+		if ok && entry.Hash == hash {
+			if entry.Reasons != nil {
+				contentReasons[groupInfo.ID] = entry.Reasons
+			}
+
+			continue
+		}
+
+		hashes[groupInfo.ID] = hash
+		groupsToAnalyze = append(groupsToAnalyze, groupInfo)
+	}
+
+	if len(groupsToAnalyze) > 0 {
+		analyzedReasons, analyzed := c.groupAnalyzer.ProcessGroups(ctx, groupsToAnalyze)
+		maps.Copy(contentReasons, analyzedReasons)
+
+		c.rememberGroupContent(ctx, hashes, analyzedReasons, analyzed)
+	}
+
+	now := time.Now()
+
+	for _, groupInfo := range groupInfos {
+		reasons, ok := contentReasons[groupInfo.ID]
+		if !ok {
+			continue
+		}
+
+		contentFlagged[groupInfo.ID] = struct{}{}
+
+		group, exists := flaggedGroups[groupInfo.ID]
+		if !exists {
+			group = &types.ReviewGroup{
+				Group: &types.Group{
+					ID:            groupInfo.ID,
+					Name:          groupInfo.Name,
+					Description:   groupInfo.Description,
+					Owner:         groupInfo.Owner,
+					Shout:         groupInfo.Shout,
+					LastUpdated:   now,
+					LastLockCheck: now,
+				},
+				Reasons: make(types.Reasons[enum.GroupReasonType]),
+			}
+			flaggedGroups[groupInfo.ID] = group
+		}
+
+		maps.Copy(group.Reasons, reasons)
+		group.Confidence = utils.CalculateConfidence(group.Reasons)
+
+		c.logger.Debug("Group flagged for content",
+			zap.Int64("groupID", groupInfo.ID),
+			zap.Int("reasons", len(reasons)))
+	}
+
+	return contentFlagged
+}
+
+// getCachedGroupContent loads the cached content analysis of the given groups.
+// Groups that are not cached or fail to load are left out of the result.
+func (c *GroupChecker) getCachedGroupContent(
+	ctx context.Context, groupInfos []*apiTypes.GroupResponse,
+) map[int64]groupContentEntry {
+	entries := make(map[int64]groupContentEntry, len(groupInfos))
+	if len(groupInfos) == 0 {
+		return entries
+	}
+
+	cmds := make(rueidis.Commands, 0, len(groupInfos))
+	for _, groupInfo := range groupInfos {
+		cmds = append(cmds, c.contentCache.B().Get().Key(groupContentKey(groupInfo.ID)).Build())
+	}
+
+	for i, resp := range c.contentCache.DoMulti(ctx, cmds...) {
+		data, err := resp.AsBytes()
+		if err != nil {
+			if !rueidis.IsRedisNil(err) {
+				c.logger.Warn("Failed to get cached group content", zap.Error(err))
+			}
+
+			continue
+		}
+
+		var entry groupContentEntry
+		if err := sonic.Unmarshal(data, &entry); err != nil {
+			continue
+		}
+
+		entries[groupInfos[i].ID] = entry
+	}
+
+	return entries
+}
+
+// rememberGroupContent caches the content hashes and reasons of successfully analyzed
+// groups so unchanged groups are not sent again by any worker until the cache expires.
+func (c *GroupChecker) rememberGroupContent(
+	ctx context.Context, hashes map[int64]uint64,
+	reasons map[int64]types.Reasons[enum.GroupReasonType], analyzed map[int64]struct{},
+) {
+	cmds := make(rueidis.Commands, 0, len(analyzed))
+
+	for groupID := range analyzed {
+		data, err := sonic.Marshal(groupContentEntry{
+			Hash:    hashes[groupID],
+			Reasons: reasons[groupID],
+		})
+		if err != nil {
+			c.logger.Error("Failed to marshal group content", zap.Error(err))
+			continue
+		}
+
+		cmds = append(cmds, c.contentCache.B().Set().
+			Key(groupContentKey(groupID)).Value(string(data)).Ex(groupContentCacheTTL).Build())
+	}
+
+	for _, resp := range c.contentCache.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			c.logger.Warn("Failed to cache group content", zap.Error(err))
+		}
+	}
+}
+
 // PrepareGroupMaps handles the preparation of confirmed, flagged, and mixed group maps.
 func (c *GroupChecker) PrepareGroupMaps(
 	ctx context.Context, userInfos []*types.ReviewUser,
@@ -228,6 +430,9 @@ func (c *GroupChecker) PrepareGroupMaps(
 
 // ProcessUsers checks multiple users' groups concurrently and updates reasonsMap.
 func (c *GroupChecker) ProcessUsers(ctx context.Context, params *GroupCheckerParams) {
+	ctx, span := tracing.Start(ctx, "GroupChecker.ProcessUsers", tracing.BatchSize(len(params.Users)))
+	defer span.End()
+
 	// Track counts before processing
 	existingFlags := len(params.ReasonsMap)
 
@@ -326,6 +531,8 @@ func (c *GroupChecker) ProcessUsers(ctx context.Context, params *GroupCheckerPar
 		}
 	}
 
+	span.SetAttributes(tracing.FlaggedCount(len(params.ReasonsMap) - existingFlags))
+
 	c.logger.Info("Finished processing groups",
 		zap.Int("totalUsers", len(params.Users)),
 		zap.Int("analyzedUsers", len(usersToAnalyze)),
@@ -530,3 +737,23 @@ func (c *GroupChecker) getRequiredFlaggedFriends(totalFriends int) int {
 		return 12
 	}
 }
+
+// hashGroupContent returns a hash of the analyzed text fields of a group.
+func hashGroupContent(group *apiTypes.GroupResponse) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(group.Name))
+	h.Write([]byte{0})
+	h.Write([]byte(group.Description))
+
+	if group.Shout != nil {
+		h.Write([]byte{0})
+		h.Write([]byte(group.Shout.Body))
+	}
+
+	return h.Sum64()
+}
+
+// groupContentKey returns the Redis key of a group's cached content analysis.
+func groupContentKey(groupID int64) string {
+	return fmt.Sprintf("%s%d", groupContentKeyPrefix, groupID)
+}
@@ -0,0 +1,176 @@
+package checker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/robalyx/rotector/internal/database"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/database/types/enum"
+	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// RuleCheckerParams contains all the parameters needed for rule checker processing.
+type RuleCheckerParams struct {
+	Users      []*types.ReviewUser                          `json:"users"`
+	ReasonsMap map[int64]types.Reasons[enum.UserReasonType] `json:"reasonsMap"`
+}
+
+// RuleChecker flags users and groups whose content matches a detection rule enabled by an admin.
+type RuleChecker struct {
+	db     database.Client
+	logger *zap.Logger
+}
+
+// NewRuleChecker creates a RuleChecker.
+func NewRuleChecker(app *setup.App, logger *zap.Logger) *RuleChecker {
+	return &RuleChecker{
+		db:     app.DB,
+		logger: logger.Named("rule_checker"),
+	}
+}
+
+// ProcessUsers adds profile reasons to users whose display name or description matches an enabled rule.
+func (c *RuleChecker) ProcessUsers(ctx context.Context, params *RuleCheckerParams) error {
+	rules, err := c.db.Service().Rule().GetEnabledRules(ctx, types.RuleScopeProfile)
+	if err != nil {
+		return fmt.Errorf("failed to get enabled detection rules: %w", err)
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+
+	flaggedCount := 0
+	normalizer := utils.NewTextNormalizer()
+
+	for _, user := range params.Users {
+		var (
+			evidence   []string
+			confidence float64
+		)
+
+		displayName := normalizer.Normalize(user.DisplayName)
+		description := normalizer.Normalize(user.Description)
+
+		for _, rule := range rules {
+			if !rule.Matches(displayName) && !rule.Matches(description) {
+				continue
+			}
+
+			evidence = append(evidence, rule.Term)
+			confidence = max(confidence, rule.MatchConfidence())
+		}
+
+		if len(evidence) == 0 {
+			continue
+		}
+
+		if _, exists := params.ReasonsMap[user.ID]; !exists {
+			params.ReasonsMap[user.ID] = make(types.Reasons[enum.UserReasonType])
+		}
+
+		params.ReasonsMap[user.ID].AddWithSource(enum.UserReasonTypeProfile, &types.Reason{
+			Message:    "Profile matches reviewer-approved detection terms: " + strings.Join(evidence, ", "),
+			Confidence: confidence,
+			Evidence:   evidence,
+		}, "Rule")
+
+		flaggedCount++
+
+		c.logger.Debug("User matched detection rules",
+			zap.Int64("userID", user.ID),
+			zap.Strings("terms", evidence))
+	}
+
+	c.logger.Info("Finished processing detection rules",
+		zap.Int("totalUsers", len(params.Users)),
+		zap.Int("rules", len(rules)),
+		zap.Int("matchedUsers", flaggedCount))
+
+	return nil
+}
+
+// ProcessGroups adds description reasons to groups whose name or description matches an enabled
+// rule, creating entries in flaggedGroups for groups not flagged yet. Returns the IDs of matched groups.
+func (c *RuleChecker) ProcessGroups(
+	ctx context.Context, groupInfos []*apiTypes.GroupResponse, flaggedGroups map[int64]*types.ReviewGroup,
+) (map[int64]struct{}, error) {
+	matched := make(map[int64]struct{})
+
+	rules, err := c.db.Service().Rule().GetEnabledRules(ctx, types.RuleScopeGroup)
+	if err != nil {
+		return matched, fmt.Errorf("failed to get enabled detection rules: %w", err)
+	}
+
+	if len(rules) == 0 {
+		return matched, nil
+	}
+
+	now := time.Now()
+	normalizer := utils.NewTextNormalizer()
+
+	for _, groupInfo := range groupInfos {
+		var (
+			evidence   []string
+			confidence float64
+		)
+
+		name := normalizer.Normalize(groupInfo.Name)
+		description := normalizer.Normalize(groupInfo.Description)
+
+		for _, rule := range rules {
+			if !rule.Matches(name) && !rule.Matches(description) {
+				continue
+			}
+
+			evidence = append(evidence, rule.Term)
+			confidence = max(confidence, rule.MatchConfidence())
+		}
+
+		if len(evidence) == 0 {
+			continue
+		}
+
+		group, exists := flaggedGroups[groupInfo.ID]
+		if !exists {
+			group = &types.ReviewGroup{
+				Group: &types.Group{
+					ID:            groupInfo.ID,
+					Name:          groupInfo.Name,
+					Description:   groupInfo.Description,
+					Owner:         groupInfo.Owner,
+					Shout:         groupInfo.Shout,
+					LastUpdated:   now,
+					LastLockCheck: now,
+				},
+				Reasons: make(types.Reasons[enum.GroupReasonType]),
+			}
+			flaggedGroups[groupInfo.ID] = group
+		}
+
+		group.Reasons.AddWithSource(enum.GroupReasonTypeDescription, &types.Reason{
+			Message:    "Group matches reviewer-approved detection terms: " + strings.Join(evidence, ", "),
+			Confidence: confidence,
+			Evidence:   evidence,
+		}, "Rule")
+
+		matched[groupInfo.ID] = struct{}{}
+
+		c.logger.Debug("Group matched detection rules",
+			zap.Int64("groupID", groupInfo.ID),
+			zap.Strings("terms", evidence))
+	}
+
+	c.logger.Info("Finished processing group detection rules",
+		zap.Int("totalGroups", len(groupInfos)),
+		zap.Int("rules", len(rules)),
+		zap.Int("matchedGroups", len(matched)))
+
+	return matched, nil
+}
@@ -13,9 +13,12 @@ import (
 	"github.com/robalyx/rotector/internal/database/types/enum"
 	"github.com/robalyx/rotector/internal/roblox/fetcher"
 	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/setup/telemetry/tracing"
 	"github.com/robalyx/rotector/internal/translator"
 	"github.com/robalyx/rotector/pkg/utils"
 	"github.com/sourcegraph/conc/pool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -37,42 +40,58 @@ type UserChecker struct {
 	db                 database.Client
 	cfClient           *cloudflare.Client
 	userFetcher        *fetcher.UserFetcher
-	gameFetcher        *fetcher.GameFetcher
 	outfitFetcher      *fetcher.OutfitFetcher
 	translator         *translator.Translator
 	userAnalyzer       *ai.UserAnalyzer
 	userReasonAnalyzer *ai.UserReasonAnalyzer
 	categoryAnalyzer   *ai.CategoryAnalyzer
 	outfitAnalyzer     *ai.OutfitAnalyzer
+	creationAnalyzer   *ai.CreationAnalyzer
 	groupChecker       *GroupChecker
 	friendChecker      *FriendChecker
 	condoChecker       *CondoChecker
+	gameChecker        *GameChecker
+	badgeChecker       *BadgeChecker
+	ruleChecker        *RuleChecker
 	logger             *zap.Logger
 }
 
 // NewUserChecker creates a UserChecker with all required dependencies.
 func NewUserChecker(app *setup.App, userFetcher *fetcher.UserFetcher, logger *zap.Logger) *UserChecker {
 	trans := translator.New(app.RoAPI.GetClient())
+	userAnalyzer := ai.NewUserAnalyzer(app, trans, logger)
+	outfitAnalyzer := ai.NewOutfitAnalyzer(app, logger)
 
 	return &UserChecker{
 		app:                app,
 		db:                 app.DB,
 		cfClient:           app.CFClient,
 		userFetcher:        userFetcher,
-		gameFetcher:        fetcher.NewGameFetcher(app.RoAPI, logger),
 		outfitFetcher:      fetcher.NewOutfitFetcher(app.RoAPI, logger),
 		translator:         trans,
-		userAnalyzer:       ai.NewUserAnalyzer(app, trans, logger),
+		userAnalyzer:       userAnalyzer,
 		userReasonAnalyzer: ai.NewUserReasonAnalyzer(app, logger),
 		categoryAnalyzer:   ai.NewCategoryAnalyzer(app, logger),
-		outfitAnalyzer:     ai.NewOutfitAnalyzer(app, logger),
-		groupChecker:       NewGroupChecker(app, logger),
+		outfitAnalyzer:     outfitAnalyzer,
+		creationAnalyzer:   ai.NewCreationAnalyzer(userAnalyzer, outfitAnalyzer, logger),
+		groupChecker:       NewGroupChecker(app, trans, logger),
 		friendChecker:      NewFriendChecker(app, logger),
 		condoChecker:       NewCondoChecker(app, logger),
+		gameChecker:        NewGameChecker(app, logger),
+		badgeChecker:       NewBadgeChecker(app, logger),
+		ruleChecker:        NewRuleChecker(app, logger),
 		logger:             logger.Named("user_checker"),
 	}
 }
 
+// AdaptiveSettings returns the current AI batch settings of the analyzers for worker status.
+func (c *UserChecker) AdaptiveSettings() map[string]string {
+	return map[string]string{
+		"user_analysis":   c.userAnalyzer.AdaptiveState(),
+		"outfit_analysis": c.outfitAnalyzer.AdaptiveState(),
+	}
+}
+
 // ProcessResult contains the results of processing users.
 type ProcessResult struct {
 	FlaggedStatus  map[int64]struct{}          // User IDs that were flagged
@@ -83,13 +102,18 @@ type ProcessResult struct {
 // ProcessUsers runs users through multiple checking stages.
 // Returns processing results containing flagged user IDs and their full data.
 func (c *UserChecker) ProcessUsers(ctx context.Context, params *UserCheckerParams) *ProcessResult {
+	ctx, span := tracing.Start(ctx, "UserChecker.ProcessUsers",
+		tracing.BatchSize(len(params.Users)),
+		attribute.Bool("rotector.from_queue_worker", params.FromQueueWorker))
+	defer span.End()
+
 	c.logger.Info("Processing users", zap.Int("userInfos", len(params.Users)))
 
 	// Initialize map to store reasons
 	reasonsMap := make(map[int64]types.Reasons[enum.UserReasonType])
 
 	// Preserve manually-added reasons from existing users before analysis
-	// This ensures moderator-added reasons (Chat, Favorites, Badges) are not lost during reprocessing
+	// This ensures moderator-added reasons (Chat) are not lost during reprocessing
 	if params.ExistingUsers != nil {
 		for userID, existingUser := range params.ExistingUsers {
 			if existingUser.Reasons == nil {
@@ -148,6 +172,22 @@ func (c *UserChecker) ProcessUsers(ctx context.Context, params *UserCheckerParam
 		c.logger.Error("Failed to process condo checker", zap.Error(err))
 	}
 
+	// Process game checker
+	if err := c.gameChecker.ProcessUsers(ctxWithTimeout, &GameCheckerParams{
+		Users:      params.Users,
+		ReasonsMap: reasonsMap,
+	}); err != nil {
+		c.logger.Error("Failed to process game checker", zap.Error(err))
+	}
+
+	// Process badge checker
+	if err := c.badgeChecker.ProcessUsers(ctxWithTimeout, &BadgeCheckerParams{
+		Users:      params.Users,
+		ReasonsMap: reasonsMap,
+	}); err != nil {
+		c.logger.Error("Failed to process badge checker", zap.Error(err))
+	}
+
 	// Prepare user info maps
 	translatedInfos, originalInfos := c.prepareUserInfoMaps(ctxWithTimeout, params.Users)
 
@@ -175,6 +215,14 @@ func (c *UserChecker) ProcessUsers(ctx context.Context, params *UserCheckerParam
 		)
 	}
 
+	// Process approved detection rules after the AI profile reasons so their matches are merged
+	if err := c.ruleChecker.ProcessUsers(ctxWithTimeout, &RuleCheckerParams{
+		Users:      params.Users,
+		ReasonsMap: reasonsMap,
+	}); err != nil {
+		c.logger.Error("Failed to process rule checker", zap.Error(err))
+	}
+
 	// Process outfit analysis
 	flaggedOutfits, furryUsers := c.outfitAnalyzer.ProcessUsers(ctxWithTimeout, &ai.OutfitAnalyzerParams{
 		Users:                    params.Users,
@@ -182,6 +230,12 @@ func (c *UserChecker) ProcessUsers(ctx context.Context, params *UserCheckerParam
 		InappropriateOutfitFlags: params.InappropriateOutfitFlags,
 	})
 
+	// Process creation analysis
+	c.creationAnalyzer.ProcessUsers(ctxWithTimeout, &ai.CreationAnalyzerParams{
+		Users:      params.Users,
+		ReasonsMap: reasonsMap,
+	})
+
 	// Remove friend-only flags for furry users to prevent false positive cascade
 	c.removeFurryUserFriendOnlyFlags(reasonsMap, furryUsers)
 
@@ -214,15 +268,25 @@ func (c *UserChecker) ProcessUsers(ctx context.Context, params *UserCheckerParam
 			user.Confidence = utils.CalculateConfidence(reasons)
 			flaggedUsers[user.ID] = user
 			flaggedStatus[user.ID] = struct{}{}
+
+			// Record why the user was flagged
+			span.AddEvent("user flagged", trace.WithAttributes(
+				tracing.UserID(user.ID),
+				attribute.Float64("rotector.confidence", user.Confidence),
+				attribute.StringSlice("rotector.reasons", reasons.Types()),
+			))
 		}
 	}
 
+	span.SetAttributes(tracing.FlaggedCount(len(flaggedUsers)))
+
 	// Classify flagged users into violation categories
 	c.classifyFlaggedUsers(ctxWithTimeout, flaggedUsers)
 
 	// Save flagged users to database
 	if err := c.db.Service().User().SaveUsers(ctx, flaggedUsers); err != nil {
 		c.logger.Error("Failed to save users", zap.Error(err))
+		tracing.RecordError(span, err)
 	}
 
 	// Automatically confirm users with high confidence scores
@@ -240,6 +304,9 @@ func (c *UserChecker) ProcessUsers(ctx context.Context, params *UserCheckerParam
 	// Track flagged users' favorite games
 	go c.trackFavoriteGames(ctx, flaggedUsers)
 
+	// Track flagged users' badges
+	go c.trackFlaggedUsersBadges(ctx, flaggedUsers)
+
 	c.logger.Info("Finished processing users",
 		zap.Int("totalProcessed", len(params.Users)),
 		zap.Int("flaggedUsers", len(flaggedUsers)),
@@ -511,9 +578,15 @@ func (c *UserChecker) autoConfirmHighConfidenceUsers(
 func (c *UserChecker) syncFlaggedUsersToD1(
 	ctx context.Context, flaggedUsers map[int64]*types.ReviewUser, confirmedUsers map[int64]*types.ReviewUser,
 ) {
+	ctx, span := tracing.Start(ctx, "UserChecker.syncFlaggedUsersToD1",
+		tracing.FlaggedCount(len(flaggedUsers)),
+		attribute.Int("rotector.confirmed_count", len(confirmedUsers)))
+	defer span.End()
+
 	if len(flaggedUsers) > 0 {
 		if err := c.cfClient.UserFlags.AddUsersWithStatus(ctx, flaggedUsers); err != nil {
 			c.logger.Error("Failed to add flagged users to D1", zap.Error(err))
+			tracing.RecordError(span, err)
 		}
 	}
 
@@ -631,22 +704,12 @@ func (c *UserChecker) trackOutfitAssets(
 func (c *UserChecker) trackFavoriteGames(ctx context.Context, flaggedUsers map[int64]*types.ReviewUser) {
 	gameUsersTracking := make(map[int64][]int64)
 
-	// Fetch favorite games for each flagged user
-	for userID := range flaggedUsers {
-		favorites, err := c.gameFetcher.FetchFavoriteGames(ctx, userID)
-		if err != nil {
-			c.logger.Error("Failed to fetch favorite games for user",
-				zap.Int64("userID", userID),
-				zap.Error(err))
-
-			continue
-		}
-
+	// Collect favorite games for flagged users
+	for userID, user := range flaggedUsers {
 		// Track games that meet the visit threshold
-		for _, game := range favorites {
+		// NOTE: game IDs here are universe IDs which is what the reputation lookups expect
+		for _, game := range user.Favorites {
 			if game.PlaceVisits <= c.app.Config.Worker.ThresholdLimits.MaxGameVisitsTrack {
-				// NOTE: just realized we're using the universe ID and not the root place ID
-				// RIP!!!!!! wtf roblox
 				gameUsersTracking[game.ID] = append(gameUsersTracking[game.ID], userID)
 			}
 		}
@@ -660,6 +723,30 @@ func (c *UserChecker) trackFavoriteGames(ctx context.Context, flaggedUsers map[i
 	}
 }
 
+// trackFlaggedUsersBadges adds flagged users' badges to tracking.
+func (c *UserChecker) trackFlaggedUsersBadges(ctx context.Context, flaggedUsers map[int64]*types.ReviewUser) {
+	badgeUsersTracking := make(map[int64][]int64)
+	badgeInfos := make(map[int64]*types.BadgeInfo)
+
+	// Collect badges for flagged users
+	for userID, user := range flaggedUsers {
+		// Track badges that meet the awarded threshold
+		for _, badge := range user.Badges {
+			if badge.AwardedCount <= c.app.Config.Worker.ThresholdLimits.MaxBadgeAwardedTrack {
+				badgeUsersTracking[badge.ID] = append(badgeUsersTracking[badge.ID], userID)
+				badgeInfos[badge.ID] = badge
+			}
+		}
+	}
+
+	// Add to tracking if we have any data
+	if len(badgeUsersTracking) > 0 {
+		if err := c.db.Model().Tracking().AddBadgesToTracking(ctx, badgeUsersTracking, badgeInfos); err != nil {
+			c.logger.Error("Failed to add flagged users to badges tracking", zap.Error(err))
+		}
+	}
+}
+
 // meetsAutoConfirmationCriteria validates eligibility for automatic user confirmation.
 func (c *UserChecker) meetsAutoConfirmationCriteria(user *types.ReviewUser) bool {
 	if user.Status != enum.UserTypeFlagged || user.Confidence < 0.90 || user.Reasons == nil || len(user.Reasons) < 2 {
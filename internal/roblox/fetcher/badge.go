@@ -0,0 +1,143 @@
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/bytedance/sonic"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/pkg/utils"
+	"go.uber.org/zap"
+)
+
+// ErrUnexpectedBadgeStatus is returned when the badges endpoint responds with a non-OK status.
+var ErrUnexpectedBadgeStatus = errors.New("unexpected status code from badges endpoint")
+
+// badgeResponse is the raw response of the user badges endpoint.
+type badgeResponse struct {
+	NextPageCursor string `json:"nextPageCursor"`
+	Data           []struct {
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Awarder     struct {
+			ID   int64  `json:"id"`
+			Type string `json:"type"`
+		} `json:"awarder"`
+		AwardingUniverse *struct {
+			ID int64 `json:"id"`
+		} `json:"awardingUniverse"`
+		Statistics struct {
+			AwardedCount int64 `json:"awardedCount"`
+		} `json:"statistics"`
+	} `json:"data"`
+}
+
+// BadgeFetcher handles retrieval of user badge information from the Roblox API.
+type BadgeFetcher struct {
+	client   *client.Client
+	logger   *zap.Logger
+	maxPages int
+}
+
+// NewBadgeFetcher creates a BadgeFetcher with the provided HTTP client and logger.
+// At most maxPages pages of recent badges are fetched per user, and at least one.
+func NewBadgeFetcher(client *client.Client, maxPages int, logger *zap.Logger) *BadgeFetcher {
+	return &BadgeFetcher{
+		client:   client,
+		logger:   logger.Named("badge_fetcher"),
+		maxPages: max(maxPages, 1),
+	}
+}
+
+// FetchUserBadges retrieves the most recently awarded badges for a user.
+func (b *BadgeFetcher) FetchUserBadges(ctx context.Context, userID int64) ([]*types.BadgeInfo, error) {
+	var (
+		allBadges  = make([]*types.BadgeInfo, 0, 100)
+		cursor     string
+		normalizer = utils.NewTextNormalizer()
+	)
+
+	for range b.maxPages {
+		response, err := b.fetchBadgePage(ctx, userID, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		// Append badges from this page
+		for _, badge := range response.Data {
+			// Only place awarded badges can be linked back to games
+			if badge.Awarder.Type != "Place" {
+				continue
+			}
+
+			info := &types.BadgeInfo{
+				ID:           badge.ID,
+				Name:         normalizer.Normalize(badge.Name),
+				Description:  normalizer.Normalize(badge.Description),
+				AwarderID:    badge.Awarder.ID,
+				AwardedCount: badge.Statistics.AwardedCount,
+			}
+			if badge.AwardingUniverse != nil {
+				info.UniverseID = badge.AwardingUniverse.ID
+			}
+
+			allBadges = append(allBadges, info)
+		}
+
+		// Check if there are more pages
+		if response.NextPageCursor == "" {
+			break
+		}
+
+		cursor = response.NextPageCursor
+	}
+
+	b.logger.Debug("Finished fetching user badges",
+		zap.Int64("userID", userID),
+		zap.Int("totalBadges", len(allBadges)))
+
+	return allBadges, nil
+}
+
+// fetchBadgePage retrieves a single page of a user's badges.
+func (b *BadgeFetcher) fetchBadgePage(ctx context.Context, userID int64, cursor string) (*badgeResponse, error) {
+	req := b.client.NewRequest().
+		Method(http.MethodGet).
+		URL("https://badges.roblox.com/v1/users/"+strconv.FormatInt(userID, 10)+"/badges").
+		Query("limit", "100").
+		Query("sortOrder", "Desc")
+
+	// Add cursor if we're not on the first page
+	if cursor != "" {
+		req = req.Query("cursor", cursor)
+	}
+
+	resp, err := req.Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Read and parse the response
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: %d (userID=%d)", ErrUnexpectedBadgeStatus, resp.StatusCode, userID)
+	}
+
+	var response badgeResponse
+	if err := sonic.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse badges response: %w", err)
+	}
+
+	return &response, nil
+}
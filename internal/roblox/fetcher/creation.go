@@ -0,0 +1,259 @@
+package fetcher
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/bytedance/sonic"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api"
+	"github.com/jaxron/roapi.go/pkg/api/resources/thumbnails"
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/pkg/utils"
+	"github.com/sourcegraph/conc/pool"
+	"go.uber.org/zap"
+)
+
+const (
+	// maxOwnedGroupsChecked limits how many owned groups have their places fetched.
+	maxOwnedGroupsChecked = 5
+	// ownerRoleRank is the rank of a group's owner role.
+	ownerRoleRank = 255
+)
+
+// catalogResponse is the raw response of the catalog search endpoint.
+type catalogResponse struct {
+	Data []struct {
+		ID          int64  `json:"id"`
+		ItemType    string `json:"itemType"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	} `json:"data"`
+}
+
+// CreationFetcher handles retrieval of content created by users from the Roblox API.
+type CreationFetcher struct {
+	client           *client.Client
+	thumbnailFetcher *ThumbnailFetcher
+	logger           *zap.Logger
+}
+
+// NewCreationFetcher creates a CreationFetcher with the provided API client and logger.
+func NewCreationFetcher(roAPI *api.API, logger *zap.Logger) *CreationFetcher {
+	return &CreationFetcher{
+		client:           roAPI.GetClient(),
+		thumbnailFetcher: NewThumbnailFetcher(roAPI, logger),
+		logger:           logger.Named("creation_fetcher"),
+	}
+}
+
+// FetchCreations retrieves the places a user created, the places owned by groups
+// the user owns, and the catalog items the user uploaded, along with their thumbnails.
+func (c *CreationFetcher) FetchCreations(
+	ctx context.Context, userID int64, games []*apiTypes.Game, groups []*apiTypes.UserGroupRoles,
+) []*types.CreationInfo {
+	var (
+		normalizer = utils.NewTextNormalizer()
+		places     = make([]*types.CreationInfo, 0, len(games))
+		assets     []*types.CreationInfo
+		p          = pool.New().WithContext(ctx)
+		mu         sync.Mutex
+	)
+
+	// Add the user's own places
+	for _, game := range games {
+		places = append(places, &types.CreationInfo{
+			ID:          game.ID,
+			Type:        types.CreationTypePlace,
+			Name:        normalizer.Normalize(game.Name),
+			Description: normalizer.Normalize(game.Description),
+		})
+	}
+
+	// Fetch places of groups the user owns
+	ownedGroups := 0
+
+	for _, group := range groups {
+		if group.Role.Rank != ownerRoleRank {
+			continue
+		}
+
+		if ownedGroups >= maxOwnedGroupsChecked {
+			break
+		}
+
+		ownedGroups++
+
+		p.Go(func(ctx context.Context) error {
+			groupPlaces, err := c.fetchGroupPlaces(ctx, group.Group.ID)
+			if err != nil {
+				c.logger.Warn("Failed to fetch group places",
+					zap.Error(err),
+					zap.Int64("groupID", group.Group.ID))
+
+				return nil
+			}
+
+			mu.Lock()
+
+			places = append(places, groupPlaces...)
+
+			mu.Unlock()
+
+			return nil
+		})
+	}
+
+	// Fetch the user's catalog items
+	p.Go(func(ctx context.Context) error {
+		var err error
+
+		assets, err = c.fetchCatalogItems(ctx, userID)
+		if err != nil {
+			c.logger.Warn("Failed to fetch user catalog items",
+				zap.Error(err),
+				zap.Int64("userID", userID))
+		}
+
+		return nil
+	})
+
+	// Wait for all fetches to complete
+	_ = p.Wait()
+
+	// Attach thumbnails to places and assets
+	c.addThumbnails(ctx, places, apiTypes.GameIconType)
+	c.addThumbnails(ctx, assets, apiTypes.AssetThumbnailType)
+
+	creations := make([]*types.CreationInfo, 0, len(places)+len(assets))
+	creations = append(creations, places...)
+	creations = append(creations, assets...)
+
+	c.logger.Debug("Finished fetching user creations",
+		zap.Int64("userID", userID),
+		zap.Int("places", len(places)),
+		zap.Int("assets", len(assets)))
+
+	return creations
+}
+
+// fetchGroupPlaces retrieves the public places owned by a group.
+func (c *CreationFetcher) fetchGroupPlaces(ctx context.Context, groupID int64) ([]*types.CreationInfo, error) {
+	resp, err := c.client.NewRequest().
+		Method(http.MethodGet).
+		URL("https://games.roblox.com/v2/groups/"+strconv.FormatInt(groupID, 10)+"/gamesV2").
+		Query("accessFilter", "Public").
+		Query("limit", "50").
+		Query("sortOrder", "Desc").
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Read and parse the response
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response apiTypes.GameResponse
+	if err := sonic.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse group games response: %w", err)
+	}
+
+	normalizer := utils.NewTextNormalizer()
+	places := make([]*types.CreationInfo, 0, len(response.Data))
+
+	for _, game := range response.Data {
+		places = append(places, &types.CreationInfo{
+			ID:          game.ID,
+			Type:        types.CreationTypePlace,
+			Name:        normalizer.Normalize(game.Name),
+			Description: normalizer.Normalize(game.Description),
+			GroupID:     groupID,
+		})
+	}
+
+	return places, nil
+}
+
+// fetchCatalogItems retrieves the most recently updated catalog items uploaded by a user.
+func (c *CreationFetcher) fetchCatalogItems(ctx context.Context, userID int64) ([]*types.CreationInfo, error) {
+	resp, err := c.client.NewRequest().
+		Method(http.MethodGet).
+		URL("https://catalog.roblox.com/v1/search/items/details").
+		Query("Category", "All").
+		Query("CreatorType", "User").
+		Query("CreatorTargetId", strconv.FormatInt(userID, 10)).
+		Query("SortType", "3").
+		Query("Limit", "30").
+		Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// Read and parse the response
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response catalogResponse
+	if err := sonic.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse catalog response: %w", err)
+	}
+
+	normalizer := utils.NewTextNormalizer()
+	assets := make([]*types.CreationInfo, 0, len(response.Data))
+
+	for _, item := range response.Data {
+		// Bundles are made of assets and are not uploaded by regular users
+		if item.ItemType != "Asset" {
+			continue
+		}
+
+		assets = append(assets, &types.CreationInfo{
+			ID:          item.ID,
+			Type:        types.CreationTypeAsset,
+			Name:        normalizer.Normalize(item.Name),
+			Description: normalizer.Normalize(item.Description),
+		})
+	}
+
+	return assets, nil
+}
+
+// addThumbnails fetches and attaches thumbnail URLs to the given creations.
+func (c *CreationFetcher) addThumbnails(
+	ctx context.Context, creations []*types.CreationInfo, thumbnailType apiTypes.ThumbnailType,
+) {
+	if len(creations) == 0 {
+		return
+	}
+
+	requests := thumbnails.NewBatchThumbnailsBuilder()
+	for _, creation := range creations {
+		requests.AddRequest(apiTypes.ThumbnailRequest{
+			Type:      thumbnailType,
+			TargetID:  creation.ID,
+			RequestID: strconv.FormatInt(creation.ID, 10),
+			Size:      apiTypes.Size150x150,
+			Format:    apiTypes.WEBP,
+		})
+	}
+
+	thumbnailMap := c.thumbnailFetcher.ProcessBatchThumbnails(ctx, requests)
+
+	for _, creation := range creations {
+		if url, ok := thumbnailMap[creation.ID]; ok && url != ThumbnailPlaceholder {
+			creation.ThumbnailURL = url
+		}
+	}
+}
@@ -128,3 +128,38 @@ func (g *GameFetcher) FetchFavoriteGames(ctx context.Context, userID int64) ([]*
 
 	return allGames, nil
 }
+
+// FetchGameDetails retrieves detailed information for multiple games by their universe IDs.
+func (g *GameFetcher) FetchGameDetails(ctx context.Context, universeIDs []int64) []*types.GameDetailResponse {
+	var (
+		details    = make([]*types.GameDetailResponse, 0, len(universeIDs))
+		normalizer = utils.NewTextNormalizer()
+	)
+
+	// Process in batches of 100 (API limit)
+	for i := 0; i < len(universeIDs); i += 100 {
+		end := min(i+100, len(universeIDs))
+
+		response, err := g.roAPI.Games().GetGamesByUniverseIDs(ctx, universeIDs[i:end])
+		if err != nil {
+			g.logger.Error("Error fetching game details",
+				zap.Int("batchSize", end-i),
+				zap.Error(err))
+
+			continue // Don't fail the whole request for one batch
+		}
+
+		for _, game := range response.Data {
+			normalizedGame := game
+			normalizedGame.Name = normalizer.Normalize(game.Name)
+			normalizedGame.Description = normalizer.Normalize(game.Description)
+			details = append(details, &normalizedGame)
+		}
+	}
+
+	g.logger.Debug("Finished fetching game details",
+		zap.Int("requestedGames", len(universeIDs)),
+		zap.Int("fetchedGames", len(details)))
+
+	return details
+}
@@ -0,0 +1,226 @@
+package fetcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/bytedance/sonic"
+	"github.com/jaxron/axonet/pkg/client"
+	"github.com/jaxron/roapi.go/pkg/api"
+	"github.com/jaxron/roapi.go/pkg/api/resources/groups"
+	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
+	"go.uber.org/zap"
+)
+
+const (
+	// MaxAlliesExpanded limits how many allied groups are enumerated per group.
+	MaxAlliesExpanded = 10
+	// groupMembersPageSize is the number of members fetched per request.
+	groupMembersPageSize = 100
+)
+
+var (
+	// ErrGroupTooLarge indicates a group has more members than may be tracked.
+	ErrGroupTooLarge = errors.New("group has too many members")
+	// ErrGroupLocked indicates a group is locked and its members cannot be listed.
+	ErrGroupLocked = errors.New("group is locked")
+	// ErrExpansionLimit indicates an allied group was skipped as the expansion already has enough members.
+	ErrExpansionLimit = errors.New("expansion member limit reached")
+)
+
+// alliesResponse is the raw response of the group allies endpoint.
+type alliesResponse struct {
+	RelatedGroups []struct {
+		ID          int64  `json:"id"`
+		Name        string `json:"name"`
+		MemberCount int64  `json:"memberCount"`
+	} `json:"relatedGroups"`
+	NextRowIndex int64 `json:"nextRowIndex"`
+}
+
+// GroupExpansion contains the members found by expanding a group and its allies.
+type GroupExpansion struct {
+	Members map[int64]int64 // User ID -> ID of the group the user was found in
+	Groups  []int64         // Groups whose members were enumerated
+	Skipped map[int64]error // Allied groups that could not be enumerated
+}
+
+// GroupMemberFetcher handles enumeration of group members and allied groups from the Roblox API.
+type GroupMemberFetcher struct {
+	roAPI  *api.API
+	client *client.Client
+	logger *zap.Logger
+}
+
+// NewGroupMemberFetcher creates a GroupMemberFetcher with the provided API client and logger.
+func NewGroupMemberFetcher(roAPI *api.API, logger *zap.Logger) *GroupMemberFetcher {
+	return &GroupMemberFetcher{
+		roAPI:  roAPI,
+		client: roAPI.GetClient(),
+		logger: logger.Named("group_member_fetcher"),
+	}
+}
+
+// Expand enumerates the members of a group and optionally its allies. Groups with
+// more than maxMembers members are not enumerated. If maxTotal is positive, allied
+// groups that would take the expansion past maxTotal members are skipped. An error
+// is only returned if the requested group itself cannot be enumerated.
+func (g *GroupMemberFetcher) Expand(
+	ctx context.Context, groupID int64, includeAllies bool, maxMembers int64, maxTotal int64,
+) (*GroupExpansion, error) {
+	expansion := &GroupExpansion{
+		Members: make(map[int64]int64),
+		Skipped: make(map[int64]error),
+	}
+
+	groupIDs := []int64{groupID}
+
+	if includeAllies {
+		allies, err := g.FetchAllies(ctx, groupID, MaxAlliesExpanded)
+		if err != nil {
+			g.logger.Warn("Failed to fetch allied groups",
+				zap.Error(err),
+				zap.Int64("groupID", groupID))
+		}
+
+		groupIDs = append(groupIDs, allies...)
+	}
+
+	for _, id := range groupIDs {
+		limit := maxMembers
+
+		if maxTotal > 0 {
+			remaining := maxTotal - int64(len(expansion.Members))
+			if remaining <= 0 {
+				expansion.Skipped[id] = ErrExpansionLimit
+				continue
+			}
+
+			if limit <= 0 || remaining < limit {
+				limit = remaining
+			}
+		}
+
+		members, err := g.FetchMembers(ctx, id, limit)
+		if err != nil {
+			if id == groupID {
+				return nil, err
+			}
+
+			expansion.Skipped[id] = err
+
+			continue
+		}
+
+		// Attribute users in several groups to the first group they were found in
+		for _, userID := range members {
+			if _, exists := expansion.Members[userID]; !exists {
+				expansion.Members[userID] = id
+			}
+		}
+
+		expansion.Groups = append(expansion.Groups, id)
+	}
+
+	g.logger.Debug("Expanded group members",
+		zap.Int64("groupID", groupID),
+		zap.Int("groups", len(expansion.Groups)),
+		zap.Int("members", len(expansion.Members)))
+
+	return expansion, nil
+}
+
+// FetchMembers retrieves the IDs of all members of a group.
+// Returns ErrGroupTooLarge if the group has more than maxMembers members.
+func (g *GroupMemberFetcher) FetchMembers(ctx context.Context, groupID int64, maxMembers int64) ([]int64, error) {
+	info, err := g.roAPI.Groups().GetGroupInfo(ctx, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch group %d: %w", groupID, err)
+	}
+
+	if info.IsLocked != nil && *info.IsLocked {
+		return nil, fmt.Errorf("%w: %d", ErrGroupLocked, groupID)
+	}
+
+	if maxMembers > 0 && info.MemberCount > maxMembers {
+		return nil, fmt.Errorf("%w: group %d has %d members (limit %d)",
+			ErrGroupTooLarge, groupID, info.MemberCount, maxMembers)
+	}
+
+	members := make([]int64, 0, info.MemberCount)
+	cursor := ""
+
+	for {
+		builder := groups.NewGroupUsersBuilder(groupID).WithLimit(groupMembersPageSize).WithCursor(cursor)
+
+		page, err := g.roAPI.Groups().GetGroupUsers(ctx, builder.Build())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch members of group %d: %w", groupID, err)
+		}
+
+		for _, member := range page.Data {
+			members = append(members, member.User.UserID)
+		}
+
+		if page.NextPageCursor == nil || *page.NextPageCursor == "" {
+			break
+		}
+
+		cursor = *page.NextPageCursor
+	}
+
+	return members, nil
+}
+
+// FetchAllies retrieves the IDs of up to limit groups allied with a group.
+func (g *GroupMemberFetcher) FetchAllies(ctx context.Context, groupID int64, limit int) ([]int64, error) {
+	var (
+		allies   []int64
+		rowIndex int64
+	)
+
+	for len(allies) < limit {
+		resp, err := g.client.NewRequest().
+			Method(http.MethodGet).
+			URL(fmt.Sprintf("%s/v1/groups/%d/relationships/allies", apiTypes.GroupsEndpoint, groupID)).
+			Query("model.startRowIndex", strconv.FormatInt(rowIndex, 10)).
+			Query("model.maxRows", "100").
+			Do(ctx)
+		if err != nil {
+			return allies, err
+		}
+
+		// Read and parse the response
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		if err != nil {
+			return allies, err
+		}
+
+		var response alliesResponse
+		if err := sonic.Unmarshal(body, &response); err != nil {
+			return allies, fmt.Errorf("failed to parse group allies response: %w", err)
+		}
+
+		for _, ally := range response.RelatedGroups {
+			if len(allies) >= limit {
+				break
+			}
+
+			allies = append(allies, ally.ID)
+		}
+
+		if len(response.RelatedGroups) == 0 || response.NextRowIndex <= rowIndex {
+			break
+		}
+
+		rowIndex = response.NextRowIndex
+	}
+
+	return allies, nil
+}
@@ -9,8 +9,10 @@ import (
 	apiTypes "github.com/jaxron/roapi.go/pkg/api/types"
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/setup/telemetry/tracing"
 	"github.com/robalyx/rotector/pkg/utils"
 	"github.com/sourcegraph/conc/pool"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -19,9 +21,12 @@ type UserFetchResult struct {
 	Groups        []*apiTypes.UserGroupRoles
 	Friends       []*apiTypes.ExtendedFriend
 	Games         []*apiTypes.Game
+	Favorites     []*apiTypes.Game
 	Outfits       []*apiTypes.Outfit
 	OutfitAssets  map[int64][]*apiTypes.AssetV2
 	CurrentAssets []*apiTypes.AssetV2
+	Badges        []*types.BadgeInfo
+	Creations     []*types.CreationInfo
 }
 
 // UserFetcher handles concurrent retrieval of user information from the Roblox API.
@@ -34,10 +39,14 @@ type UserFetcher struct {
 	outfitFetcher    *OutfitFetcher
 	thumbnailFetcher *ThumbnailFetcher
 	inventoryFetcher *InventoryFetcher
+	badgeFetcher     *BadgeFetcher
+	creationFetcher  *CreationFetcher
 }
 
 // NewUserFetcher creates a UserFetcher with the provided API client and logger.
 func NewUserFetcher(app *setup.App, logger *zap.Logger) *UserFetcher {
+	limits := app.Config.Worker.ThresholdLimits
+
 	return &UserFetcher{
 		roAPI:            app.RoAPI,
 		logger:           logger.Named("user_fetcher"),
@@ -47,11 +56,17 @@ func NewUserFetcher(app *setup.App, logger *zap.Logger) *UserFetcher {
 		outfitFetcher:    NewOutfitFetcher(app.RoAPI, logger),
 		thumbnailFetcher: NewThumbnailFetcher(app.RoAPI, logger),
 		inventoryFetcher: NewInventoryFetcher(app.RoAPI, logger),
+		badgeFetcher:     NewBadgeFetcher(app.RoAPI.GetClient(), limits.MaxBadgePages, logger),
+		creationFetcher:  NewCreationFetcher(app.RoAPI, logger),
 	}
 }
 
 // FetchInfos retrieves complete user information for a batch of user IDs.
 func (u *UserFetcher) FetchInfos(ctx context.Context, userIDs []int64) []*types.ReviewUser {
+	ctx, span := tracing.Start(ctx, "UserFetcher.FetchInfos",
+		tracing.BatchSize(len(userIDs)), tracing.UserIDs(userIDs))
+	defer span.End()
+
 	var (
 		validUsers = make([]*types.ReviewUser, 0, len(userIDs))
 		userMap    = make(map[int64]*types.User)
@@ -107,8 +122,9 @@ func (u *UserFetcher) FetchInfos(ctx context.Context, userIDs []int64) []*types.
 				OutfitAssets:  fetchResult.OutfitAssets,
 				CurrentAssets: fetchResult.CurrentAssets,
 				Inventory:     []*apiTypes.InventoryAsset{},
-				Favorites:     []*apiTypes.Game{},
-				Badges:        []any{},
+				Favorites:     fetchResult.Favorites,
+				Badges:        fetchResult.Badges,
+				Creations:     fetchResult.Creations,
 			}
 
 			mu.Lock()
@@ -146,6 +162,8 @@ func (u *UserFetcher) FetchInfos(ctx context.Context, userIDs []int64) []*types.
 		zap.Int("totalRequested", len(userIDs)),
 		zap.Int("successfulFetches", len(validUsers)))
 
+	span.SetAttributes(attribute.Int("rotector.fetched_count", len(validUsers)))
+
 	return validUsers
 }
 
@@ -195,7 +213,7 @@ func (u *UserFetcher) FetchBannedUsers(ctx context.Context, userIDs []int64) ([]
 	return results, nil
 }
 
-// fetchUserData retrieves a user's group memberships, friend list, and games concurrently.
+// fetchUserData retrieves a user's group memberships, friend list, games, favorites, and badges concurrently.
 func (u *UserFetcher) fetchUserData(ctx context.Context, userID int64) *UserFetchResult {
 	result := &UserFetchResult{
 		OutfitAssets: make(map[int64][]*apiTypes.AssetV2),
@@ -244,6 +262,34 @@ func (u *UserFetcher) fetchUserData(ctx context.Context, userID int64) *UserFetc
 		return nil
 	})
 
+	// Fetch user's favorite games
+	p.Go(func(ctx context.Context) error {
+		var err error
+
+		result.Favorites, err = u.gameFetcher.FetchFavoriteGames(ctx, userID)
+		if err != nil {
+			u.logger.Warn("Failed to fetch user favorite games",
+				zap.Error(err),
+				zap.Int64("userID", userID))
+		}
+
+		return nil
+	})
+
+	// Fetch user's badges
+	p.Go(func(ctx context.Context) error {
+		var err error
+
+		result.Badges, err = u.badgeFetcher.FetchUserBadges(ctx, userID)
+		if err != nil {
+			u.logger.Warn("Failed to fetch user badges",
+				zap.Error(err),
+				zap.Int64("userID", userID))
+		}
+
+		return nil
+	})
+
 	// Fetch user's outfits
 	p.Go(func(ctx context.Context) error {
 		outfits, currentAssets, err := u.outfitFetcher.GetOutfits(ctx, userID)
@@ -279,5 +325,8 @@ func (u *UserFetcher) fetchUserData(ctx context.Context, userID int64) *UserFetc
 	// Wait for all fetches to complete
 	_ = p.Wait()
 
+	// Fetch user's creations which depend on their games and groups
+	result.Creations = u.creationFetcher.FetchCreations(ctx, userID, result.Games, result.Groups)
+
 	return result
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/jaxron/axonet/pkg/client/logger"
 	"github.com/jaxron/axonet/pkg/client/middleware"
 	"github.com/redis/rueidis"
+	"github.com/robalyx/rotector/internal/metrics"
 	"github.com/robalyx/rotector/internal/setup/client/interceptor/interceptorutil"
 	"github.com/robalyx/rotector/internal/setup/client/interceptor/proxy/scripts"
 	"github.com/robalyx/rotector/internal/setup/config"
@@ -184,8 +185,12 @@ func (m *Middleware) tryProxy(ctx context.Context, httpClient *http.Client, req
 	proxyClient := m.applyProxyToClient(httpClient, proxy)
 
 	// Make the request
+	start := time.Now()
+
 	resp, err := proxyClient.Do(req)
 	if err != nil {
+		metrics.ObserveRobloxRequest(endpoint, "proxy", proxy.Host, 0, err, time.Since(start))
+
 		// Check if error is due to context cancellation/timeout
 		if ctx.Err() != nil {
 			return nil, err
@@ -199,6 +204,8 @@ func (m *Middleware) tryProxy(ctx context.Context, httpClient *http.Client, req
 		return nil, err
 	}
 
+	metrics.ObserveRobloxRequest(endpoint, "proxy", proxy.Host, resp.StatusCode, nil, time.Since(start))
+
 	// Check if the response indicates success
 	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
 		m.logger.WithFields(
@@ -16,7 +16,9 @@ import (
 	"github.com/jaxron/axonet/pkg/client/logger"
 	"github.com/jaxron/axonet/pkg/client/middleware"
 	"github.com/redis/rueidis"
+	"github.com/robalyx/rotector/internal/metrics"
 	"github.com/robalyx/rotector/internal/setup/client/interceptor/interceptorutil"
+	"github.com/robalyx/rotector/internal/setup/client/interceptor/proxy"
 	"github.com/robalyx/rotector/internal/setup/client/interceptor/roverse/scripts"
 	"github.com/robalyx/rotector/internal/setup/config"
 	"golang.org/x/sync/semaphore"
@@ -211,7 +213,23 @@ func (m *Middleware) routeRequest(
 	}
 
 	// Make the request
+	endpoint := req.Host + proxy.GetNormalizedPath(req.URL.Path)
+	start := time.Now()
+
 	resp, err := client.Do(proxyReq)
+
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+
+	proxyLabel := proxyHost
+	if proxyLabel == "" {
+		proxyLabel = "direct"
+	}
+
+	metrics.ObserveRobloxRequest(endpoint, "roverse", proxyLabel, statusCode, err, time.Since(start))
+
 	if err != nil {
 		// If it's a timeout error and we're using a proxy, mark it as unhealthy
 		if interceptorutil.IsTimeoutError(err) && proxyIndex >= 0 {
@@ -47,6 +47,7 @@ type CommonConfig struct {
 	Proxy          Proxy          `koanf:"proxy"`
 	Roverse        Roverse        `koanf:"roverse"`
 	Loki           Loki           `koanf:"loki"`
+	Tracing        Tracing        `koanf:"tracing"`
 	Discord        DiscordConfig  `koanf:"discord"`
 }
 
@@ -104,6 +105,8 @@ type WorkerConfig struct {
 	Cloudflare CloudflareConfig `koanf:"cloudflare"`
 	// Queue worker rate limiting configuration
 	QueueRateLimiting QueueRateLimitingConfig `koanf:"queue_rate_limiting"`
+	// Adaptive batch sizing for AI analyzers
+	AdaptiveBatching AdaptiveBatchingConfig `koanf:"adaptive_batching"`
 }
 
 // CloudflareConfig contains Cloudflare D1 and R2 configuration.
@@ -140,6 +143,42 @@ type QueueRateLimitingConfig struct {
 	MaxUsersPerWindow int `koanf:"max_users_per_window"`
 	// Time window duration for rate limiting.
 	WindowDuration time.Duration `koanf:"window_duration"`
+	// Relative share of each window reserved for each priority lane.
+	LaneShares QueueLaneShares `koanf:"lane_shares"`
+	// Time a user must wait before moving up one lane when ordering lanes.
+	AgingInterval time.Duration `koanf:"aging_interval"`
+}
+
+// QueueLaneShares contains the relative window share of each queue priority lane.
+// A lane may borrow the unused share of lanes that have nothing waiting.
+type QueueLaneShares struct {
+	// Users queued by hand through the bot.
+	Reviewer int `koanf:"reviewer"`
+	// Users reported through the browser extension.
+	Extension int `koanf:"extension"`
+	// Automated re-checks of known users.
+	Recheck int `koanf:"recheck"`
+	// Bulk imports from the queue CLI.
+	Bulk int `koanf:"bulk"`
+}
+
+// AdaptiveBatchingConfig contains bounds for the adaptive AI batch size and concurrency controller.
+// Analyzers start from their configured batch sizes and adjust within these bounds.
+type AdaptiveBatchingConfig struct {
+	// Enable adaptive adjustment. When disabled, the configured batch sizes are used as-is.
+	Enabled bool `koanf:"enabled"`
+	// Minimum number of items per AI request.
+	MinBatchSize int `koanf:"min_batch_size"`
+	// Maximum number of items per AI request. Zero uses each analyzer's configured batch size.
+	MaxBatchSize int `koanf:"max_batch_size"`
+	// Minimum concurrent AI requests per analyzer.
+	MinConcurrency int `koanf:"min_concurrency"`
+	// Maximum concurrent AI requests per analyzer. Zero uses each analyzer's configured concurrency.
+	MaxConcurrency int `koanf:"max_concurrency"`
+	// Request latency above which the batch size shrinks.
+	TargetLatency time.Duration `koanf:"target_latency"`
+	// Fraction of the max completion tokens above which the batch size shrinks.
+	TokenBudgetRatio float64 `koanf:"token_budget_ratio"`
 }
 
 // Debug contains debug-related configuration.
@@ -154,6 +193,12 @@ type Debug struct {
 	EnablePprof bool `koanf:"enable_pprof"`
 	// pprof server port.
 	PprofPort int `koanf:"pprof_port"`
+	// Enable Prometheus metrics endpoint.
+	EnableMetrics bool `koanf:"enable_metrics"`
+	// Metrics server host.
+	MetricsHost string `koanf:"metrics_host"`
+	// Metrics server port.
+	MetricsPort int `koanf:"metrics_port"`
 }
 
 // CircuitBreaker contains circuit breaker configuration.
@@ -306,8 +351,12 @@ type BatchSizes struct {
 	TrackGroups int `koanf:"track_groups"`
 	// Number of users to check for new groups in one batch.
 	TrackUserGroups int `koanf:"track_user_groups"`
+	// Number of game trackings to process in one batch.
+	TrackGames int `koanf:"track_games"`
 	// Number of queue items to process in one batch.
 	QueueItems int `koanf:"queue_items"`
+	// Number of users due for a rescan to add to the recheck lane in one batch.
+	RescanUsers int `koanf:"rescan_users"`
 	// Number of users to update thumbnails in one batch.
 	ThumbnailUsers int `koanf:"thumbnail_users"`
 	// Number of groups to update thumbnails in one batch.
@@ -358,6 +407,20 @@ type ThresholdLimits struct {
 	MaxGroupMembersTrack int64 `koanf:"max_group_members_track"`
 	// Maximum game visits before skipping tracking.
 	MaxGameVisitsTrack int64 `koanf:"max_game_visits_track"`
+	// Minimum number of flagged users favoriting a game to consider it suspected.
+	MinGameFlaggedUsers int `koanf:"min_game_flagged_users"`
+	// Minimum flagged favoriters per 1,000 visits needed to mark a game as suspected.
+	MinGameFlaggedDensity float64 `koanf:"min_game_flagged_density"`
+	// Mark game as suspected if flagged favoriters exceed this value, regardless of density.
+	MinGameFlaggedOverride int `koanf:"min_game_flagged_override"`
+	// Maximum times a badge can be awarded before skipping tracking.
+	MaxBadgeAwardedTrack int64 `koanf:"max_badge_awarded_track"`
+	// Minimum number of flagged users holding a badge to consider it suspected.
+	MinBadgeFlaggedHolders int `koanf:"min_badge_flagged_holders"`
+	// Minimum flagged holders per 1,000 awards needed to mark a badge as suspected.
+	MinBadgeFlaggedDensity float64 `koanf:"min_badge_flagged_density"`
+	// Maximum pages of 100 recent badges fetched per user.
+	MaxBadgePages int `koanf:"max_badge_pages"`
 	// Hamming distance threshold for considering outfit images as similar.
 	ImageSimilarityThreshold int `koanf:"image_similarity_threshold"`
 	// Number of messages to accumulate before processing a channel.
@@ -418,6 +481,22 @@ type Loki struct {
 	Password string `koanf:"password"`
 }
 
+// Tracing contains OpenTelemetry tracing configuration.
+type Tracing struct {
+	// Enable OpenTelemetry tracing
+	Enabled bool `koanf:"enabled"`
+	// OTLP HTTP collector endpoint (host:port), empty disables the OTLP exporter
+	Endpoint string `koanf:"endpoint"`
+	// Use plain HTTP instead of HTTPS for the OTLP exporter
+	Insecure bool `koanf:"insecure"`
+	// Headers added to OTLP export requests (e.g. authentication)
+	Headers map[string]string `koanf:"headers"`
+	// Fraction of traces to sample between 0 and 1, where 0 or unset samples every trace
+	SampleRatio float64 `koanf:"sample_ratio"`
+	// Write spans to a traces.jsonl file in the log session directory
+	FileExport bool `koanf:"file_export"`
+}
+
 // LoadConfig loads the configuration from the specified file.
 // Returns the config along with the used config directory.
 func LoadConfig() (*Config, string, error) {
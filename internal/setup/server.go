@@ -0,0 +1,81 @@
+package setup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	// #nosec G108 -- pprof debugging is intentionally enabled only on localhost
+	_ "net/http/pprof"
+	"time"
+
+	"github.com/robalyx/rotector/internal/metrics"
+	"go.uber.org/zap"
+)
+
+// httpServer represents a debug or metrics HTTP server.
+type httpServer struct {
+	name     string
+	srv      *http.Server
+	listener net.Listener
+}
+
+// startPprofServer initializes and starts the pprof HTTP server.
+func startPprofServer(ctx context.Context, port int, logger *zap.Logger) (*httpServer, error) {
+	return startHTTPServer(ctx, "pprof", fmt.Sprintf("localhost:%d", port), http.DefaultServeMux, logger)
+}
+
+// startMetricsServer initializes and starts the Prometheus metrics HTTP server.
+func startMetricsServer(ctx context.Context, host string, port int, logger *zap.Logger) (*httpServer, error) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+
+	return startHTTPServer(ctx, "metrics", net.JoinHostPort(host, fmt.Sprintf("%d", port)), mux, logger)
+}
+
+// startHTTPServer initializes and starts an HTTP server on the given address.
+func startHTTPServer(
+	ctx context.Context, name, addr string, handler http.Handler, logger *zap.Logger,
+) (*httpServer, error) {
+	// Create secure server with timeouts
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           handler,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       120 * time.Second,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	lc := &net.ListenConfig{}
+
+	listener, err := lc.Listen(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create listener: %w", err)
+	}
+
+	// Start server in background
+	go func() {
+		logger.Info("Starting HTTP server", zap.String("server", name), zap.String("address", addr))
+
+		if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("HTTP server failed", zap.String("server", name), zap.Error(err))
+		}
+	}()
+
+	return &httpServer{
+		name:     name,
+		srv:      srv,
+		listener: listener,
+	}, nil
+}
+
+// shutdown gracefully stops the HTTP server.
+func (s *httpServer) shutdown(ctx context.Context, logger *zap.Logger) {
+	if err := s.srv.Shutdown(ctx); err != nil {
+		logger.Error("Failed to shutdown HTTP server", zap.String("server", s.name), zap.Error(err))
+	}
+
+	s.listener.Close()
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/robalyx/rotector/internal/setup/client"
 	"github.com/robalyx/rotector/internal/setup/config"
 	"github.com/robalyx/rotector/internal/setup/telemetry"
+	"github.com/robalyx/rotector/internal/setup/telemetry/tracing"
 	"github.com/uptrace/bun/migrate"
 	"go.uber.org/zap"
 )
@@ -32,7 +33,9 @@ type App struct {
 	StatusClient rueidis.Client      // Redis client for worker status reporting
 	CFClient     *cloudflare.Client  // Cloudflare D1 client for cloudflare operations
 	LogManager   *telemetry.Manager  // Log management system
-	pprofServer  *pprofServer        // Debug HTTP server for pprof
+	pprofServer  *httpServer         // Debug HTTP server for pprof
+	metricsSrv   *httpServer         // HTTP server for Prometheus metrics
+	tracing      *tracing.Provider   // OpenTelemetry tracer provider
 	Middlewares  *client.Middlewares // HTTP client middleware instances
 }
 
@@ -61,6 +64,15 @@ func InitializeApp(ctx context.Context, serviceType telemetry.ServiceType, logDi
 		return nil, err
 	}
 
+	// Tracing is initialized early so that all components produce spans
+	tracingProvider, err := tracing.NewProvider(
+		ctx, &cfg.Common.Tracing, logManager.GetComponentName(),
+		logManager.GetInstanceID(), logManager.GetCurrentSessionDir(),
+	)
+	if err != nil {
+		logger.Error("Failed to initialize tracing", zap.Error(err))
+	}
+
 	// Redis manager provides connection pools for various subsystems
 	redisManager := redis.NewManager(&cfg.Common.Redis, logger)
 
@@ -103,7 +115,7 @@ func InitializeApp(ctx context.Context, serviceType telemetry.ServiceType, logDi
 	}
 
 	// Start pprof server if enabled
-	var pprofSrv *pprofServer
+	var pprofSrv *httpServer
 
 	if cfg.Common.Debug.EnablePprof {
 		srv, err := startPprofServer(ctx, cfg.Common.Debug.PprofPort, logger)
@@ -116,6 +128,18 @@ func InitializeApp(ctx context.Context, serviceType telemetry.ServiceType, logDi
 		}
 	}
 
+	// Start metrics server if enabled
+	var metricsSrv *httpServer
+
+	if cfg.Common.Debug.EnableMetrics {
+		srv, err := startMetricsServer(ctx, cfg.Common.Debug.MetricsHost, cfg.Common.Debug.MetricsPort, logger)
+		if err != nil {
+			logger.Error("Failed to start metrics server", zap.Error(err))
+		} else {
+			metricsSrv = srv
+		}
+	}
+
 	// Bundle all initialized components
 	return &App{
 		Config:       cfg,
@@ -129,6 +153,8 @@ func InitializeApp(ctx context.Context, serviceType telemetry.ServiceType, logDi
 		CFClient:     cfClient,
 		LogManager:   logManager,
 		pprofServer:  pprofSrv,
+		metricsSrv:   metricsSrv,
+		tracing:      tracingProvider,
 		Middlewares:  middlewares,
 	}, nil
 }
@@ -136,13 +162,20 @@ func InitializeApp(ctx context.Context, serviceType telemetry.ServiceType, logDi
 // Cleanup ensures graceful shutdown of all components in reverse initialization order.
 // Logs but does not fail on cleanup errors to ensure all components get cleanup attempts.
 func (s *App) Cleanup(ctx context.Context) {
-	// Shutdown pprof server if running
+	// Shutdown pprof and metrics servers if running
 	if s.pprofServer != nil {
-		if err := s.pprofServer.srv.Shutdown(ctx); err != nil {
-			s.Logger.Error("Failed to shutdown pprof server", zap.Error(err))
-		}
+		s.pprofServer.shutdown(ctx, s.Logger)
+	}
 
-		s.pprofServer.listener.Close()
+	if s.metricsSrv != nil {
+		s.metricsSrv.shutdown(ctx, s.Logger)
+	}
+
+	// Flush pending trace spans
+	if s.tracing != nil {
+		if err := s.tracing.Shutdown(ctx); err != nil {
+			s.Logger.Error("Failed to shutdown tracing", zap.Error(err))
+		}
 	}
 
 	// Sync buffered logs before shutdown
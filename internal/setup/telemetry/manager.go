@@ -183,6 +183,11 @@ func (lm *Manager) GetInstanceID() string {
 	return lm.instanceID
 }
 
+// GetComponentName returns the component identifier for this program run.
+func (lm *Manager) GetComponentName() string {
+	return lm.componentName
+}
+
 // GetImageLogger creates a logger specifically for handling image logging.
 // It creates a dedicated image directory within the current session directory.
 func (lm *Manager) GetImageLogger(name string) (*zap.Logger, string, error) {
@@ -0,0 +1,178 @@
+// Package tracing provides OpenTelemetry tracing for the worker pipelines.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/robalyx/rotector/internal/setup/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// tracerName is the instrumentation scope used for all spans.
+	tracerName = "github.com/robalyx/rotector"
+	// traceFileName is the name of the offline trace file in the log session directory.
+	traceFileName = "traces.jsonl"
+)
+
+// ErrNoExporters indicates tracing is enabled but no exporter is configured.
+var ErrNoExporters = errors.New("tracing enabled but no exporter configured")
+
+// Provider wraps the OpenTelemetry tracer provider and its exporters.
+type Provider struct {
+	tp   *sdktrace.TracerProvider
+	file *os.File
+}
+
+// NewProvider creates a tracer provider with the configured exporters and
+// installs it as the global provider. Returns nil if tracing is disabled.
+func NewProvider(
+	ctx context.Context, cfg *config.Tracing, componentName, instanceID, sessionDir string,
+) (*Provider, error) {
+	if !cfg.Enabled {
+		return nil, nil //nolint:nilnil // nil provider means tracing is disabled
+	}
+
+	provider := &Provider{}
+	opts := make([]sdktrace.TracerProviderOption, 0, 4)
+
+	// Configure OTLP exporter for a remote collector
+	if cfg.Endpoint != "" {
+		exporterOpts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.Endpoint)}
+		if cfg.Insecure {
+			exporterOpts = append(exporterOpts, otlptracehttp.WithInsecure())
+		}
+
+		if len(cfg.Headers) > 0 {
+			exporterOpts = append(exporterOpts, otlptracehttp.WithHeaders(cfg.Headers))
+		}
+
+		exporter, err := otlptracehttp.New(ctx, exporterOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+		}
+
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	// Configure file exporter for offline debugging
+	if cfg.FileExport {
+		file, err := os.OpenFile(
+			filepath.Join(sessionDir, traceFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open trace file: %w", err)
+		}
+
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to create file exporter: %w", err)
+		}
+
+		provider.file = file
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+
+	if len(opts) == 0 {
+		return nil, ErrNoExporters
+	}
+
+	// Describe this service instance
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName("rotector"),
+		semconv.ServiceInstanceID(instanceID),
+		attribute.String("rotector.component", componentName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	// An unset ratio samples every trace since disabling is done through the enabled flag
+	sampleRatio := cfg.SampleRatio
+	if sampleRatio <= 0 {
+		sampleRatio = 1
+	}
+
+	opts = append(opts,
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	)
+
+	provider.tp = sdktrace.NewTracerProvider(opts...)
+
+	otel.SetTracerProvider(provider.tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return provider, nil
+}
+
+// Shutdown flushes pending spans and closes the exporters.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	err := p.tp.Shutdown(ctx)
+
+	if p.file != nil {
+		err = errors.Join(err, p.file.Close())
+	}
+
+	return err
+}
+
+// Start creates a span with the given name and attributes.
+// When tracing is disabled, this returns a no-op span.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// RecordError marks the span as failed with the given error if present.
+func RecordError(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+}
+
+// End records the error on the span if present and ends it.
+func End(span trace.Span, err error) {
+	RecordError(span, err)
+	span.End()
+}
+
+// UserIDs returns an attribute holding the given user IDs.
+func UserIDs(ids []int64) attribute.KeyValue {
+	return attribute.Int64Slice("rotector.user_ids", ids)
+}
+
+// UserID returns an attribute holding a single user ID.
+func UserID(id int64) attribute.KeyValue {
+	return attribute.Int64("rotector.user_id", id)
+}
+
+// BatchSize returns an attribute holding the size of a batch.
+func BatchSize(size int) attribute.KeyValue {
+	return attribute.Int("rotector.batch_size", size)
+}
+
+// Model returns an attribute holding the AI model name.
+func Model(name string) attribute.KeyValue {
+	return attribute.String("rotector.model", name)
+}
+
+// FlaggedCount returns an attribute holding the number of flagged users.
+func FlaggedCount(count int) attribute.KeyValue {
+	return attribute.Int("rotector.flagged_count", count)
+}
@@ -20,6 +20,9 @@ type ProgressBar struct {
 	status      string
 	healthy     bool
 	lastUpdate  time.Time
+	startedAt   time.Time
+	restarts    int
+	processed   int64
 }
 
 // NewProgressBar creates a new progress bar component.
@@ -99,6 +102,13 @@ func (pb *ProgressBar) SetStatus(status string, healthy bool) {
 	pb.lastUpdate = time.Now()
 }
 
+// SetRuntime updates the worker runtime statistics shown below the bar.
+func (pb *ProgressBar) SetRuntime(startedAt time.Time, restarts int, processed int64) {
+	pb.startedAt = startedAt
+	pb.restarts = restarts
+	pb.processed = processed
+}
+
 // SetSize sets the progress bar width.
 func (pb *ProgressBar) SetSize(width int) {
 	if width > 20 {
@@ -138,5 +148,17 @@ func (pb *ProgressBar) render() string {
 		lines = append(lines, "Step: "+pb.stepMessage)
 	}
 
+	if !pb.startedAt.IsZero() {
+		uptime := time.Since(pb.startedAt)
+
+		var perMinute float64
+		if minutes := uptime.Minutes(); minutes > 0 {
+			perMinute = float64(pb.processed) / minutes
+		}
+
+		lines = append(lines, fmt.Sprintf("Uptime: %s | Restarts: %d | Processed: %d (%.1f/min)",
+			uptime.Truncate(time.Second), pb.restarts, pb.processed, perMinute))
+	}
+
 	return strings.Join(lines, "\n")
 }
@@ -2,6 +2,7 @@ package category
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -28,18 +29,22 @@ type Worker struct {
 	bar              *components.ProgressBar
 	categoryAnalyzer *ai.CategoryAnalyzer
 	reporter         *core.StatusReporter
+	control          *core.Controller
+	leader           *core.LeaderElector
 	logger           *zap.Logger
 }
 
 // New creates a new category worker.
 func New(app *setup.App, bar *components.ProgressBar, logger *zap.Logger, instanceID string) *Worker {
-	reporter := core.NewStatusReporter(app.StatusClient, "category", instanceID, logger)
+	reporter := core.NewStatusReporter(app.StatusClient, app.DB, bar, "category", instanceID, logger)
 
 	return &Worker{
 		db:               app.DB,
 		bar:              bar,
 		categoryAnalyzer: ai.NewCategoryAnalyzer(app, logger),
 		reporter:         reporter,
+		control:          core.NewController(app.StatusClient, "category", &app.Config.Worker, bar, reporter, logger),
+		leader:           core.NewLeaderElector(app.StatusClient, "category", instanceID, logger),
 		logger:           logger.Named("category_worker"),
 	}
 }
@@ -52,6 +57,21 @@ func (w *Worker) Start(ctx context.Context) {
 	w.reporter.Start(ctx)
 	defer w.reporter.Stop()
 
+	// Wait on standby until this instance is the leader
+	w.bar.SetStepMessage("Standby: waiting for leadership", 0)
+	w.reporter.UpdateStatus("Standby: waiting for leadership", 0)
+
+	ctx, ok := w.leader.Campaign(ctx)
+	if !ok {
+		return
+	}
+	defer w.leader.Resign()
+
+	// Honour control plane commands
+	if !w.control.Checkpoint(ctx) {
+		return
+	}
+
 	w.bar.SetTotal(100)
 	w.bar.SetStepMessage("Starting category classification process", 0)
 
@@ -59,6 +79,10 @@ func (w *Worker) Start(ctx context.Context) {
 	w.bar.SetStepMessage("Classifying users without category", 50)
 
 	categoryCount, err := w.processUsersWithoutCategory(ctx)
+	if errors.Is(err, core.ErrWorkerStopped) {
+		return
+	}
+
 	if err != nil {
 		w.logger.Error("Failed to process users without category", zap.Error(err))
 	} else {
@@ -82,6 +106,11 @@ func (w *Worker) processUsersWithoutCategory(ctx context.Context) (int, error) {
 	cursorID := int64(0)
 
 	for !utils.ContextGuardWithLog(ctx, w.logger, "Context cancelled during batch processing") {
+		// Honour control plane commands between batches
+		if !w.control.Checkpoint(ctx) {
+			return totalProcessed, core.ErrWorkerStopped
+		}
+
 		// Get batch of users without category
 		users, err := w.db.Model().User().GetUsersWithoutCategory(ctx, batchSize, cursorID)
 		if err != nil {
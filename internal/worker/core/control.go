@@ -0,0 +1,495 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/rueidis"
+	"github.com/robalyx/rotector/internal/setup/config"
+	"github.com/robalyx/rotector/internal/tui/components"
+	"go.uber.org/zap"
+)
+
+const (
+	// ControlKeyPrefix is the prefix for worker control keys in Redis.
+	ControlKeyPrefix = "worker_control"
+
+	// ControlPollInterval is how often workers check for control changes while waiting.
+	ControlPollInterval = 5 * time.Second
+
+	// overrideFieldPrefix prefixes hash fields that hold config overrides.
+	overrideFieldPrefix = "override:"
+)
+
+// Control hash fields.
+const (
+	controlFieldPaused    = "paused"
+	controlFieldDrainAt   = "drain_at"
+	controlFieldRunNowAt  = "run_now_at"
+	controlFieldUpdatedAt = "updated_at"
+	controlFieldUpdatedBy = "updated_by"
+)
+
+var (
+	// ErrUnknownWorkerType indicates the worker type is not recognized.
+	ErrUnknownWorkerType = errors.New("unknown worker type")
+	// ErrInvalidOverride indicates an override key or value cannot be applied.
+	ErrInvalidOverride = errors.New("invalid override")
+	// ErrWorkerStopped indicates the control plane asked the worker to stop mid-cycle.
+	ErrWorkerStopped = errors.New("worker stopped by control plane")
+)
+
+// WorkerTypes lists all worker types that can be controlled.
+var WorkerTypes = []string{
+	"category", "friend", "group", "maintenance", "stats", "queue", "sync", "reason", "war",
+}
+
+// liveOverrideKeys lists the config keys that workers re-read on every cycle.
+// Other keys are only read at startup, so overriding them would have no effect.
+var liveOverrideKeys = map[string]struct{}{
+	"batch_sizes.friend_users":                   {},
+	"batch_sizes.group_users":                    {},
+	"batch_sizes.queue_items":                    {},
+	"batch_sizes.purge_users":                    {},
+	"batch_sizes.purge_groups":                   {},
+	"batch_sizes.track_groups":                   {},
+	"batch_sizes.track_user_groups":              {},
+	"batch_sizes.track_games":                    {},
+	"batch_sizes.thumbnail_users":                {},
+	"batch_sizes.thumbnail_groups":               {},
+	"threshold_limits.flagged_users":             {},
+	"threshold_limits.max_group_members_track":   {},
+	"threshold_limits.min_group_flagged_users":   {},
+	"threshold_limits.min_flagged_override":      {},
+	"threshold_limits.min_flagged_percentage":    {},
+	"threshold_limits.min_game_flagged_users":    {},
+	"threshold_limits.min_game_flagged_override": {},
+}
+
+// processStartedAt marks when this process started. Drain and run-now
+// commands issued before this time are ignored by workers in this process.
+var processStartedAt = time.Now()
+
+// ControlState represents the control settings for a worker type.
+type ControlState struct {
+	WorkerType string            `json:"workerType"`
+	Paused     bool              `json:"paused"`
+	DrainAt    time.Time         `json:"drainAt"`
+	RunNowAt   time.Time         `json:"runNowAt"`
+	Overrides  map[string]string `json:"overrides"`
+	UpdatedAt  time.Time         `json:"updatedAt"`
+	UpdatedBy  string            `json:"updatedBy"`
+}
+
+// IsDraining checks if a drain was requested after the given start time.
+func (s *ControlState) IsDraining(startedAt time.Time) bool {
+	return !s.DrainAt.IsZero() && s.DrainAt.After(startedAt)
+}
+
+// ControlClient reads and writes worker control state in Redis.
+type ControlClient struct {
+	client rueidis.Client
+	logger *zap.Logger
+}
+
+// NewControlClient creates a new worker control client.
+func NewControlClient(client rueidis.Client, logger *zap.Logger) *ControlClient {
+	return &ControlClient{
+		client: client,
+		logger: logger.Named("worker_control"),
+	}
+}
+
+// GetState retrieves the control state for a worker type.
+func (c *ControlClient) GetState(ctx context.Context, workerType string) (*ControlState, error) {
+	fields, err := c.client.Do(ctx, c.client.B().Hgetall().Key(controlKey(workerType)).Build()).AsStrMap()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get control state: %w", err)
+	}
+
+	state := &ControlState{
+		WorkerType: workerType,
+		Overrides:  make(map[string]string),
+	}
+
+	for field, value := range fields {
+		switch {
+		case field == controlFieldPaused:
+			state.Paused = value == "1"
+		case field == controlFieldDrainAt:
+			state.DrainAt = parseUnixNano(value)
+		case field == controlFieldRunNowAt:
+			state.RunNowAt = parseUnixNano(value)
+		case field == controlFieldUpdatedAt:
+			state.UpdatedAt = parseUnixNano(value)
+		case field == controlFieldUpdatedBy:
+			state.UpdatedBy = value
+		case strings.HasPrefix(field, overrideFieldPrefix):
+			state.Overrides[strings.TrimPrefix(field, overrideFieldPrefix)] = value
+		}
+	}
+
+	return state, nil
+}
+
+// GetAllStates retrieves the control state for every worker type.
+func (c *ControlClient) GetAllStates(ctx context.Context) ([]*ControlState, error) {
+	states := make([]*ControlState, 0, len(WorkerTypes))
+
+	for _, workerType := range WorkerTypes {
+		state, err := c.GetState(ctx, workerType)
+		if err != nil {
+			return nil, err
+		}
+
+		states = append(states, state)
+	}
+
+	return states, nil
+}
+
+// Pause stops workers of the given type at their next checkpoint.
+func (c *ControlClient) Pause(ctx context.Context, workerType, actor string) error {
+	return c.set(ctx, workerType, actor, controlFieldPaused, "1")
+}
+
+// Resume clears any pause or drain for the given worker type.
+func (c *ControlClient) Resume(ctx context.Context, workerType, actor string) error {
+	if err := c.del(ctx, workerType, controlFieldPaused, controlFieldDrainAt); err != nil {
+		return err
+	}
+
+	return c.set(ctx, workerType, actor)
+}
+
+// Drain makes running workers of the given type finish their current cycle and exit.
+func (c *ControlClient) Drain(ctx context.Context, workerType, actor string) error {
+	return c.set(ctx, workerType, actor, controlFieldDrainAt, formatUnixNano(time.Now()))
+}
+
+// RunNow wakes idle workers of the given type to start their next cycle immediately.
+func (c *ControlClient) RunNow(ctx context.Context, workerType, actor string) error {
+	return c.set(ctx, workerType, actor, controlFieldRunNowAt, formatUnixNano(time.Now()))
+}
+
+// SetOverride sets a live config override such as "batch_sizes.friend_users".
+func (c *ControlClient) SetOverride(ctx context.Context, workerType, key, value, actor string) error {
+	if err := ValidateOverride(key, value); err != nil {
+		return err
+	}
+
+	return c.set(ctx, workerType, actor, overrideFieldPrefix+key, value)
+}
+
+// ClearOverrides removes all live config overrides for the given worker type.
+func (c *ControlClient) ClearOverrides(ctx context.Context, workerType, actor string) error {
+	state, err := c.GetState(ctx, workerType)
+	if err != nil {
+		return err
+	}
+
+	fields := make([]string, 0, len(state.Overrides))
+	for key := range state.Overrides {
+		fields = append(fields, overrideFieldPrefix+key)
+	}
+
+	if len(fields) > 0 {
+		if err := c.del(ctx, workerType, fields...); err != nil {
+			return err
+		}
+	}
+
+	return c.set(ctx, workerType, actor)
+}
+
+// IsDraining checks if workers of the given type in this process should drain.
+func (c *ControlClient) IsDraining(ctx context.Context, workerType string) bool {
+	state, err := c.GetState(ctx, workerType)
+	if err != nil {
+		c.logger.Warn("Failed to check drain state", zap.String("workerType", workerType), zap.Error(err))
+		return false
+	}
+
+	return state.IsDraining(processStartedAt)
+}
+
+// set writes the given field-value pairs along with audit fields.
+func (c *ControlClient) set(ctx context.Context, workerType, actor string, fieldValues ...string) error {
+	if !IsWorkerType(workerType) {
+		return fmt.Errorf("%w: %s", ErrUnknownWorkerType, workerType)
+	}
+
+	cmd := c.client.B().Hset().Key(controlKey(workerType)).FieldValue().
+		FieldValue(controlFieldUpdatedAt, formatUnixNano(time.Now())).
+		FieldValue(controlFieldUpdatedBy, actor)
+	for i := 0; i+1 < len(fieldValues); i += 2 {
+		cmd = cmd.FieldValue(fieldValues[i], fieldValues[i+1])
+	}
+
+	if err := c.client.Do(ctx, cmd.Build()).Error(); err != nil {
+		return fmt.Errorf("failed to update control state: %w", err)
+	}
+
+	c.logger.Info("Updated worker control state",
+		zap.String("workerType", workerType),
+		zap.String("actor", actor),
+		zap.Strings("fields", fieldValues))
+
+	return nil
+}
+
+// del removes the given fields from the control state.
+func (c *ControlClient) del(ctx context.Context, workerType string, fields ...string) error {
+	if !IsWorkerType(workerType) {
+		return fmt.Errorf("%w: %s", ErrUnknownWorkerType, workerType)
+	}
+
+	if err := c.client.Do(ctx, c.client.B().Hdel().Key(controlKey(workerType)).Field(fields...).Build()).Error(); err != nil {
+		return fmt.Errorf("failed to update control state: %w", err)
+	}
+
+	return nil
+}
+
+// Controller lets a running worker honour pause, drain, run-now and config
+// override commands issued through the control plane.
+type Controller struct {
+	client     *ControlClient
+	workerType string
+	base       config.WorkerConfig
+	bar        *components.ProgressBar
+	reporter   *StatusReporter
+	logger     *zap.Logger
+
+	mu         sync.Mutex
+	state      *ControlState
+	lastRunNow time.Time
+}
+
+// NewController creates a controller for a worker instance.
+func NewController(
+	client rueidis.Client, workerType string, base *config.WorkerConfig,
+	bar *components.ProgressBar, reporter *StatusReporter, logger *zap.Logger,
+) *Controller {
+	return &Controller{
+		client:     NewControlClient(client, logger),
+		workerType: workerType,
+		base:       *base,
+		bar:        bar,
+		reporter:   reporter,
+		logger:     logger.Named("controller"),
+		state:      &ControlState{WorkerType: workerType},
+		lastRunNow: processStartedAt,
+	}
+}
+
+// Checkpoint should be called at the start of each worker cycle. It blocks while
+// the worker type is paused and returns false if the worker should stop.
+func (c *Controller) Checkpoint(ctx context.Context) bool {
+	for {
+		state := c.refresh(ctx)
+
+		if state.IsDraining(processStartedAt) {
+			c.logger.Info("Drain requested, stopping worker")
+			c.bar.SetStepMessage("Drained", 100)
+			c.reporter.UpdateStatus("Drained", 100)
+			c.reporter.MarkDrained()
+
+			return false
+		}
+
+		if !state.Paused {
+			return true
+		}
+
+		c.bar.SetStepMessage("Paused by control plane", 0)
+		c.reporter.UpdateStatus("Paused by control plane", 0)
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(ControlPollInterval):
+		}
+	}
+}
+
+// Sleep waits for the given duration, waking early on a run-now command.
+// Returns false if the context was cancelled or a drain was requested.
+func (c *Controller) Sleep(ctx context.Context, duration time.Duration) bool {
+	deadline := time.Now().Add(duration)
+
+	for {
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return true
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(min(remaining, ControlPollInterval)):
+		}
+
+		state := c.refresh(ctx)
+
+		if state.IsDraining(processStartedAt) {
+			return false
+		}
+
+		c.mu.Lock()
+		runNow := state.RunNowAt.After(c.lastRunNow)
+		if runNow {
+			c.lastRunNow = state.RunNowAt
+		}
+		c.mu.Unlock()
+
+		if runNow {
+			c.logger.Info("Run-now requested, starting next cycle")
+			return true
+		}
+	}
+}
+
+// Config returns the worker config with live overrides applied.
+func (c *Controller) Config() config.WorkerConfig {
+	c.mu.Lock()
+	overrides := c.state.Overrides
+	c.mu.Unlock()
+
+	cfg := c.base
+	for key, value := range overrides {
+		if err := applyOverride(&cfg, key, value); err != nil {
+			c.logger.Warn("Skipping invalid config override",
+				zap.String("key", key),
+				zap.String("value", value),
+				zap.Error(err))
+		}
+	}
+
+	return cfg
+}
+
+// refresh loads the latest control state, keeping the previous state on errors.
+func (c *Controller) refresh(ctx context.Context) *ControlState {
+	state, err := c.client.GetState(ctx, c.workerType)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err != nil {
+		if ctx.Err() == nil {
+			c.logger.Warn("Failed to refresh control state", zap.Error(err))
+		}
+
+		return c.state
+	}
+
+	c.state = state
+
+	return state
+}
+
+// IsWorkerType checks if the given name is a known worker type.
+func IsWorkerType(workerType string) bool {
+	return slices.Contains(WorkerTypes, workerType)
+}
+
+// OverrideKeys returns all config keys that can be overridden, sorted.
+func OverrideKeys() []string {
+	keys := make([]string, 0, len(liveOverrideKeys))
+	for key := range liveOverrideKeys {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// ValidateOverride checks if the override key exists and the value can be parsed.
+func ValidateOverride(key, value string) error {
+	var cfg config.WorkerConfig
+	return applyOverride(&cfg, key, value)
+}
+
+// applyOverride sets a BatchSizes or ThresholdLimits field by its koanf key.
+func applyOverride(cfg *config.WorkerConfig, key, value string) error {
+	sectionKey, fieldKey, ok := strings.Cut(key, ".")
+	if !ok {
+		return fmt.Errorf("%w: key %q must be in section.field form", ErrInvalidOverride, key)
+	}
+
+	if _, live := liveOverrideKeys[key]; !live {
+		return fmt.Errorf("%w: %q is not re-read by running workers", ErrInvalidOverride, key)
+	}
+
+	var section reflect.Value
+
+	switch sectionKey {
+	case "batch_sizes":
+		section = reflect.ValueOf(&cfg.BatchSizes).Elem()
+	case "threshold_limits":
+		section = reflect.ValueOf(&cfg.ThresholdLimits).Elem()
+	default:
+		return fmt.Errorf("%w: unknown section %q", ErrInvalidOverride, sectionKey)
+	}
+
+	for i := range section.NumField() {
+		if section.Type().Field(i).Tag.Get("koanf") != fieldKey {
+			continue
+		}
+
+		field := section.Field(i)
+
+		switch field.Kind() { //nolint:exhaustive // config only uses these kinds
+		case reflect.Int, reflect.Int64:
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || n <= 0 {
+				return fmt.Errorf("%w: %q is not a positive integer", ErrInvalidOverride, value)
+			}
+
+			field.SetInt(n)
+		case reflect.Float64:
+			f, err := strconv.ParseFloat(value, 64)
+			if err != nil || f < 0 {
+				return fmt.Errorf("%w: %q is not a non-negative number", ErrInvalidOverride, value)
+			}
+
+			field.SetFloat(f)
+		default:
+			return fmt.Errorf("%w: unsupported field type for %q", ErrInvalidOverride, key)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: unknown field %q", ErrInvalidOverride, key)
+}
+
+// controlKey returns the Redis key for a worker type's control state.
+func controlKey(workerType string) string {
+	return fmt.Sprintf("%s:%s", ControlKeyPrefix, workerType)
+}
+
+// formatUnixNano formats a time as a unix nanosecond string.
+func formatUnixNano(t time.Time) string {
+	return strconv.FormatInt(t.UnixNano(), 10)
+}
+
+// parseUnixNano parses a unix nanosecond string, returning zero time on error.
+func parseUnixNano(value string) time.Time {
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return time.Unix(0, n)
+}
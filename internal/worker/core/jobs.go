@@ -0,0 +1,182 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/bytedance/sonic"
+	"github.com/robalyx/rotector/internal/database"
+	"github.com/robalyx/rotector/internal/database/types"
+	"go.uber.org/zap"
+)
+
+const (
+	// JobLeaseDuration is how long a leased job is held before another worker may take it.
+	JobLeaseDuration = 10 * time.Minute
+	// JobHeartbeatInterval is how often a held lease is extended.
+	JobHeartbeatInterval = 2 * time.Minute
+	// JobMaxAttempts is how many times a job is attempted before it is dead-lettered.
+	JobMaxAttempts = 5
+	// JobRetryBaseDelay is the base delay before a failed job is retried.
+	JobRetryBaseDelay = 30 * time.Second
+)
+
+// JobQueue produces and consumes jobs of a single type for a worker.
+type JobQueue struct {
+	db      database.Client
+	jobType types.JobType
+	owner   string
+	logger  *zap.Logger
+}
+
+// NewJobQueue creates a JobQueue for the given job type.
+// The owner identifies the worker instance holding leases.
+func NewJobQueue(db database.Client, jobType types.JobType, owner string, logger *zap.Logger) *JobQueue {
+	return &JobQueue{
+		db:      db,
+		jobType: jobType,
+		owner:   owner,
+		logger:  logger.Named("job_queue"),
+	}
+}
+
+// Enqueue adds a job with the given idempotency key and payload.
+// Returns false if an active job with the same key already exists.
+func (q *JobQueue) Enqueue(ctx context.Context, idempotencyKey string, payload any) (bool, error) {
+	data, err := sonic.Marshal(payload)
+	if err != nil {
+		return false, fmt.Errorf("failed to marshal job payload: %w", err)
+	}
+
+	added, err := q.db.Model().Job().Enqueue(ctx, []*types.Job{{
+		Type:           q.jobType,
+		Payload:        data,
+		IdempotencyKey: idempotencyKey,
+		MaxAttempts:    JobMaxAttempts,
+	}})
+	if err != nil {
+		return false, err
+	}
+
+	return added > 0, nil
+}
+
+// Lease claims the next available job and keeps its lease alive until the job
+// is completed or failed. Returns types.ErrNoJobsAvailable if the queue is empty.
+func (q *JobQueue) Lease(ctx context.Context) (*LeasedJob, error) {
+	job, err := q.db.Model().Job().Lease(ctx, q.jobType, q.owner, JobLeaseDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	heartbeatCtx, cancel := context.WithCancel(ctx)
+	leased := &LeasedJob{
+		Job:    job,
+		queue:  q,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	go leased.heartbeat(heartbeatCtx)
+
+	return leased, nil
+}
+
+// LeasedJob is a job held by this worker with an active lease.
+type LeasedJob struct {
+	*types.Job
+
+	queue    *JobQueue
+	cancel   context.CancelFunc
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// Decode unmarshals the job payload into v.
+func (j *LeasedJob) Decode(v any) error {
+	if err := sonic.Unmarshal(j.Payload, v); err != nil {
+		return fmt.Errorf("failed to unmarshal job payload: %w", err)
+	}
+
+	return nil
+}
+
+// Checkpoint stores an updated payload so that progress survives a crash.
+// A worker that later takes over the job resumes from the checkpoint.
+func (j *LeasedJob) Checkpoint(ctx context.Context, payload any) error {
+	data, err := sonic.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal job payload: %w", err)
+	}
+
+	if err := j.queue.db.Model().Job().Heartbeat(ctx, j.ID, j.queue.owner, JobLeaseDuration, data); err != nil {
+		return err
+	}
+
+	j.Payload = data
+
+	return nil
+}
+
+// Complete marks the job as completed and stops its heartbeat.
+func (j *LeasedJob) Complete(ctx context.Context) error {
+	j.stop()
+	return j.queue.db.Model().Job().Complete(ctx, j.ID, j.queue.owner)
+}
+
+// Fail records a failed attempt and stops the heartbeat. The job is retried with
+// a quadratic backoff or dead-lettered once it runs out of attempts.
+func (j *LeasedJob) Fail(ctx context.Context, jobErr error) error {
+	j.stop()
+
+	retryDelay := JobRetryBaseDelay * time.Duration(j.Attempts*j.Attempts)
+
+	return j.queue.db.Model().Job().Fail(ctx, j.ID, j.queue.owner, jobErr, retryDelay)
+}
+
+// Release stops the heartbeat without updating the job. It is used for jobs whose
+// lease was lost to another worker, which now owns the job.
+func (j *LeasedJob) Release() {
+	j.stop()
+}
+
+// stop ends the heartbeat and waits for it to exit.
+func (j *LeasedJob) stop() {
+	j.stopOnce.Do(func() {
+		j.cancel()
+		<-j.done
+	})
+}
+
+// heartbeat periodically extends the job lease until the context is cancelled.
+func (j *LeasedJob) heartbeat(ctx context.Context) {
+	defer close(j.done)
+
+	ticker := time.NewTicker(JobHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := j.queue.db.Model().Job().Heartbeat(ctx, j.ID, j.queue.owner, JobLeaseDuration, nil)
+			if errors.Is(err, types.ErrJobLeaseLost) {
+				j.queue.logger.Warn("Lost lease on job",
+					zap.Int64("jobID", j.ID),
+					zap.String("type", string(j.Type)))
+
+				return
+			}
+
+			if err != nil && ctx.Err() == nil {
+				j.queue.logger.Error("Failed to extend job lease",
+					zap.Error(err),
+					zap.Int64("jobID", j.ID))
+			}
+		}
+	}
+}
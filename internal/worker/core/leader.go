@@ -0,0 +1,265 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/rueidis"
+	"github.com/robalyx/rotector/internal/database"
+	"github.com/robalyx/rotector/internal/database/dbretry"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/uptrace/bun"
+	"go.uber.org/zap"
+)
+
+const (
+	// LeaderKeyPrefix is the prefix for leader lease keys in Redis.
+	LeaderKeyPrefix = "worker_leader"
+
+	// LeaderTokenKeyPrefix is the prefix for fencing token counters in Redis.
+	LeaderTokenKeyPrefix = "worker_leader_token"
+
+	// LeaderLeaseTTL is how long a leader lease lasts without renewal.
+	LeaderLeaseTTL = 30 * time.Second
+
+	// LeaderRenewInterval is how often the leader renews its lease.
+	LeaderRenewInterval = 10 * time.Second
+
+	// LeaderRetryInterval is how often standbys try to acquire leadership.
+	LeaderRetryInterval = 15 * time.Second
+)
+
+// ErrLeadershipLost indicates the lease expired or was taken by another instance.
+var ErrLeadershipLost = errors.New("leadership lost")
+
+var (
+	// renewLeaseScript extends the lease only if it is still held with our value.
+	renewLeaseScript = rueidis.NewLuaScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0`)
+
+	// releaseLeaseScript deletes the lease only if it is still held with our value.
+	releaseLeaseScript = rueidis.NewLuaScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+)
+
+// LeaderElector ensures only one instance of a singleton worker type is active.
+// Leadership is a Redis lease tagged with a fencing token that increases with
+// every acquisition, so writes can be rejected once a newer leader has written.
+type LeaderElector struct {
+	client     rueidis.Client
+	workerType string
+	instanceID string
+	logger     *zap.Logger
+
+	mu     sync.Mutex
+	token  int64
+	value  string
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewLeaderElector creates a leader elector for a worker type.
+func NewLeaderElector(client rueidis.Client, workerType, instanceID string, logger *zap.Logger) *LeaderElector {
+	return &LeaderElector{
+		client:     client,
+		workerType: workerType,
+		instanceID: instanceID,
+		logger:     logger.Named("leader_elector"),
+	}
+}
+
+// Campaign blocks until this instance becomes leader or the context is cancelled.
+// The returned context is cancelled when leadership is lost. Returns false if
+// the context was cancelled before leadership was acquired.
+func (e *LeaderElector) Campaign(ctx context.Context) (context.Context, bool) {
+	for {
+		acquired, err := e.tryAcquire(ctx)
+		if err != nil {
+			e.logger.Warn("Failed to acquire leadership", zap.Error(err))
+		}
+
+		if acquired {
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx, false
+		case <-time.After(LeaderRetryInterval):
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	leaderCtx, cancel := context.WithCancel(ctx)
+	e.cancel = cancel
+	e.done = make(chan struct{})
+
+	go e.renewLoop(leaderCtx, cancel, e.value, e.done)
+
+	e.logger.Info("Acquired leadership",
+		zap.String("workerType", e.workerType),
+		zap.Int64("fencingToken", e.token))
+
+	return leaderCtx, true
+}
+
+// Resign gives up leadership so a standby can take over immediately.
+func (e *LeaderElector) Resign() {
+	e.mu.Lock()
+	cancel, done, value := e.cancel, e.done, e.value
+	e.cancel, e.done, e.value = nil, nil, ""
+	e.mu.Unlock()
+
+	if cancel == nil {
+		return
+	}
+
+	cancel()
+	<-done
+
+	ctx, cancelRelease := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelRelease()
+
+	err := releaseLeaseScript.Exec(ctx, e.client, []string{e.leaseKey()}, []string{value}).Error()
+	if err != nil {
+		e.logger.Warn("Failed to release leadership", zap.Error(err))
+		return
+	}
+
+	e.logger.Info("Resigned leadership", zap.String("workerType", e.workerType))
+}
+
+// Token returns the fencing token of the current leadership term.
+func (e *LeaderElector) Token() int64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return e.token
+}
+
+// RunFenced runs fn in a transaction that first claims the worker type's fence with our
+// fencing token. Writes made through tx are rejected once a newer leader has written, and
+// since the fence row stays locked until fn returns, writes fn makes to other stores are
+// serialized against other leaders too. Returns ErrLeadershipLost if we are not the leader.
+func (e *LeaderElector) RunFenced(
+	ctx context.Context, db database.Client, fn func(ctx context.Context, tx bun.Tx) error,
+) error {
+	e.mu.Lock()
+	token, value := e.token, e.value
+	e.mu.Unlock()
+
+	if value == "" {
+		return ErrLeadershipLost
+	}
+
+	return dbretry.Transaction(ctx, db.DB(), func(ctx context.Context, tx bun.Tx) error {
+		err := db.Model().Worker().ClaimFenceWithTx(ctx, tx, e.workerType, token)
+		if errors.Is(err, types.ErrStaleFencingToken) {
+			return fmt.Errorf("%w: %w", ErrLeadershipLost, err)
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fn(ctx, tx)
+	})
+}
+
+// tryAcquire attempts to take the lease with a new fencing token.
+func (e *LeaderElector) tryAcquire(ctx context.Context) (bool, error) {
+	// Skip issuing a token if another instance clearly holds the lease
+	exists, err := e.client.Do(ctx, e.client.B().Exists().Key(e.leaseKey()).Build()).AsInt64()
+	if err != nil {
+		return false, fmt.Errorf("failed to check lease: %w", err)
+	}
+
+	if exists > 0 {
+		return false, nil
+	}
+
+	token, err := e.client.Do(ctx, e.client.B().Incr().Key(e.tokenKey()).Build()).AsInt64()
+	if err != nil {
+		return false, fmt.Errorf("failed to issue fencing token: %w", err)
+	}
+
+	value := e.instanceID + ":" + strconv.FormatInt(token, 10)
+
+	err = e.client.Do(ctx, e.client.B().Set().Key(e.leaseKey()).Value(value).
+		Nx().Px(LeaderLeaseTTL).Build()).Error()
+	if rueidis.IsRedisNil(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to acquire lease: %w", err)
+	}
+
+	e.mu.Lock()
+	e.token = token
+	e.value = value
+	e.mu.Unlock()
+
+	return true, nil
+}
+
+// renewLoop extends the lease until the context is cancelled or the lease is lost.
+func (e *LeaderElector) renewLoop(ctx context.Context, cancel context.CancelFunc, value string, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(LeaderRenewInterval)
+	defer ticker.Stop()
+
+	lastRenewed := time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			renewed, err := renewLeaseScript.Exec(ctx, e.client, []string{e.leaseKey()},
+				[]string{value, strconv.FormatInt(LeaderLeaseTTL.Milliseconds(), 10)}).AsInt64()
+			if err != nil {
+				// Keep leadership on transient errors until the lease would have expired
+				e.logger.Warn("Failed to renew leadership", zap.Error(err))
+
+				if time.Since(lastRenewed) < LeaderLeaseTTL {
+					continue
+				}
+
+				renewed = 0
+			}
+
+			if renewed == 0 {
+				e.logger.Warn("Leadership lost", zap.String("workerType", e.workerType))
+				cancel()
+
+				return
+			}
+
+			lastRenewed = time.Now()
+		}
+	}
+}
+
+// leaseKey returns the Redis key for the worker type's lease.
+func (e *LeaderElector) leaseKey() string {
+	return fmt.Sprintf("%s:%s", LeaderKeyPrefix, e.workerType)
+}
+
+// tokenKey returns the Redis key for the worker type's fencing token counter.
+func (e *LeaderElector) tokenKey() string {
+	return fmt.Sprintf("%s:%s", LeaderTokenKeyPrefix, e.workerType)
+}
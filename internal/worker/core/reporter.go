@@ -6,43 +6,93 @@ import (
 	"time"
 
 	"github.com/redis/rueidis"
+	"github.com/robalyx/rotector/internal/database"
+	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/tui/components"
 	"go.uber.org/zap"
 )
 
+const (
+	// maxPendingEvents caps buffered history events if the database is unavailable.
+	maxPendingEvents = 1000
+
+	// eventFlushTimeout bounds the final history flush when a worker stops.
+	eventFlushTimeout = 5 * time.Second
+)
+
+// slotKey is the context key holding the stable slot of a worker.
+type slotKey struct{}
+
+// WithSlot returns a context that carries the stable slot of the worker started with it.
+// A slot identifies the worker across process restarts, unlike its instance ID.
+func WithSlot(ctx context.Context, slot string) context.Context {
+	return context.WithValue(ctx, slotKey{}, slot)
+}
+
 // StatusReporter handles automatic status reporting for workers.
+// Lifecycle transitions and health flips are buffered and written to the
+// worker history on each heartbeat. Task changes are only reported as status.
 type StatusReporter struct {
-	monitor  *Monitor
-	status   Status
-	stopChan chan struct{}
-	stopped  bool
-	mu       sync.Mutex
-	logger   *zap.Logger
+	monitor       *Monitor
+	db            database.Client
+	bar           *components.ProgressBar
+	status        Status
+	slot          string
+	pending       []*types.WorkerEvent
+	unloggedCount int64
+	detailsFunc   func() map[string]string
+	stopChan      chan struct{}
+	running       bool
+	mu            sync.Mutex
+	logger        *zap.Logger
 }
 
 // NewStatusReporter creates a new status reporter for a worker.
-func NewStatusReporter(client rueidis.Client, workerType, instanceID string, logger *zap.Logger) *StatusReporter {
+func NewStatusReporter(
+	client rueidis.Client, db database.Client, bar *components.ProgressBar,
+	workerType, instanceID string, logger *zap.Logger,
+) *StatusReporter {
 	return &StatusReporter{
 		monitor: NewMonitor(client, logger),
+		db:      db,
+		bar:     bar,
 		status: Status{
 			WorkerID:   instanceID,
 			WorkerType: workerType,
 			IsHealthy:  true,
 		},
-		stopChan: make(chan struct{}),
-		stopped:  false,
-		logger:   logger.Named("status_reporter"),
+		slot:   instanceID,
+		logger: logger.Named("status_reporter"),
 	}
 }
 
-// Start begins periodic status reporting.
+// Start begins periodic status reporting. Calling Start again after Stop
+// resumes reporting and counts as a restart.
 func (r *StatusReporter) Start(ctx context.Context) {
 	r.mu.Lock()
 
-	if r.stopped {
+	if r.running {
 		r.mu.Unlock()
 		return
 	}
 
+	if !r.status.StartedAt.IsZero() {
+		r.status.Restarts++
+	}
+
+	// Record history under the stable slot when the worker was started with one
+	if slot, ok := ctx.Value(slotKey{}).(string); ok && slot != "" {
+		r.slot = slot
+	}
+
+	r.status.StartedAt = time.Now()
+	r.status.IsHealthy = true
+	r.running = true
+	r.stopChan = make(chan struct{})
+	r.recordEvent(types.WorkerEventStarted)
+
+	stopChan := r.stopChan
+
 	r.mu.Unlock()
 
 	go func() {
@@ -50,34 +100,41 @@ func (r *StatusReporter) Start(ctx context.Context) {
 		defer ticker.Stop()
 
 		// Report initial status
-		if err := r.monitor.ReportStatus(ctx, r.status); err != nil {
-			r.logger.Error("Failed to report initial status", zap.Error(err))
-		}
+		r.report(ctx)
 
 		for {
 			select {
 			case <-ticker.C:
-				if err := r.monitor.ReportStatus(ctx, r.status); err != nil {
-					r.logger.Error("Failed to report status", zap.Error(err))
-				}
+				r.report(ctx)
 			case <-ctx.Done():
 				return
-			case <-r.stopChan:
+			case <-stopChan:
 				return
 			}
 		}
 	}()
 }
 
-// Stop ends status reporting.
+// Stop ends status reporting and flushes any buffered history.
 func (r *StatusReporter) Stop() {
 	r.mu.Lock()
-	defer r.mu.Unlock()
 
-	if !r.stopped {
-		close(r.stopChan)
-		r.stopped = true
+	if !r.running {
+		r.mu.Unlock()
+		return
 	}
+
+	close(r.stopChan)
+	r.running = false
+	r.recordEvent(types.WorkerEventStopped)
+
+	r.mu.Unlock()
+
+	// Use a fresh context since the worker context is usually cancelled by now
+	ctx, cancel := context.WithTimeout(context.Background(), eventFlushTimeout)
+	defer cancel()
+
+	r.flushEvents(ctx)
 }
 
 // UpdateStatus updates the current status.
@@ -89,15 +146,114 @@ func (r *StatusReporter) UpdateStatus(task string, progress int) {
 	r.status.Progress = progress
 }
 
+// MarkDrained records that the worker is stopping because a drain was requested.
+func (r *StatusReporter) MarkDrained() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.recordEvent(types.WorkerEventDrained)
+}
+
 // SetHealthy updates the health status.
 func (r *StatusReporter) SetHealthy(healthy bool) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if healthy == r.status.IsHealthy {
+		return
+	}
+
 	r.status.IsHealthy = healthy
+	if healthy {
+		r.recordEvent(types.WorkerEventHealthy)
+	} else {
+		r.recordEvent(types.WorkerEventUnhealthy)
+	}
+}
+
+// AddProcessed adds to the number of items processed by the worker.
+func (r *StatusReporter) AddProcessed(n int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.status.Processed += int64(n)
+	r.unloggedCount += int64(n)
+}
+
+// SetDetailsProvider sets a function that supplies extra details, such as
+// current AI batch settings, included in each status report.
+func (r *StatusReporter) SetDetailsProvider(fn func() map[string]string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.detailsFunc = fn
 }
 
 // GetWorkerID returns the unique worker ID.
 func (r *StatusReporter) GetWorkerID() string {
 	return r.status.WorkerID
 }
+
+// report sends the current status to the registry and flushes buffered history.
+func (r *StatusReporter) report(ctx context.Context) {
+	r.mu.Lock()
+	status := r.status
+	detailsFunc := r.detailsFunc
+	r.mu.Unlock()
+
+	if detailsFunc != nil {
+		status.Details = detailsFunc()
+	}
+
+	if err := r.monitor.ReportStatus(ctx, status); err != nil {
+		r.logger.Error("Failed to report status", zap.Error(err))
+	}
+
+	if r.bar != nil {
+		r.bar.SetRuntime(status.StartedAt, status.Restarts, status.Processed)
+	}
+
+	r.flushEvents(ctx)
+}
+
+// recordEvent buffers a history event. Must be called with the lock held.
+func (r *StatusReporter) recordEvent(eventType types.WorkerEventType) {
+	if len(r.pending) >= maxPendingEvents {
+		r.pending = r.pending[1:]
+	}
+
+	r.pending = append(r.pending, &types.WorkerEvent{
+		Timestamp:  time.Now(),
+		WorkerID:   r.status.WorkerID,
+		WorkerSlot: r.slot,
+		WorkerType: r.status.WorkerType,
+		EventType:  eventType,
+		Task:       r.status.CurrentTask,
+		Processed:  r.unloggedCount,
+	})
+	r.unloggedCount = 0
+}
+
+// flushEvents writes buffered history events to the database.
+// Events are kept for the next attempt if the write fails.
+func (r *StatusReporter) flushEvents(ctx context.Context) {
+	r.mu.Lock()
+	events := r.pending
+	r.pending = nil
+	r.mu.Unlock()
+
+	if len(events) == 0 {
+		return
+	}
+
+	if err := r.db.Model().Worker().LogEvents(ctx, events); err != nil {
+		r.logger.Error("Failed to record worker history", zap.Error(err), zap.Int("count", len(events)))
+
+		r.mu.Lock()
+		r.pending = append(events, r.pending...)
+		if overflow := len(r.pending) - maxPendingEvents; overflow > 0 {
+			r.pending = r.pending[overflow:]
+		}
+		r.mu.Unlock()
+	}
+}
@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/bytedance/sonic"
@@ -19,19 +20,47 @@ const (
 
 	// StaleThreshold is how long before a worker is considered offline.
 	StaleThreshold = 1 * time.Minute
+
+	// CrashLoopWindow is the window used to detect workers that keep restarting.
+	CrashLoopWindow = 15 * time.Minute
+
+	// CrashLoopThreshold is the number of starts within CrashLoopWindow
+	// at which a worker is considered to be crash looping.
+	CrashLoopThreshold = 3
+)
+
+const (
+	// RegistryStatusKey is the hash holding the latest status of each worker.
+	RegistryStatusKey = "worker_registry:status"
+
+	// RegistryHeartbeatKey is the sorted set of workers scored by last heartbeat.
+	RegistryHeartbeatKey = "worker_registry:heartbeat"
 )
 
 // Status represents a worker's current state.
 type Status struct {
-	WorkerID    string    `json:"workerId"`
-	WorkerType  string    `json:"workerType"`
-	LastSeen    time.Time `json:"lastSeen"`
-	CurrentTask string    `json:"currentTask,omitempty"`
-	Progress    int       `json:"progress"`
-	IsHealthy   bool      `json:"isHealthy"`
+	WorkerID    string            `json:"workerId"`
+	WorkerType  string            `json:"workerType"`
+	LastSeen    time.Time         `json:"lastSeen"`
+	StartedAt   time.Time         `json:"startedAt"`
+	CurrentTask string            `json:"currentTask,omitempty"`
+	Progress    int               `json:"progress"`
+	IsHealthy   bool              `json:"isHealthy"`
+	Restarts    int               `json:"restarts"`
+	Processed   int64             `json:"processed"`
+	Details     map[string]string `json:"details,omitempty"`
 }
 
-// Monitor handles worker status reporting and querying.
+// Uptime returns how long the worker has been running since its last start.
+func (s *Status) Uptime() time.Duration {
+	if s.StartedAt.IsZero() {
+		return 0
+	}
+
+	return s.LastSeen.Sub(s.StartedAt)
+}
+
+// Monitor handles worker status reporting and querying through the worker registry.
 type Monitor struct {
 	client rueidis.Client
 	logger *zap.Logger
@@ -45,7 +74,7 @@ func NewMonitor(client rueidis.Client, logger *zap.Logger) *Monitor {
 	}
 }
 
-// ReportStatus updates a worker's status in Redis.
+// ReportStatus updates a worker's status in the registry.
 func (m *Monitor) ReportStatus(ctx context.Context, status Status) error {
 	// Update last seen timestamp
 	status.LastSeen = time.Now()
@@ -56,38 +85,41 @@ func (m *Monitor) ReportStatus(ctx context.Context, status Status) error {
 		return fmt.Errorf("failed to marshal status: %w", err)
 	}
 
-	// Store in Redis with TTL
-	key := fmt.Sprintf("worker:%s:%s", status.WorkerType, status.WorkerID)
+	// Store status and heartbeat together
+	member := registryMember(status.WorkerType, status.WorkerID)
+	cmds := rueidis.Commands{
+		m.client.B().Hset().Key(RegistryStatusKey).FieldValue().FieldValue(member, string(data)).Build(),
+		m.client.B().Zadd().Key(RegistryHeartbeatKey).ScoreMember().
+			ScoreMember(float64(status.LastSeen.Unix()), member).Build(),
+	}
 
-	err = m.client.Do(ctx, m.client.B().Set().Key(key).Value(string(data)).Ex(HeartbeatTTL).Build()).Error()
-	if err != nil {
-		return fmt.Errorf("failed to store status: %w", err)
+	for _, resp := range m.client.DoMulti(ctx, cmds...) {
+		if err := resp.Error(); err != nil {
+			return fmt.Errorf("failed to store status: %w", err)
+		}
 	}
 
 	return nil
 }
 
-// GetAllStatuses retrieves all worker statuses.
+// GetAllStatuses retrieves all worker statuses that reported within HeartbeatTTL.
+// Expired workers are removed from the registry.
 func (m *Monitor) GetAllStatuses(ctx context.Context) ([]Status, error) {
-	// Get all worker keys
-	keys, err := m.client.Do(ctx, m.client.B().Keys().Pattern("worker:*").Build()).AsStrSlice()
+	if err := m.pruneExpired(ctx); err != nil {
+		m.logger.Warn("Failed to prune expired workers", zap.Error(err))
+	}
+
+	values, err := m.client.Do(ctx, m.client.B().Hvals().Key(RegistryStatusKey).Build()).AsStrSlice()
 	if err != nil {
-		return nil, fmt.Errorf("failed to get worker keys: %w", err)
+		return nil, fmt.Errorf("failed to get worker statuses: %w", err)
 	}
 
-	statuses := make([]Status, 0, len(keys))
-
-	// Get each worker's status
-	for _, key := range keys {
-		data, err := m.client.Do(ctx, m.client.B().Get().Key(key).Build()).AsBytes()
-		if err != nil {
-			m.logger.Error("Failed to get worker status", zap.String("key", key), zap.Error(err))
-			continue
-		}
+	statuses := make([]Status, 0, len(values))
 
+	for _, value := range values {
 		var status Status
-		if err := sonic.Unmarshal(data, &status); err != nil {
-			m.logger.Error("Failed to unmarshal worker status", zap.String("key", key), zap.Error(err))
+		if err := sonic.UnmarshalString(value, &status); err != nil {
+			m.logger.Error("Failed to unmarshal worker status", zap.Error(err))
 			continue
 		}
 
@@ -96,3 +128,31 @@ func (m *Monitor) GetAllStatuses(ctx context.Context) ([]Status, error) {
 
 	return statuses, nil
 }
+
+// pruneExpiredScript removes workers whose heartbeat is at or below the cutoff from both
+// registry keys in one step, so a worker that reports in between is never removed.
+var pruneExpiredScript = rueidis.NewLuaScript(`
+local expired = redis.call("ZRANGEBYSCORE", KEYS[2], "-inf", ARGV[1])
+for _, member in ipairs(expired) do
+	redis.call("HDEL", KEYS[1], member)
+	redis.call("ZREM", KEYS[2], member)
+end
+return #expired`)
+
+// pruneExpired removes workers whose last heartbeat is older than HeartbeatTTL.
+func (m *Monitor) pruneExpired(ctx context.Context) error {
+	cutoff := strconv.FormatInt(time.Now().Add(-HeartbeatTTL).Unix(), 10)
+
+	err := pruneExpiredScript.Exec(ctx, m.client,
+		[]string{RegistryStatusKey, RegistryHeartbeatKey}, []string{cutoff}).Error()
+	if err != nil {
+		return fmt.Errorf("failed to remove expired workers: %w", err)
+	}
+
+	return nil
+}
+
+// registryMember returns the registry member name for a worker.
+func registryMember(workerType, workerID string) string {
+	return workerType + ":" + workerID
+}
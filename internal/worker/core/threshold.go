@@ -40,6 +40,11 @@ func NewThresholdChecker(
 	}
 }
 
+// SetThreshold updates the flagged users threshold.
+func (tc *ThresholdChecker) SetThreshold(threshold int) {
+	tc.threshold = threshold
+}
+
 // CheckThreshold checks if the flagged users count exceeds the threshold.
 // Returns true if threshold is exceeded and worker should pause, false if worker should continue.
 func (tc *ThresholdChecker) CheckThreshold(ctx context.Context) (bool, error) {
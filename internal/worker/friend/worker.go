@@ -12,9 +12,11 @@ import (
 	"github.com/robalyx/rotector/internal/database"
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/database/types/enum"
+	"github.com/robalyx/rotector/internal/metrics"
 	"github.com/robalyx/rotector/internal/roblox/checker"
 	"github.com/robalyx/rotector/internal/roblox/fetcher"
 	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/setup/telemetry/tracing"
 	"github.com/robalyx/rotector/internal/tui/components"
 	"github.com/robalyx/rotector/internal/worker/core"
 	"github.com/robalyx/rotector/pkg/utils"
@@ -50,7 +52,8 @@ type Worker struct {
 	friendFetcher    *fetcher.FriendFetcher
 	reporter         *core.StatusReporter
 	thresholdChecker *core.ThresholdChecker
-	pendingFriends   []*types.ReviewUser
+	control          *core.Controller
+	jobQueue         *core.JobQueue
 	logger           *zap.Logger
 	batchSize        int
 }
@@ -60,7 +63,8 @@ func New(app *setup.App, bar *components.ProgressBar, logger *zap.Logger, instan
 	userFetcher := fetcher.NewUserFetcher(app, logger)
 	userChecker := checker.NewUserChecker(app, userFetcher, logger)
 	friendFetcher := fetcher.NewFriendFetcher(app.DB, app.RoAPI, logger)
-	reporter := core.NewStatusReporter(app.StatusClient, "friend", instanceID, logger)
+	reporter := core.NewStatusReporter(app.StatusClient, app.DB, bar, "friend", instanceID, logger)
+	reporter.SetDetailsProvider(userChecker.AdaptiveSettings)
 	thresholdChecker := core.NewThresholdChecker(
 		app.DB,
 		app.Config.Worker.ThresholdLimits.FlaggedUsers,
@@ -80,7 +84,8 @@ func New(app *setup.App, bar *components.ProgressBar, logger *zap.Logger, instan
 		friendFetcher:    friendFetcher,
 		reporter:         reporter,
 		thresholdChecker: thresholdChecker,
-		pendingFriends:   make([]*types.ReviewUser, 0),
+		control:          core.NewController(app.StatusClient, "friend", &app.Config.Worker, bar, reporter, logger),
+		jobQueue:         core.NewJobQueue(app.DB, types.JobTypeFriendScan, reporter.GetWorkerID(), logger),
 		logger:           logger.Named("friend_worker"),
 		batchSize:        app.Config.Worker.BatchSizes.FriendUsers,
 	}
@@ -104,6 +109,15 @@ func (w *Worker) Start(ctx context.Context) {
 			return
 		}
 
+		// Honour control plane commands and apply live config overrides
+		if !w.control.Checkpoint(ctx) {
+			return
+		}
+
+		cfg := w.control.Config()
+		w.batchSize = cfg.BatchSizes.FriendUsers
+		w.thresholdChecker.SetThreshold(cfg.ThresholdLimits.FlaggedUsers)
+
 		w.bar.Reset()
 		w.reporter.SetHealthy(true)
 
@@ -121,13 +135,18 @@ func (w *Worker) Start(ctx context.Context) {
 			continue
 		}
 
+		// Trace the batch through collecting, checking and completing
+		batchCtx, span := tracing.Start(ctx, "FriendWorker.processBatch")
+
 		// Step 1: Process friends batch (40%)
 		w.bar.SetStepMessage("Processing friends batch", 40)
 		w.reporter.UpdateStatus("Processing friends batch", 40)
 
-		userInfos, err := w.processFriendsBatch(ctx)
+		userInfos, jobs, err := w.processFriendsBatch(batchCtx)
 		if err != nil {
 			w.reporter.SetHealthy(false)
+			w.failJobs(batchCtx, jobs, err)
+			tracing.End(span, err)
 
 			if !utils.ErrorSleep(ctx, 5*time.Minute, w.logger, "friend worker") {
 				return
@@ -139,31 +158,44 @@ func (w *Worker) Start(ctx context.Context) {
 		// Step 2: Process users (60%)
 		w.bar.SetStepMessage("Processing users", 60)
 		w.reporter.UpdateStatus("Processing users", 60)
-		w.userChecker.ProcessUsers(ctx, &checker.UserCheckerParams{
-			Users:                     userInfos[:w.batchSize],
-			InappropriateOutfitFlags:  nil,
-			InappropriateProfileFlags: nil,
-			InappropriateFriendsFlags: nil,
-			InappropriateGroupsFlags:  nil,
-			FromQueueWorker:           false,
-		})
 
-		// Mark processed users in cache to prevent reprocessing
-		userCreationDates := make(map[int64]time.Time)
-		for _, user := range userInfos[:w.batchSize] {
-			userCreationDates[user.ID] = user.CreatedAt
-		}
+		span.SetAttributes(tracing.BatchSize(len(userInfos)))
+
+		flaggedCount := 0
 
-		if err := w.db.Service().Cache().MarkUsersProcessed(ctx, userCreationDates); err != nil {
-			w.logger.Error("Failed to mark users as processed in cache", zap.Error(err))
+		for start := 0; start < len(userInfos); start += w.batchSize {
+			usersToProcess := userInfos[start:min(start+w.batchSize, len(userInfos))]
+
+			processResult := w.userChecker.ProcessUsers(batchCtx, &checker.UserCheckerParams{
+				Users:                     usersToProcess,
+				InappropriateOutfitFlags:  nil,
+				InappropriateProfileFlags: nil,
+				InappropriateFriendsFlags: nil,
+				InappropriateGroupsFlags:  nil,
+				FromQueueWorker:           false,
+			})
+
+			metrics.AddUsersProcessed("friend", len(usersToProcess), len(processResult.FlaggedStatus))
+			w.reporter.AddProcessed(len(usersToProcess))
+
+			flaggedCount += len(processResult.FlaggedStatus)
+
+			// Mark processed users in cache and schedule their next scan
+			if err := w.db.Service().Cache().MarkUsersProcessed(batchCtx, usersToProcess); err != nil {
+				w.logger.Error("Failed to mark users as processed in cache", zap.Error(err))
+				tracing.RecordError(span, err)
+			}
 		}
 
 		// Step 3: Processing completed (80%)
 		w.bar.SetStepMessage("Processing completed", 80)
 		w.reporter.UpdateStatus("Processing completed", 80)
 
-		// Step 4: Prepare for next batch
-		w.pendingFriends = userInfos[w.batchSize:]
+		// Step 4: Complete the jobs whose friends were processed
+		w.completeJobs(batchCtx, jobs)
+
+		span.SetAttributes(tracing.FlaggedCount(flaggedCount))
+		span.End()
 
 		// Step 5: Completed (100%)
 		w.bar.SetStepMessage("Completed", 100)
@@ -177,8 +209,12 @@ func (w *Worker) Start(ctx context.Context) {
 }
 
 // processFriendsBatch builds a list of validated friends to check.
-func (w *Worker) processFriendsBatch(ctx context.Context) ([]*types.ReviewUser, error) {
-	validFriends := w.pendingFriends
+// Returns the friends along with the leased jobs of the users they were collected from.
+func (w *Worker) processFriendsBatch(ctx context.Context) ([]*types.ReviewUser, []*core.LeasedJob, error) {
+	var (
+		validFriends []*types.ReviewUser
+		jobs         []*core.LeasedJob
+	)
 
 	// Track processing metrics
 	usersProcessed := 0
@@ -186,7 +222,7 @@ func (w *Worker) processFriendsBatch(ctx context.Context) ([]*types.ReviewUser,
 
 	for len(validFriends) < w.batchSize {
 		// Get the next confirmed user
-		user, err := w.db.Model().User().GetUserToScan(ctx)
+		job, user, err := w.leaseNextUser(ctx)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) {
 				w.logger.Warn("No more users to scan")
@@ -195,16 +231,20 @@ func (w *Worker) processFriendsBatch(ctx context.Context) ([]*types.ReviewUser,
 
 			w.logger.Error("Error getting user to scan", zap.Error(err))
 
-			return validFriends, err
+			return validFriends, jobs, err
 		}
 
 		// Check if we should process this user's friends based on count changes
 		userFriendIDs, err := w.friendFetcher.GetFriendIDs(ctx, user.ID)
 		if err != nil {
 			w.logger.Error("Error fetching friends", zap.Error(err), zap.Int64("userID", user.ID))
+			w.failJobs(ctx, []*core.LeasedJob{job}, err)
+
 			continue
 		}
 
+		jobs = append(jobs, job)
+
 		currentFriendCount := len(userFriendIDs)
 
 		// Compare current friend count with cached value
@@ -327,7 +367,80 @@ func (w *Worker) processFriendsBatch(ctx context.Context) ([]*types.ReviewUser,
 			zap.Int("validFriendsFound", len(validFriends)))
 	}
 
-	return validFriends, nil
+	return validFriends, jobs, nil
+}
+
+// leaseNextUser leases the next friend scan job, enqueuing the next confirmed user
+// to scan when the queue is empty. Returns sql.ErrNoRows if there is nothing to scan.
+func (w *Worker) leaseNextUser(ctx context.Context) (*core.LeasedJob, *types.ReviewUser, error) {
+	for {
+		job, err := w.jobQueue.Lease(ctx)
+		if errors.Is(err, types.ErrNoJobsAvailable) {
+			// Produce a job for the next confirmed user
+			user, err := w.db.Model().User().GetUserToScan(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			_, err = w.jobQueue.Enqueue(ctx, fmt.Sprintf("friend_scan:%d", user.ID), &types.FriendScanPayload{
+				UserID: user.ID,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var payload types.FriendScanPayload
+		if err := job.Decode(&payload); err != nil {
+			w.failJobs(ctx, []*core.LeasedJob{job}, err)
+			continue
+		}
+
+		users, err := w.db.Model().User().GetUsersByIDs(
+			ctx, []int64{payload.UserID}, types.UserFieldID|types.UserFieldStatus,
+		)
+		if err != nil {
+			w.failJobs(ctx, []*core.LeasedJob{job}, err)
+			return nil, nil, err
+		}
+
+		// Users removed since the job was queued have nothing left to scan
+		user, ok := users[payload.UserID]
+		if !ok {
+			w.completeJobs(ctx, []*core.LeasedJob{job})
+			continue
+		}
+
+		return job, user, nil
+	}
+}
+
+// completeJobs marks the given jobs as completed.
+func (w *Worker) completeJobs(ctx context.Context, jobs []*core.LeasedJob) {
+	for _, job := range jobs {
+		if err := job.Complete(ctx); err != nil {
+			w.logger.Error("Failed to complete friend scan job",
+				zap.Error(err),
+				zap.Int64("jobID", job.ID))
+		}
+	}
+}
+
+// failJobs records a failed attempt for the given jobs so they are retried.
+func (w *Worker) failJobs(ctx context.Context, jobs []*core.LeasedJob, jobErr error) {
+	for _, job := range jobs {
+		if err := job.Fail(ctx, jobErr); err != nil {
+			w.logger.Error("Failed to record friend scan job failure",
+				zap.Error(err),
+				zap.Int64("jobID", job.ID))
+		}
+	}
 }
 
 // filterUsersByNetwork filters users based on their network characteristics.
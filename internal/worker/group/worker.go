@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"maps"
 	"time"
 
 	"github.com/jaxron/roapi.go/pkg/api"
@@ -11,12 +13,15 @@ import (
 	"github.com/robalyx/rotector/internal/cloudflare"
 	"github.com/robalyx/rotector/internal/database"
 	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/metrics"
 	"github.com/robalyx/rotector/internal/roblox/checker"
 	"github.com/robalyx/rotector/internal/roblox/fetcher"
 	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/setup/telemetry/tracing"
 	"github.com/robalyx/rotector/internal/tui/components"
 	"github.com/robalyx/rotector/internal/worker/core"
 	"github.com/robalyx/rotector/pkg/utils"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
@@ -38,7 +43,10 @@ type Worker struct {
 	userChecker      *checker.UserChecker
 	reporter         *core.StatusReporter
 	thresholdChecker *core.ThresholdChecker
-	pendingUsers     []*types.ReviewUser
+	control          *core.Controller
+	jobQueue         *core.JobQueue
+	currentJob       *core.LeasedJob
+	finishedJobs     []*core.LeasedJob
 	logger           *zap.Logger
 	batchSize        int
 	currentGroupID   int64
@@ -49,7 +57,8 @@ type Worker struct {
 func New(app *setup.App, bar *components.ProgressBar, logger *zap.Logger, instanceID string) *Worker {
 	userFetcher := fetcher.NewUserFetcher(app, logger)
 	userChecker := checker.NewUserChecker(app, userFetcher, logger)
-	reporter := core.NewStatusReporter(app.StatusClient, "group", instanceID, logger)
+	reporter := core.NewStatusReporter(app.StatusClient, app.DB, bar, "group", instanceID, logger)
+	reporter.SetDetailsProvider(userChecker.AdaptiveSettings)
 	thresholdChecker := core.NewThresholdChecker(
 		app.DB,
 		app.Config.Worker.ThresholdLimits.FlaggedUsers,
@@ -68,7 +77,8 @@ func New(app *setup.App, bar *components.ProgressBar, logger *zap.Logger, instan
 		userChecker:      userChecker,
 		reporter:         reporter,
 		thresholdChecker: thresholdChecker,
-		pendingUsers:     make([]*types.ReviewUser, 0),
+		control:          core.NewController(app.StatusClient, "group", &app.Config.Worker, bar, reporter, logger),
+		jobQueue:         core.NewJobQueue(app.DB, types.JobTypeGroupScan, reporter.GetWorkerID(), logger),
 		logger:           logger.Named("group_worker"),
 		batchSize:        app.Config.Worker.BatchSizes.GroupUsers,
 	}
@@ -92,6 +102,15 @@ func (w *Worker) Start(ctx context.Context) {
 			return
 		}
 
+		// Honour control plane commands and apply live config overrides
+		if !w.control.Checkpoint(ctx) {
+			return
+		}
+
+		cfg := w.control.Config()
+		w.batchSize = cfg.BatchSizes.GroupUsers
+		w.thresholdChecker.SetThreshold(cfg.ThresholdLimits.FlaggedUsers)
+
 		w.bar.Reset()
 		w.reporter.SetHealthy(true)
 
@@ -109,13 +128,17 @@ func (w *Worker) Start(ctx context.Context) {
 			continue
 		}
 
+		// Trace the batch through collecting, checking and completing
+		batchCtx, span := tracing.Start(ctx, "GroupWorker.processBatch")
+
 		// Step 1: Get next group to process (10%)
 		w.bar.SetStepMessage("Fetching next group to process", 10)
 		w.reporter.UpdateStatus("Fetching next group to process", 10)
 
 		if w.currentGroupID == 0 {
-			if err := w.moveToNextGroup(ctx); err != nil {
+			if err := w.moveToNextGroup(batchCtx); err != nil {
 				w.reporter.SetHealthy(false)
+				tracing.End(span, err)
 
 				if !utils.ErrorSleep(ctx, 5*time.Minute, w.logger, "group worker") {
 					return
@@ -129,10 +152,14 @@ func (w *Worker) Start(ctx context.Context) {
 		w.bar.SetStepMessage("Processing group users", 70)
 		w.reporter.UpdateStatus("Processing group users", 70)
 
-		userInfos, err := w.processGroup(ctx)
+		span.SetAttributes(attribute.Int64("rotector.group_id", w.currentGroupID))
+
+		userInfos, err := w.processGroup(batchCtx)
 		if err != nil {
 			w.reporter.SetHealthy(false)
 			w.logger.Error("Error processing group users", zap.Error(err))
+			w.failGroupJobs(batchCtx, err)
+			tracing.End(span, err)
 
 			if !utils.ErrorSleep(ctx, 5*time.Minute, w.logger, "group worker") {
 				return
@@ -145,39 +172,20 @@ func (w *Worker) Start(ctx context.Context) {
 		w.bar.SetStepMessage("Processing users", 90)
 		w.reporter.UpdateStatus("Processing users", 90)
 
-		// Process users up to batch size or all available users if less than batch size
-		usersToProcess := userInfos
-		if len(userInfos) > w.batchSize {
-			usersToProcess = userInfos[:w.batchSize]
-		}
-
-		processResult := w.userChecker.ProcessUsers(ctx, &checker.UserCheckerParams{
-			Users:                     usersToProcess,
-			InappropriateOutfitFlags:  nil,
-			InappropriateProfileFlags: nil,
-			InappropriateFriendsFlags: nil,
-			InappropriateGroupsFlags:  nil,
-			FromQueueWorker:           false,
-		})
-
-		// Mark processed users in cache to prevent reprocessing
-		userCreationDates := make(map[int64]time.Time)
-		for _, user := range usersToProcess {
-			userCreationDates[user.ID] = user.CreatedAt
-		}
+		processResult := w.processUsers(batchCtx, userInfos)
 
-		if err := w.db.Service().Cache().MarkUsersProcessed(ctx, userCreationDates); err != nil {
-			w.logger.Error("Failed to mark users as processed in cache", zap.Error(err))
-		}
+		span.SetAttributes(tracing.BatchSize(len(userInfos)), tracing.FlaggedCount(len(processResult.FlaggedStatus)))
 
 		// Step 4: Processing completed (95%)
 		w.bar.SetStepMessage("Processing completed", 95)
 		w.reporter.UpdateStatus("Processing completed", 95)
 
 		// Check if we should skip this group based on flag rate
-		if w.shouldSkipGroupByFlagRate(usersToProcess, processResult) {
-			if err := w.moveToNextGroup(ctx); err != nil {
+		if w.shouldSkipGroupByFlagRate(userInfos, processResult) {
+			if err := w.moveToNextGroup(batchCtx); err != nil {
 				w.reporter.SetHealthy(false)
+				w.completeGroupJobs(batchCtx)
+				tracing.End(span, err)
 
 				if !utils.ErrorSleep(ctx, 5*time.Minute, w.logger, "group worker") {
 					return
@@ -185,18 +193,11 @@ func (w *Worker) Start(ctx context.Context) {
 
 				continue
 			}
-			// Clear pending users since we're moving to a new group
-			w.pendingUsers = nil
-
-			continue
 		}
 
-		// Step 5: Prepare for next batch
-		if len(userInfos) > w.batchSize {
-			w.pendingUsers = userInfos[w.batchSize:]
-		} else {
-			w.pendingUsers = nil
-		}
+		// Step 5: Record progress of the group jobs
+		w.completeGroupJobs(batchCtx)
+		span.End()
 
 		// Step 6: Completed (100%)
 		w.bar.SetStepMessage("Completed", 100)
@@ -209,9 +210,47 @@ func (w *Worker) Start(ctx context.Context) {
 	}
 }
 
+// processUsers checks the collected users in batches and marks them as processed.
+// Returns the combined results of all batches.
+func (w *Worker) processUsers(ctx context.Context, userInfos []*types.ReviewUser) *checker.ProcessResult {
+	combined := &checker.ProcessResult{
+		FlaggedStatus:  make(map[int64]struct{}),
+		FlaggedUsers:   make(map[int64]*types.ReviewUser),
+		ConfirmedUsers: make(map[int64]*types.ReviewUser),
+	}
+
+	for start := 0; start < len(userInfos); start += w.batchSize {
+		usersToProcess := userInfos[start:min(start+w.batchSize, len(userInfos))]
+
+		processResult := w.userChecker.ProcessUsers(ctx, &checker.UserCheckerParams{
+			Users:                     usersToProcess,
+			InappropriateOutfitFlags:  nil,
+			InappropriateProfileFlags: nil,
+			InappropriateFriendsFlags: nil,
+			InappropriateGroupsFlags:  nil,
+			FromQueueWorker:           false,
+		})
+
+		metrics.AddUsersProcessed("group", len(usersToProcess), len(processResult.FlaggedStatus))
+		w.reporter.AddProcessed(len(usersToProcess))
+
+		maps.Copy(combined.FlaggedStatus, processResult.FlaggedStatus)
+		maps.Copy(combined.FlaggedUsers, processResult.FlaggedUsers)
+		maps.Copy(combined.ConfirmedUsers, processResult.ConfirmedUsers)
+
+		// Mark processed users in cache and schedule their next scan
+		if err := w.db.Service().Cache().MarkUsersProcessed(ctx, usersToProcess); err != nil {
+			w.logger.Error("Failed to mark users as processed in cache", zap.Error(err))
+		}
+	}
+
+	return combined
+}
+
 // processGroup builds a list of validated users to check.
 func (w *Worker) processGroup(ctx context.Context) ([]*types.ReviewUser, error) {
-	validUsers := w.pendingUsers
+	var validUsers []*types.ReviewUser
+
 	groupsAttempted := 0
 
 	for len(validUsers) < w.batchSize && groupsAttempted < MaxGroupsPerBatch {
@@ -255,14 +294,20 @@ func (w *Worker) processGroup(ctx context.Context) ([]*types.ReviewUser, error)
 	return validUsers, nil
 }
 
-// moveToNextGroup resets the current group state and gets the next group to scan.
+// moveToNextGroup finishes the current group job and leases the next group to scan.
+// The finished job is completed once the users collected from it have been processed.
 func (w *Worker) moveToNextGroup(ctx context.Context) error {
 	// Reset state
+	if w.currentJob != nil {
+		w.finishedJobs = append(w.finishedJobs, w.currentJob)
+		w.currentJob = nil
+	}
+
 	w.currentGroupID = 0
 	w.currentCursor = ""
 
 	// Get next group
-	nextGroup, err := w.db.Model().Group().GetGroupToScan(ctx)
+	job, payload, err := w.leaseNextGroup(ctx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			w.logger.Warn("No more groups to scan")
@@ -273,11 +318,116 @@ func (w *Worker) moveToNextGroup(ctx context.Context) error {
 		return err
 	}
 
-	w.currentGroupID = nextGroup.ID
+	w.currentJob = job
+	w.currentGroupID = payload.GroupID
+	w.currentCursor = payload.Cursor
 
 	return nil
 }
 
+// leaseNextGroup leases the next group scan job, enqueuing the next group to scan
+// when the queue is empty. Returns sql.ErrNoRows if there is nothing to scan.
+func (w *Worker) leaseNextGroup(ctx context.Context) (*core.LeasedJob, *types.GroupScanPayload, error) {
+	for {
+		job, err := w.jobQueue.Lease(ctx)
+		if errors.Is(err, types.ErrNoJobsAvailable) {
+			// Produce a job for the next group
+			group, err := w.db.Model().Group().GetGroupToScan(ctx)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			_, err = w.jobQueue.Enqueue(ctx, fmt.Sprintf("group_scan:%d", group.ID), &types.GroupScanPayload{
+				GroupID: group.ID,
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var payload types.GroupScanPayload
+		if err := job.Decode(&payload); err != nil {
+			if err := job.Fail(ctx, err); err != nil {
+				w.logger.Error("Failed to record group scan job failure", zap.Error(err), zap.Int64("jobID", job.ID))
+			}
+
+			continue
+		}
+
+		if payload.Cursor != "" {
+			w.logger.Info("Resuming group scan from checkpoint",
+				zap.Int64("groupID", payload.GroupID),
+				zap.Int("attempt", job.Attempts))
+		}
+
+		return job, &payload, nil
+	}
+}
+
+// completeGroupJobs completes the jobs of finished groups and checkpoints the
+// cursor of the current group so that another worker can resume it.
+func (w *Worker) completeGroupJobs(ctx context.Context) {
+	for _, job := range w.finishedJobs {
+		if err := job.Complete(ctx); err != nil {
+			w.logger.Error("Failed to complete group scan job", zap.Error(err), zap.Int64("jobID", job.ID))
+		}
+	}
+
+	w.finishedJobs = nil
+
+	if w.currentJob == nil {
+		return
+	}
+
+	err := w.currentJob.Checkpoint(ctx, &types.GroupScanPayload{
+		GroupID: w.currentGroupID,
+		Cursor:  w.currentCursor,
+	})
+	if err != nil {
+		w.logger.Warn("Failed to checkpoint group scan job, dropping group",
+			zap.Error(err),
+			zap.Int64("groupID", w.currentGroupID))
+
+		// Another worker owns the job once the lease is lost, otherwise retry it later
+		if errors.Is(err, types.ErrJobLeaseLost) {
+			w.currentJob.Release()
+		} else if err := w.currentJob.Fail(ctx, err); err != nil {
+			w.logger.Error("Failed to record group scan job failure",
+				zap.Error(err),
+				zap.Int64("jobID", w.currentJob.ID))
+		}
+
+		w.currentJob = nil
+		w.currentGroupID = 0
+		w.currentCursor = ""
+	}
+}
+
+// failGroupJobs records a failed attempt for the current and finished group jobs so they are retried.
+func (w *Worker) failGroupJobs(ctx context.Context, jobErr error) {
+	jobs := w.finishedJobs
+	if w.currentJob != nil {
+		jobs = append(jobs, w.currentJob)
+	}
+
+	for _, job := range jobs {
+		if err := job.Fail(ctx, jobErr); err != nil {
+			w.logger.Error("Failed to record group scan job failure", zap.Error(err), zap.Int64("jobID", job.ID))
+		}
+	}
+
+	w.finishedJobs = nil
+	w.currentJob = nil
+	w.currentGroupID = 0
+	w.currentCursor = ""
+}
+
 // collectUserIDsFromGroup collects user IDs from the current group that don't exist in our system.
 func (w *Worker) collectUserIDsFromGroup(ctx context.Context) ([]int64, bool, error) {
 	// Fetch group users with cursor pagination
@@ -289,6 +439,15 @@ func (w *Worker) collectUserIDsFromGroup(ctx context.Context) ([]int64, bool, er
 			zap.Int64("groupID", w.currentGroupID),
 			zap.Error(err))
 
+		// Retry this group later
+		if w.currentJob != nil {
+			if err := w.currentJob.Fail(ctx, err); err != nil {
+				w.logger.Error("Failed to record group scan job failure", zap.Error(err), zap.Int64("jobID", w.currentJob.ID))
+			}
+
+			w.currentJob = nil
+		}
+
 		// Reset state and try next group
 		if err := w.moveToNextGroup(ctx); err != nil {
 			return nil, false, err
@@ -10,18 +10,34 @@ import (
 	"github.com/disgoorg/snowflake/v2"
 	"github.com/jaxron/roapi.go/pkg/api"
 	"github.com/robalyx/rotector/internal/cloudflare"
+	"github.com/robalyx/rotector/internal/cloudflare/manager"
 	"github.com/robalyx/rotector/internal/database"
 	"github.com/robalyx/rotector/internal/database/types"
 	"github.com/robalyx/rotector/internal/roblox/checker"
 	"github.com/robalyx/rotector/internal/roblox/fetcher"
 	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/setup/config"
+	"github.com/robalyx/rotector/internal/translator"
 	"github.com/robalyx/rotector/internal/tui/components"
 	"github.com/robalyx/rotector/internal/worker/core"
 	"github.com/robalyx/rotector/pkg/utils"
 	"go.uber.org/zap"
 )
 
+const (
+	// rescanRequeueDelay is how long users added to the recheck lane wait before they
+	// can be added again if they have not been processed by then.
+	rescanRequeueDelay = 6 * time.Hour
+
+	// ruleBacktestBatchSize is how many suggested rules are back-tested per iteration.
+	ruleBacktestBatchSize = 5
+)
+
 // Worker handles all maintenance operations.
+//
+// Unlike the friend and group workers it does not use the job queue. Only the elected
+// leader runs maintenance, so no two instances share its work, and each step is an
+// idempotent sweep that a new leader simply repeats after a crash.
 type Worker struct {
 	db                       database.Client
 	cfClient                 *cloudflare.Client
@@ -30,30 +46,40 @@ type Worker struct {
 	bar                      *components.ProgressBar
 	userFetcher              *fetcher.UserFetcher
 	groupFetcher             *fetcher.GroupFetcher
+	gameFetcher              *fetcher.GameFetcher
 	thumbnailFetcher         *fetcher.ThumbnailFetcher
 	groupChecker             *checker.GroupChecker
+	gameChecker              *checker.GameChecker
 	reporter                 *core.StatusReporter
+	control                  *core.Controller
+	leader                   *core.LeaderElector
 	logger                   *zap.Logger
 	reviewerInfoMaxAge       time.Duration
 	userBatchSize            int
 	groupBatchSize           int
 	trackBatchSize           int
 	trackUserGroupsBatchSize int
+	trackGamesBatchSize      int
 	thumbnailUserBatchSize   int
 	thumbnailGroupBatchSize  int
+	rescanBatchSize          int
 	maxGroupMembersTrack     int64
 	minGroupFlaggedUsers     int
 	minFlaggedOverride       int
 	minFlaggedPercent        float64
+	minGameFlaggedUsers      int
+	minGameFlaggedOverride   int
 }
 
 // New creates a new maintenance worker.
 func New(app *setup.App, bar *components.ProgressBar, logger *zap.Logger, instanceID string) *Worker {
 	userFetcher := fetcher.NewUserFetcher(app, logger)
 	groupFetcher := fetcher.NewGroupFetcher(app.RoAPI, logger)
+	gameFetcher := fetcher.NewGameFetcher(app.RoAPI, logger)
 	thumbnailFetcher := fetcher.NewThumbnailFetcher(app.RoAPI, logger)
-	reporter := core.NewStatusReporter(app.StatusClient, "maintenance", instanceID, logger)
-	groupChecker := checker.NewGroupChecker(app, logger)
+	reporter := core.NewStatusReporter(app.StatusClient, app.DB, bar, "maintenance", instanceID, logger)
+	groupChecker := checker.NewGroupChecker(app, translator.New(app.RoAPI.GetClient()), logger)
+	gameChecker := checker.NewGameChecker(app, logger)
 
 	// Create Discord client
 	client, err := disgo.New(app.Config.Bot.Discord.Token,
@@ -68,30 +94,45 @@ func New(app *setup.App, bar *components.ProgressBar, logger *zap.Logger, instan
 		logger.Fatal("failed to create Discord client", zap.Error(err))
 	}
 
-	return &Worker{
-		db:                       app.DB,
-		cfClient:                 app.CFClient,
-		bot:                      client,
-		roAPI:                    app.RoAPI,
-		bar:                      bar,
-		userFetcher:              userFetcher,
-		groupFetcher:             groupFetcher,
-		thumbnailFetcher:         thumbnailFetcher,
-		groupChecker:             groupChecker,
-		reporter:                 reporter,
-		logger:                   logger.Named("maintenance_worker"),
-		reviewerInfoMaxAge:       24 * time.Hour,
-		userBatchSize:            app.Config.Worker.BatchSizes.PurgeUsers,
-		groupBatchSize:           app.Config.Worker.BatchSizes.PurgeGroups,
-		trackBatchSize:           app.Config.Worker.BatchSizes.TrackGroups,
-		trackUserGroupsBatchSize: app.Config.Worker.BatchSizes.TrackUserGroups,
-		thumbnailUserBatchSize:   app.Config.Worker.BatchSizes.ThumbnailUsers,
-		thumbnailGroupBatchSize:  app.Config.Worker.BatchSizes.ThumbnailGroups,
-		maxGroupMembersTrack:     app.Config.Worker.ThresholdLimits.MaxGroupMembersTrack,
-		minGroupFlaggedUsers:     app.Config.Worker.ThresholdLimits.MinGroupFlaggedUsers,
-		minFlaggedOverride:       app.Config.Worker.ThresholdLimits.MinFlaggedOverride,
-		minFlaggedPercent:        app.Config.Worker.ThresholdLimits.MinFlaggedPercentage,
-	}
+	w := &Worker{
+		db:                 app.DB,
+		cfClient:           app.CFClient,
+		bot:                client,
+		roAPI:              app.RoAPI,
+		bar:                bar,
+		userFetcher:        userFetcher,
+		groupFetcher:       groupFetcher,
+		gameFetcher:        gameFetcher,
+		thumbnailFetcher:   thumbnailFetcher,
+		groupChecker:       groupChecker,
+		gameChecker:        gameChecker,
+		reporter:           reporter,
+		control:            core.NewController(app.StatusClient, "maintenance", &app.Config.Worker, bar, reporter, logger),
+		leader:             core.NewLeaderElector(app.StatusClient, "maintenance", instanceID, logger),
+		logger:             logger.Named("maintenance_worker"),
+		reviewerInfoMaxAge: 24 * time.Hour,
+	}
+	w.applyConfig(&app.Config.Worker)
+
+	return w
+}
+
+// applyConfig sets the batch sizes and thresholds used by the maintenance steps.
+func (w *Worker) applyConfig(cfg *config.WorkerConfig) {
+	w.userBatchSize = cfg.BatchSizes.PurgeUsers
+	w.groupBatchSize = cfg.BatchSizes.PurgeGroups
+	w.trackBatchSize = cfg.BatchSizes.TrackGroups
+	w.trackUserGroupsBatchSize = cfg.BatchSizes.TrackUserGroups
+	w.trackGamesBatchSize = cfg.BatchSizes.TrackGames
+	w.thumbnailUserBatchSize = cfg.BatchSizes.ThumbnailUsers
+	w.thumbnailGroupBatchSize = cfg.BatchSizes.ThumbnailGroups
+	w.rescanBatchSize = cfg.BatchSizes.RescanUsers
+	w.maxGroupMembersTrack = cfg.ThresholdLimits.MaxGroupMembersTrack
+	w.minGroupFlaggedUsers = cfg.ThresholdLimits.MinGroupFlaggedUsers
+	w.minFlaggedOverride = cfg.ThresholdLimits.MinFlaggedOverride
+	w.minFlaggedPercent = cfg.ThresholdLimits.MinFlaggedPercentage
+	w.minGameFlaggedUsers = cfg.ThresholdLimits.MinGameFlaggedUsers
+	w.minGameFlaggedOverride = cfg.ThresholdLimits.MinGameFlaggedOverride
 }
 
 // Start begins the maintenance worker's main loop.
@@ -101,6 +142,16 @@ func (w *Worker) Start(ctx context.Context) {
 	w.reporter.Start(ctx)
 	defer w.reporter.Stop()
 
+	// Wait on standby until this instance is the leader
+	w.bar.SetStepMessage("Standby: waiting for leadership", 0)
+	w.reporter.UpdateStatus("Standby: waiting for leadership", 0)
+
+	ctx, ok := w.leader.Campaign(ctx)
+	if !ok {
+		return
+	}
+	defer w.leader.Resign()
+
 	w.bar.SetTotal(100)
 
 	for {
@@ -112,6 +163,14 @@ func (w *Worker) Start(ctx context.Context) {
 			return
 		}
 
+		// Honour control plane commands and apply live config overrides
+		if !w.control.Checkpoint(ctx) {
+			return
+		}
+
+		cfg := w.control.Config()
+		w.applyConfig(&cfg)
+
 		w.bar.Reset()
 		w.reporter.SetHealthy(true)
 
@@ -130,21 +189,33 @@ func (w *Worker) Start(ctx context.Context) {
 		// Step 5: Process group tracking (40%)
 		w.processGroupTracking(ctx)
 
-		// Step 6: Process user thumbnails (50%)
+		// Step 6: Process game tracking (45%)
+		w.processGameTracking(ctx)
+
+		// Step 7: Process user thumbnails (50%)
 		w.processUserThumbnails(ctx)
 
-		// Step 7: Process group thumbnails (60%)
+		// Step 8: Process group thumbnails (60%)
 		w.processGroupThumbnails(ctx)
 
-		// Step 8: Process reviewer info (80%)
+		// Step 9: Process reviewer info (80%)
 		w.processReviewerInfo(ctx)
 
-		// Step 9: Completed (100%)
+		// Step 10: Process job queue (90%)
+		w.processJobQueue(ctx)
+
+		// Step 11: Queue users due for a rescan (95%)
+		w.processRescanQueue(ctx)
+
+		// Step 12: Back-test suggested detection rules (98%)
+		w.processRuleBacktests(ctx)
+
+		// Step 13: Completed (100%)
 		w.bar.SetStepMessage("Completed", 100)
 		w.reporter.UpdateStatus("Completed", 100)
 
 		// Short pause before next iteration
-		if !utils.IntervalSleep(ctx, 10*time.Second, w.logger, "maintenance worker") {
+		if !w.control.Sleep(ctx, 10*time.Second) {
 			return
 		}
 	}
@@ -541,6 +612,82 @@ func (w *Worker) processNewGroups(ctx context.Context, newGroupIDs []int64, grou
 	return newlyFlaggedIDs
 }
 
+// processGameTracking calculates reputations for tracked games.
+func (w *Worker) processGameTracking(ctx context.Context) {
+	w.bar.SetStepMessage("Processing game tracking", 45)
+	w.reporter.UpdateStatus("Processing game tracking", 45)
+
+	// Get games to check
+	gamesWithUsers, err := w.db.Model().Tracking().GetGameTrackingsToCheck(
+		ctx,
+		w.trackGamesBatchSize,
+		w.minGameFlaggedUsers,
+		w.minGameFlaggedOverride,
+	)
+	if err != nil {
+		w.logger.Error("Error checking game trackings", zap.Error(err))
+		w.reporter.SetHealthy(false)
+
+		return
+	}
+
+	// Check if there are any games to check
+	if len(gamesWithUsers) == 0 {
+		w.logger.Debug("No games to check for tracking")
+		return
+	}
+
+	// Extract game IDs for batch lookup
+	gameIDs := make([]int64, 0, len(gamesWithUsers))
+	for gameID := range gamesWithUsers {
+		gameIDs = append(gameIDs, gameID)
+	}
+
+	// Load game details from API
+	gameDetails := w.gameFetcher.FetchGameDetails(ctx, gameIDs)
+	if len(gameDetails) == 0 {
+		return
+	}
+
+	// Calculate reputations for the games
+	reputations := w.gameChecker.CheckGameReputations(ctx, gameDetails, gamesWithUsers)
+	if len(reputations) == 0 {
+		return
+	}
+
+	// Save reputations to database
+	reputationList := make([]*types.GameReputation, 0, len(reputations))
+	scoredIDs := make([]int64, 0, len(reputations))
+	suspectedIDs := make([]int64, 0)
+
+	for gameID, reputation := range reputations {
+		reputationList = append(reputationList, reputation)
+		scoredIDs = append(scoredIDs, gameID)
+
+		if reputation.IsSuspected {
+			suspectedIDs = append(suspectedIDs, gameID)
+		}
+	}
+
+	if err := w.db.Model().Tracking().SaveGameReputations(ctx, reputationList); err != nil {
+		w.logger.Error("Failed to save game reputations", zap.Error(err))
+		w.reporter.SetHealthy(false)
+
+		return
+	}
+
+	// Update tracking entries so only suspected games stay flagged
+	if err := w.db.Model().Tracking().UpdateFlaggedGames(ctx, scoredIDs, suspectedIDs); err != nil {
+		w.logger.Error("Failed to update game tracking entries", zap.Error(err))
+		return
+	}
+
+	w.logger.Info("Processed game trackings",
+		zap.Int("totalGames", len(gameIDs)),
+		zap.Int("scoredGames", len(reputations)),
+		zap.Int("suspectedGames", len(suspectedIDs)))
+}
+
 // processUserThumbnails updates user thumbnails.
 func (w *Worker) processUserThumbnails(ctx context.Context) {
 	w.bar.SetStepMessage("Processing user thumbnails", 50)
@@ -698,3 +845,95 @@ func (w *Worker) processReviewerInfo(ctx context.Context) {
 			zap.Int("count", len(updatedReviewers)))
 	}
 }
+
+// processJobQueue dead-letters abandoned jobs and removes old finished jobs.
+func (w *Worker) processJobQueue(ctx context.Context) {
+	w.bar.SetStepMessage("Processing job queue", 90)
+	w.reporter.UpdateStatus("Processing job queue", 90)
+
+	deadLettered, err := w.db.Model().Job().DeadLetterExpiredJobs(ctx)
+	if err != nil {
+		w.logger.Error("Error dead-lettering expired jobs", zap.Error(err))
+		w.reporter.SetHealthy(false)
+
+		return
+	}
+
+	if deadLettered > 0 {
+		w.logger.Warn("Dead-lettered jobs with expired leases", zap.Int("count", deadLettered))
+	}
+
+	cutOffDate := time.Now().AddDate(0, 0, -7)
+
+	purged, err := w.db.Model().Job().PurgeFinishedJobs(ctx, cutOffDate)
+	if err != nil {
+		w.logger.Error("Error purging finished jobs", zap.Error(err))
+		w.reporter.SetHealthy(false)
+
+		return
+	}
+
+	if purged > 0 {
+		w.logger.Info("Purged finished jobs",
+			zap.Int("affected", purged),
+			zap.Time("cutOffDate", cutOffDate))
+	}
+}
+
+// processRescanQueue adds users due for a rescan to the recheck lane of the queue.
+// Their next scan is pushed back so they are not added again while they wait.
+func (w *Worker) processRescanQueue(ctx context.Context) {
+	w.bar.SetStepMessage("Queueing users due for rescan", 95)
+	w.reporter.UpdateStatus("Queueing users due for rescan", 95)
+
+	userIDs, err := w.db.Service().Cache().GetDueUsers(ctx, min(w.rescanBatchSize, manager.MaxQueueBatchSize))
+	if err != nil {
+		w.logger.Error("Error getting users due for rescan", zap.Error(err))
+		w.reporter.SetHealthy(false)
+
+		return
+	}
+
+	if len(userIDs) == 0 {
+		w.logger.Debug("No users due for rescan")
+		return
+	}
+
+	skipped, err := w.cfClient.Queue.AddUsers(ctx, userIDs, manager.QueuePriorityRecheck)
+	if err != nil {
+		w.logger.Error("Error adding users to the recheck lane", zap.Error(err))
+		w.reporter.SetHealthy(false)
+
+		return
+	}
+
+	if err := w.db.Service().Cache().DeferUsers(ctx, userIDs, rescanRequeueDelay); err != nil {
+		w.logger.Error("Error deferring queued rescans", zap.Error(err))
+		w.reporter.SetHealthy(false)
+
+		return
+	}
+
+	w.logger.Info("Queued users due for rescan",
+		zap.Int("dueUsers", len(userIDs)),
+		zap.Int("alreadyQueued", len(skipped)))
+}
+
+// processRuleBacktests back-tests detection rules suggested by reviewers, which are
+// kept out of the bot so suggesting a rule never waits on the table scans.
+func (w *Worker) processRuleBacktests(ctx context.Context) {
+	w.bar.SetStepMessage("Back-testing suggested rules", 98)
+	w.reporter.UpdateStatus("Back-testing suggested rules", 98)
+
+	tested, err := w.db.Service().Rule().BacktestPending(ctx, ruleBacktestBatchSize)
+	if err != nil {
+		w.logger.Error("Error back-testing suggested rules", zap.Error(err))
+		w.reporter.SetHealthy(false)
+
+		return
+	}
+
+	if tested > 0 {
+		w.logger.Info("Back-tested suggested rules", zap.Int("count", tested))
+	}
+}
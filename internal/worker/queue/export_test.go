@@ -0,0 +1,43 @@
+package queue
+
+import (
+	"maps"
+	"time"
+
+	"github.com/robalyx/rotector/internal/cloudflare/manager"
+)
+
+// LaneLimiter exposes laneLimiter for tests.
+type LaneLimiter = laneLimiter
+
+// NewLaneLimiter exposes newLaneLimiter for tests.
+var NewLaneLimiter = newLaneLimiter
+
+// Allocate exposes allocate for tests.
+func (l *laneLimiter) Allocate(
+	pending map[manager.QueuePriority]manager.LanePending, batchSize int, now time.Time,
+) map[manager.QueuePriority]int {
+	return l.allocate(pending, batchSize, now)
+}
+
+// Record exposes record for tests.
+func (l *laneLimiter) Record(counts map[manager.QueuePriority]int) {
+	l.record(counts)
+}
+
+// Order exposes order for tests.
+func (l *laneLimiter) Order(
+	pending map[manager.QueuePriority]manager.LanePending, now time.Time,
+) []manager.QueuePriority {
+	return l.order(pending, now)
+}
+
+// Quotas returns a copy of the lane quotas for tests.
+func (l *laneLimiter) Quotas() map[manager.QueuePriority]int {
+	return maps.Clone(l.quotas)
+}
+
+// Used returns a copy of the lane usage for tests.
+func (l *laneLimiter) Used() map[manager.QueuePriority]int {
+	return maps.Clone(l.used)
+}
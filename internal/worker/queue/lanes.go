@@ -0,0 +1,204 @@
+package queue
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/robalyx/rotector/internal/cloudflare/manager"
+	"github.com/robalyx/rotector/internal/setup/config"
+)
+
+// laneLimiter splits the queue rate limit window between priority lanes.
+// Each lane owns a share of the window, and lanes with users waiting may
+// borrow the share of lanes that have nothing waiting.
+type laneLimiter struct {
+	mu          sync.Mutex
+	window      time.Duration
+	aging       time.Duration
+	quotas      map[manager.QueuePriority]int
+	used        map[manager.QueuePriority]int
+	windowStart time.Time
+}
+
+// newLaneLimiter creates a lane limiter from the rate limiting configuration.
+func newLaneLimiter(cfg *config.QueueRateLimitingConfig) *laneLimiter {
+	shares := map[manager.QueuePriority]int{
+		manager.QueuePriorityReviewer:  max(cfg.LaneShares.Reviewer, 0),
+		manager.QueuePriorityExtension: max(cfg.LaneShares.Extension, 0),
+		manager.QueuePriorityRecheck:   max(cfg.LaneShares.Recheck, 0),
+		manager.QueuePriorityBulk:      max(cfg.LaneShares.Bulk, 0),
+	}
+
+	totalShares := 0
+	for _, share := range shares {
+		totalShares += share
+	}
+
+	// Split the window evenly if no shares are configured
+	if totalShares == 0 {
+		for priority := range shares {
+			shares[priority] = 1
+		}
+
+		totalShares = len(shares)
+	}
+
+	// Hand out the rounding remainder to the highest lanes first
+	quotas := make(map[manager.QueuePriority]int, len(shares))
+	remainder := cfg.MaxUsersPerWindow
+
+	for _, priority := range manager.QueuePriorities {
+		quotas[priority] = cfg.MaxUsersPerWindow * shares[priority] / totalShares
+		remainder -= quotas[priority]
+	}
+
+	for _, priority := range manager.QueuePriorities {
+		if remainder <= 0 {
+			break
+		}
+
+		if shares[priority] > 0 {
+			quotas[priority]++
+			remainder--
+		}
+	}
+
+	return &laneLimiter{
+		window:      cfg.WindowDuration,
+		aging:       cfg.AgingInterval,
+		quotas:      quotas,
+		used:        make(map[manager.QueuePriority]int),
+		windowStart: time.Now(),
+	}
+}
+
+// allocate decides how many users to take from each lane for the next batch.
+func (l *laneLimiter) allocate(
+	pending map[manager.QueuePriority]manager.LanePending, batchSize int, now time.Time,
+) map[manager.QueuePriority]int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.resetIfExpired(now)
+
+	order := l.order(pending, now)
+	allocation := make(map[manager.QueuePriority]int, len(order))
+	left := batchSize
+
+	// First pass: each lane uses its own share
+	for _, priority := range order {
+		take := min(pending[priority].Count, l.remaining(priority), left)
+		allocation[priority] = take
+		left -= take
+	}
+
+	// Second pass: lanes still waiting borrow the share of idle lanes
+	spare := 0
+
+	for _, priority := range manager.QueuePriorities {
+		if pending[priority].Count == 0 {
+			spare += l.remaining(priority)
+		}
+	}
+
+	for _, priority := range order {
+		take := min(pending[priority].Count-allocation[priority], spare, left)
+		allocation[priority] += take
+		spare -= take
+		left -= take
+	}
+
+	return allocation
+}
+
+// record charges processed users against their lanes. Users beyond a lane's
+// own share are charged to the lowest lanes with share left.
+func (l *laneLimiter) record(counts map[manager.QueuePriority]int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	overflow := 0
+
+	for _, priority := range manager.QueuePriorities {
+		own := min(counts[priority], l.remaining(priority))
+		l.used[priority] += own
+		overflow += counts[priority] - own
+	}
+
+	for _, priority := range slices.Backward(manager.QueuePriorities) {
+		if overflow <= 0 {
+			break
+		}
+
+		charge := min(overflow, l.remaining(priority))
+		l.used[priority] += charge
+		overflow -= charge
+	}
+}
+
+// waitDuration returns how long until the current window resets.
+func (l *laneLimiter) waitDuration(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.windowStart.Add(l.window).Sub(now)
+}
+
+// details returns the window usage of each lane for status reporting.
+func (l *laneLimiter) details() map[string]string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	details := make(map[string]string, len(manager.QueuePriorities))
+	for _, priority := range manager.QueuePriorities {
+		details["lane_"+string(priority)] = fmt.Sprintf("%d/%d", l.used[priority], l.quotas[priority])
+	}
+
+	return details
+}
+
+// order returns the lanes with users waiting, highest effective priority first.
+// A lane moves up one step for every aging interval its oldest user has waited.
+func (l *laneLimiter) order(pending map[manager.QueuePriority]manager.LanePending, now time.Time) []manager.QueuePriority {
+	effective := make(map[manager.QueuePriority]int, len(pending))
+	order := make([]manager.QueuePriority, 0, len(pending))
+
+	for _, priority := range manager.QueuePriorities {
+		lane, ok := pending[priority]
+		if !ok || lane.Count == 0 {
+			continue
+		}
+
+		rank := priority.Rank()
+		if l.aging > 0 && !lane.OldestAt.IsZero() {
+			rank -= int(now.Sub(lane.OldestAt) / l.aging)
+		}
+
+		effective[priority] = rank
+		order = append(order, priority)
+	}
+
+	slices.SortStableFunc(order, func(a, b manager.QueuePriority) int {
+		return effective[a] - effective[b]
+	})
+
+	return order
+}
+
+// remaining returns the unused share of a lane. Must be called with the lock held.
+func (l *laneLimiter) remaining(priority manager.QueuePriority) int {
+	return max(l.quotas[priority]-l.used[priority], 0)
+}
+
+// resetIfExpired starts a new window once the current one has passed.
+// Must be called with the lock held.
+func (l *laneLimiter) resetIfExpired(now time.Time) {
+	if now.Sub(l.windowStart) < l.window {
+		return
+	}
+
+	clear(l.used)
+	l.windowStart = now
+}
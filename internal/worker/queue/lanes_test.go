@@ -0,0 +1,294 @@
+package queue_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/internal/cloudflare/manager"
+	"github.com/robalyx/rotector/internal/setup/config"
+	"github.com/robalyx/rotector/internal/worker/queue"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	reviewer  = manager.QueuePriorityReviewer
+	extension = manager.QueuePriorityExtension
+	recheck   = manager.QueuePriorityRecheck
+	bulk      = manager.QueuePriorityBulk
+)
+
+// newLimiter creates a lane limiter with shares of 4, 3, 2 and 1 over the given window size.
+func newLimiter(maxUsers int, aging time.Duration) *queue.LaneLimiter {
+	return queue.NewLaneLimiter(&config.QueueRateLimitingConfig{
+		MaxUsersPerWindow: maxUsers,
+		WindowDuration:    time.Hour,
+		AgingInterval:     aging,
+		LaneShares: config.QueueLaneShares{
+			Reviewer:  4,
+			Extension: 3,
+			Recheck:   2,
+			Bulk:      1,
+		},
+	})
+}
+
+func TestNewLaneLimiterQuotas(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		maxUsers int
+		shares   config.QueueLaneShares
+		expected map[manager.QueuePriority]int
+	}{
+		{
+			name:     "even split",
+			maxUsers: 10,
+			shares:   config.QueueLaneShares{Reviewer: 4, Extension: 3, Recheck: 2, Bulk: 1},
+			expected: map[manager.QueuePriority]int{reviewer: 4, extension: 3, recheck: 2, bulk: 1},
+		},
+		{
+			name:     "remainder goes to the highest lane",
+			maxUsers: 11,
+			shares:   config.QueueLaneShares{Reviewer: 4, Extension: 3, Recheck: 2, Bulk: 1},
+			expected: map[manager.QueuePriority]int{reviewer: 5, extension: 3, recheck: 2, bulk: 1},
+		},
+		{
+			name:     "remainder skips lanes without a share",
+			maxUsers: 3,
+			shares:   config.QueueLaneShares{Extension: 1, Bulk: 1},
+			expected: map[manager.QueuePriority]int{reviewer: 0, extension: 2, recheck: 0, bulk: 1},
+		},
+		{
+			name:     "no shares splits evenly",
+			maxUsers: 10,
+			shares:   config.QueueLaneShares{},
+			expected: map[manager.QueuePriority]int{reviewer: 3, extension: 3, recheck: 2, bulk: 2},
+		},
+		{
+			name:     "negative shares are ignored",
+			maxUsers: 4,
+			shares:   config.QueueLaneShares{Reviewer: -5, Extension: 1, Recheck: 1, Bulk: 2},
+			expected: map[manager.QueuePriority]int{reviewer: 0, extension: 1, recheck: 1, bulk: 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := queue.NewLaneLimiter(&config.QueueRateLimitingConfig{
+				MaxUsersPerWindow: tt.maxUsers,
+				WindowDuration:    time.Hour,
+				LaneShares:        tt.shares,
+			})
+
+			assert.Equal(t, tt.expected, limiter.Quotas())
+		})
+	}
+}
+
+func TestLaneLimiterAllocate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		pending   map[manager.QueuePriority]manager.LanePending
+		batchSize int
+		expected  map[manager.QueuePriority]int
+	}{
+		{
+			name: "each lane takes its own share",
+			pending: map[manager.QueuePriority]manager.LanePending{
+				reviewer: {Count: 20}, extension: {Count: 20}, recheck: {Count: 20}, bulk: {Count: 20},
+			},
+			batchSize: 10,
+			expected:  map[manager.QueuePriority]int{reviewer: 4, extension: 3, recheck: 2, bulk: 1},
+		},
+		{
+			name: "batch size caps the lower lanes",
+			pending: map[manager.QueuePriority]manager.LanePending{
+				reviewer: {Count: 20}, extension: {Count: 20}, recheck: {Count: 20}, bulk: {Count: 20},
+			},
+			batchSize: 5,
+			expected:  map[manager.QueuePriority]int{reviewer: 4, extension: 1, recheck: 0, bulk: 0},
+		},
+		{
+			name: "lone lane borrows every idle share",
+			pending: map[manager.QueuePriority]manager.LanePending{
+				bulk: {Count: 20},
+			},
+			batchSize: 20,
+			expected:  map[manager.QueuePriority]int{bulk: 10},
+		},
+		{
+			name: "waiting lanes keep their unused share",
+			pending: map[manager.QueuePriority]manager.LanePending{
+				reviewer: {Count: 1}, bulk: {Count: 20},
+			},
+			batchSize: 20,
+			expected:  map[manager.QueuePriority]int{reviewer: 1, bulk: 6},
+		},
+		{
+			name: "borrowing favours the higher lane",
+			pending: map[manager.QueuePriority]manager.LanePending{
+				reviewer: {Count: 20}, recheck: {Count: 20},
+			},
+			batchSize: 20,
+			expected:  map[manager.QueuePriority]int{reviewer: 8, recheck: 2},
+		},
+		{
+			name:      "nothing waiting",
+			pending:   map[manager.QueuePriority]manager.LanePending{},
+			batchSize: 10,
+			expected:  map[manager.QueuePriority]int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := newLimiter(10, 0)
+			assert.Equal(t, tt.expected, limiter.Allocate(tt.pending, tt.batchSize, now))
+		})
+	}
+}
+
+func TestLaneLimiterAllocateAfterRecord(t *testing.T) {
+	t.Parallel()
+
+	limiter := newLimiter(10, 0)
+	now := time.Now()
+	pending := map[manager.QueuePriority]manager.LanePending{
+		reviewer: {Count: 20}, extension: {Count: 20}, recheck: {Count: 20}, bulk: {Count: 20},
+	}
+
+	limiter.Record(map[manager.QueuePriority]int{reviewer: 4, extension: 3})
+
+	// Only the unused shares are left in the current window
+	assert.Equal(t,
+		map[manager.QueuePriority]int{reviewer: 0, extension: 0, recheck: 2, bulk: 1},
+		limiter.Allocate(pending, 10, now))
+
+	// A new window restores every share
+	assert.Equal(t,
+		map[manager.QueuePriority]int{reviewer: 4, extension: 3, recheck: 2, bulk: 1},
+		limiter.Allocate(pending, 10, now.Add(time.Hour)))
+}
+
+func TestLaneLimiterRecord(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		counts   []map[manager.QueuePriority]int
+		expected map[manager.QueuePriority]int
+	}{
+		{
+			name:     "within own shares",
+			counts:   []map[manager.QueuePriority]int{{reviewer: 2, bulk: 1}},
+			expected: map[manager.QueuePriority]int{reviewer: 2, extension: 0, recheck: 0, bulk: 1},
+		},
+		{
+			name:     "overflow charges the lowest lanes first",
+			counts:   []map[manager.QueuePriority]int{{reviewer: 7}},
+			expected: map[manager.QueuePriority]int{reviewer: 4, extension: 0, recheck: 2, bulk: 1},
+		},
+		{
+			name:     "overflow moves up once the lowest lanes are spent",
+			counts:   []map[manager.QueuePriority]int{{bulk: 1}, {bulk: 4}},
+			expected: map[manager.QueuePriority]int{reviewer: 0, extension: 2, recheck: 2, bulk: 1},
+		},
+		{
+			name:     "overflow beyond the window is dropped",
+			counts:   []map[manager.QueuePriority]int{{reviewer: 30}},
+			expected: map[manager.QueuePriority]int{reviewer: 4, extension: 3, recheck: 2, bulk: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := newLimiter(10, 0)
+			for _, counts := range tt.counts {
+				limiter.Record(counts)
+			}
+
+			assert.Equal(t, tt.expected, limiter.Used())
+		})
+	}
+}
+
+func TestLaneLimiterOrder(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name     string
+		aging    time.Duration
+		pending  map[manager.QueuePriority]manager.LanePending
+		expected []manager.QueuePriority
+	}{
+		{
+			name:  "lane rank without aging",
+			aging: 0,
+			pending: map[manager.QueuePriority]manager.LanePending{
+				bulk:     {Count: 1, OldestAt: now.Add(-48 * time.Hour)},
+				recheck:  {Count: 1, OldestAt: now},
+				reviewer: {Count: 1, OldestAt: now},
+			},
+			expected: []manager.QueuePriority{reviewer, recheck, bulk},
+		},
+		{
+			name:  "empty lanes are left out",
+			aging: time.Hour,
+			pending: map[manager.QueuePriority]manager.LanePending{
+				reviewer:  {Count: 0, OldestAt: now.Add(-48 * time.Hour)},
+				extension: {Count: 1, OldestAt: now},
+			},
+			expected: []manager.QueuePriority{extension},
+		},
+		{
+			name:  "aged lane ties keep lane rank",
+			aging: time.Hour,
+			pending: map[manager.QueuePriority]manager.LanePending{
+				reviewer: {Count: 1, OldestAt: now},
+				bulk:     {Count: 1, OldestAt: now.Add(-3 * time.Hour)},
+			},
+			expected: []manager.QueuePriority{reviewer, bulk},
+		},
+		{
+			name:  "aged lane moves ahead",
+			aging: time.Hour,
+			pending: map[manager.QueuePriority]manager.LanePending{
+				reviewer:  {Count: 1, OldestAt: now},
+				extension: {Count: 1, OldestAt: now},
+				bulk:      {Count: 1, OldestAt: now.Add(-4 * time.Hour)},
+			},
+			expected: []manager.QueuePriority{bulk, reviewer, extension},
+		},
+		{
+			name:  "unknown wait time does not age",
+			aging: time.Hour,
+			pending: map[manager.QueuePriority]manager.LanePending{
+				extension: {Count: 1, OldestAt: now},
+				recheck:   {Count: 1},
+			},
+			expected: []manager.QueuePriority{extension, recheck},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limiter := newLimiter(10, tt.aging)
+			assert.Equal(t, tt.expected, limiter.Order(tt.pending, now))
+		})
+	}
+}
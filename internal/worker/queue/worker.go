@@ -4,20 +4,29 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"time"
 
+	"github.com/robalyx/rotector/internal/cloudflare/manager"
 	"github.com/robalyx/rotector/internal/database/types"
+	"github.com/robalyx/rotector/internal/metrics"
 	"github.com/robalyx/rotector/internal/roblox/checker"
 	"github.com/robalyx/rotector/internal/roblox/fetcher"
 	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/setup/telemetry/tracing"
 	"github.com/robalyx/rotector/internal/tui/components"
 	"github.com/robalyx/rotector/internal/worker/core"
 	"github.com/robalyx/rotector/pkg/utils"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
-// ErrNoUsersToProcess indicates that no users are available for processing.
-var ErrNoUsersToProcess = errors.New("no users available for processing")
+var (
+	// ErrNoUsersToProcess indicates that no users are available for processing.
+	ErrNoUsersToProcess = errors.New("no users available for processing")
+	// ErrRateLimited indicates that users are waiting but every lane has used its share of the window.
+	ErrRateLimited = errors.New("rate limit window exhausted")
+)
 
 // BatchData represents the data needed for processing a batch of users.
 type BatchData struct {
@@ -27,42 +36,44 @@ type BatchData struct {
 	ProfileFlags  map[int64]struct{}
 	FriendsFlags  map[int64]struct{}
 	GroupsFlags   map[int64]struct{}
+	SourceGroups  map[int64]int64
+	Priorities    map[int64]manager.QueuePriority
 }
 
 // Worker processes queued users from Cloudflare D1.
 type Worker struct {
-	app               *setup.App
-	bar               *components.ProgressBar
-	userFetcher       *fetcher.UserFetcher
-	userChecker       *checker.UserChecker
-	reporter          *core.StatusReporter
-	logger            *zap.Logger
-	batchSize         int
-	processedCount    int
-	windowStartTime   time.Time
-	maxUsersPerWindow int
-	windowDuration    time.Duration
+	app         *setup.App
+	bar         *components.ProgressBar
+	userFetcher *fetcher.UserFetcher
+	userChecker *checker.UserChecker
+	reporter    *core.StatusReporter
+	control     *core.Controller
+	lanes       *laneLimiter
+	logger      *zap.Logger
+	batchSize   int
 }
 
 // New creates a new queue worker.
 func New(app *setup.App, bar *components.ProgressBar, logger *zap.Logger, instanceID string) *Worker {
 	userFetcher := fetcher.NewUserFetcher(app, logger)
 	userChecker := checker.NewUserChecker(app, userFetcher, logger)
-	reporter := core.NewStatusReporter(app.StatusClient, "queue", instanceID, logger)
-
-	return &Worker{
-		app:               app,
-		bar:               bar,
-		userFetcher:       userFetcher,
-		userChecker:       userChecker,
-		reporter:          reporter,
-		logger:            logger.Named("queue_worker"),
-		batchSize:         app.Config.Worker.BatchSizes.QueueItems,
-		processedCount:    0,
-		windowStartTime:   time.Now(),
-		maxUsersPerWindow: app.Config.Worker.QueueRateLimiting.MaxUsersPerWindow,
-		windowDuration:    app.Config.Worker.QueueRateLimiting.WindowDuration,
+	reporter := core.NewStatusReporter(app.StatusClient, app.DB, bar, "queue", instanceID, logger)
+
+	w := &Worker{
+		app:         app,
+		bar:         bar,
+		userFetcher: userFetcher,
+		userChecker: userChecker,
+		reporter:    reporter,
+		control:     core.NewController(app.StatusClient, "queue", &app.Config.Worker, bar, reporter, logger),
+		lanes:       newLaneLimiter(&app.Config.Worker.QueueRateLimiting),
+		logger:      logger.Named("queue_worker"),
+		batchSize:   app.Config.Worker.BatchSizes.QueueItems,
 	}
+
+	reporter.SetDetailsProvider(w.statusDetails)
+
+	return w
 }
 
 // Start begins the queue worker's main processing loop.
@@ -74,6 +85,11 @@ func (w *Worker) Start(ctx context.Context) {
 	w.reporter.Start(ctx)
 	defer w.reporter.Stop()
 
+	// Make sure the queue table has priority lanes
+	if err := w.app.CFClient.Queue.EnsureSchema(ctx); err != nil {
+		w.logger.Error("Failed to ensure queue schema", zap.Error(err))
+	}
+
 	// Cleanup IP tracking records on startup
 	if err := w.app.CFClient.IPTracking.Cleanup(
 		ctx,
@@ -89,28 +105,35 @@ func (w *Worker) Start(ctx context.Context) {
 			return
 		}
 
-		w.bar.Reset()
-
-		// Check if we can process based on rate limiting
-		canProcess, sleepDuration := w.canProcessBatch(w.batchSize)
-		if !canProcess {
-			w.bar.SetStepMessage("Waiting for rate limit", 0)
-			w.logger.Debug("Waiting for rate limit window",
-				zap.Duration("sleep_duration", sleepDuration))
+		// Honour control plane commands and apply live config overrides
+		if !w.control.Checkpoint(ctx) {
+			return
+		}
 
-			if utils.ContextSleep(ctx, sleepDuration) == utils.SleepCancelled {
-				w.logger.Info("Context cancelled during rate limit wait, stopping queue worker")
-				return
-			}
+		w.batchSize = w.control.Config().BatchSizes.QueueItems
 
-			continue
-		}
+		w.bar.Reset()
 
 		// Step 1: Get next batch of unprocessed users (25%)
 		w.bar.SetStepMessage("Getting next batch", 25)
 
 		batchData, err := w.getBatchForProcessing(ctx)
 		if err != nil {
+			if errors.Is(err, ErrRateLimited) {
+				sleepDuration := w.lanes.waitDuration(time.Now())
+
+				w.bar.SetStepMessage("Waiting for rate limit", 0)
+				w.logger.Debug("Waiting for rate limit window",
+					zap.Duration("sleep_duration", sleepDuration))
+
+				if utils.ContextSleep(ctx, sleepDuration) == utils.SleepCancelled {
+					w.logger.Info("Context cancelled during rate limit wait, stopping queue worker")
+					return
+				}
+
+				continue
+			}
+
 			if errors.Is(err, ErrNoUsersToProcess) {
 				w.bar.SetStepMessage("No items to process", 0)
 
@@ -137,13 +160,19 @@ func (w *Worker) Start(ctx context.Context) {
 			continue
 		}
 
+		// Trace the batch through fetching, checking and marking
+		batchCtx, span := tracing.Start(ctx, "QueueWorker.processBatch",
+			tracing.BatchSize(len(batchData.ProcessIDs)),
+			attribute.Int("rotector.existing_count", len(batchData.ExistingUsers)))
+
 		// Step 2: Fetch user info (40%)
 		w.bar.SetStepMessage("Fetching user info", 40)
-		userInfos := w.userFetcher.FetchInfos(ctx, batchData.ProcessIDs)
+		userInfos := w.userFetcher.FetchInfos(batchCtx, batchData.ProcessIDs)
+		w.removeMissingRechecks(batchCtx, batchData, userInfos)
 
 		// Step 3: Process users with checker (60%)
 		w.bar.SetStepMessage("Processing users", 60)
-		processResult := w.userChecker.ProcessUsers(ctx, &checker.UserCheckerParams{
+		processResult := w.userChecker.ProcessUsers(batchCtx, &checker.UserCheckerParams{
 			Users:                     userInfos,
 			ExistingUsers:             batchData.ExistingUsers,
 			InappropriateOutfitFlags:  batchData.OutfitFlags,
@@ -153,24 +182,38 @@ func (w *Worker) Start(ctx context.Context) {
 			FromQueueWorker:           true,
 		})
 
+		metrics.AddUsersProcessed("queue", len(userInfos), len(processResult.FlaggedStatus))
+		w.reporter.AddProcessed(len(userInfos))
+
+		// Schedule rescans and record the group users were queued from
+		if err := w.app.DB.Service().Cache().MarkUsersProcessedFromGroups(
+			batchCtx, userInfos, batchData.SourceGroups,
+		); err != nil {
+			w.logger.Error("Failed to mark users as processed in cache", zap.Error(err))
+		}
+
 		// Step 4: Mark users as processed (75%)
 		w.bar.SetStepMessage("Marking as processed", 75)
 
-		if err := w.app.CFClient.Queue.MarkAsProcessed(ctx, batchData.ProcessIDs, processResult.FlaggedStatus); err != nil {
+		if err := w.app.CFClient.Queue.MarkAsProcessed(
+			batchCtx, batchData.ProcessIDs, processResult.FlaggedStatus,
+		); err != nil {
 			w.logger.Error("Failed to mark users as processed", zap.Error(err))
+			tracing.RecordError(span, err)
 		}
 
 		// Step 5: Update IP tracking (100%)
 		w.bar.SetStepMessage("Updating IP tracking", 100)
 
 		if err := w.updateIPTrackingFlaggedStatus(
-			ctx, batchData.ProcessIDs, processResult.FlaggedStatus,
+			batchCtx, batchData.ProcessIDs, processResult.FlaggedStatus,
 		); err != nil {
 			w.logger.Error("Failed to update IP tracking flagged status", zap.Error(err))
+			tracing.RecordError(span, err)
 		}
 
-		// Update processed count for rate limiting
-		w.processedCount += len(batchData.ProcessIDs)
+		span.SetAttributes(tracing.FlaggedCount(len(processResult.FlaggedStatus)))
+		span.End()
 
 		w.logger.Info("Processed batch",
 			zap.Int("total", len(batchData.ProcessIDs)),
@@ -179,37 +222,44 @@ func (w *Worker) Start(ctx context.Context) {
 	}
 }
 
-// canProcessBatch checks if processing a batch would exceed rate limits.
-// Returns true if processing is allowed, or false with the required wait duration.
-func (w *Worker) canProcessBatch(batchSize int) (bool, time.Duration) {
-	now := time.Now()
-
-	// Reset window if expired
-	if now.Sub(w.windowStartTime) >= w.windowDuration {
-		w.processedCount = 0
-		w.windowStartTime = now
+// removeMissingRechecks takes re-checked users that could no longer be fetched,
+// such as banned or deleted users, out of the rescan schedule.
+func (w *Worker) removeMissingRechecks(ctx context.Context, batchData *BatchData, userInfos []*types.ReviewUser) {
+	fetchedIDs := make(map[int64]struct{}, len(userInfos))
+	for _, user := range userInfos {
+		fetchedIDs[user.ID] = struct{}{}
 	}
 
-	// Check if processing this batch would exceed the limit
-	if w.processedCount+batchSize > w.maxUsersPerWindow {
-		waitUntil := w.windowStartTime.Add(w.windowDuration)
-		waitDuration := time.Until(waitUntil)
+	var missingIDs []int64
 
-		w.logger.Debug("Rate limit would be exceeded, waiting",
-			zap.Int("processed_count", w.processedCount),
-			zap.Int("batch_size", batchSize),
-			zap.Int("max_users_per_window", w.maxUsersPerWindow),
-			zap.Duration("wait_duration", waitDuration))
+	for _, userID := range batchData.ProcessIDs {
+		if batchData.Priorities[userID] != manager.QueuePriorityRecheck {
+			continue
+		}
+
+		if _, ok := fetchedIDs[userID]; !ok {
+			missingIDs = append(missingIDs, userID)
+		}
+	}
+
+	if len(missingIDs) == 0 {
+		return
+	}
 
-		return false, waitDuration
+	if err := w.app.DB.Service().Cache().RemoveUsers(ctx, missingIDs); err != nil {
+		w.logger.Error("Failed to remove missing users from rescan schedule", zap.Error(err))
+		return
 	}
 
-	w.logger.Debug("Rate limit check passed",
-		zap.Int("processed_count", w.processedCount),
-		zap.Int("batch_size", batchSize),
-		zap.Int("max_users_per_window", w.maxUsersPerWindow))
+	w.logger.Info("Removed missing users from rescan schedule", zap.Int("count", len(missingIDs)))
+}
+
+// statusDetails returns adaptive analyzer settings and lane usage for status reporting.
+func (w *Worker) statusDetails() map[string]string {
+	details := w.userChecker.AdaptiveSettings()
+	maps.Copy(details, w.lanes.details())
 
-	return true, 0
+	return details
 }
 
 // updateIPTrackingFlaggedStatus updates the queue_ip_tracking table for processed users.
@@ -234,9 +284,36 @@ func (w *Worker) updateIPTrackingFlaggedStatus(
 }
 
 // getBatchForProcessing handles getting and preparing a batch of users for processing.
+// The batch is split between priority lanes according to their share of the rate window.
 func (w *Worker) getBatchForProcessing(ctx context.Context) (*BatchData, error) {
+	pending, err := w.app.CFClient.Queue.GetPendingByPriority(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending lanes: %w", err)
+	}
+
+	waiting := 0
+	for _, priority := range manager.QueuePriorities {
+		waiting += pending[priority].Count
+		metrics.SetQueueDepth(string(priority), pending[priority].Count)
+	}
+
+	if waiting == 0 {
+		return nil, ErrNoUsersToProcess
+	}
+
+	allocation := w.lanes.allocate(pending, w.batchSize, time.Now())
+
+	allocated := 0
+	for _, count := range allocation {
+		allocated += count
+	}
+
+	if allocated == 0 {
+		return nil, ErrRateLimited
+	}
+
 	// Get next batch of unprocessed users
-	userBatch, err := w.app.CFClient.Queue.GetNextBatch(ctx, w.batchSize)
+	userBatch, err := w.app.CFClient.Queue.GetNextBatch(ctx, allocation)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get next batch: %w", err)
 	}
@@ -245,6 +322,14 @@ func (w *Worker) getBatchForProcessing(ctx context.Context) (*BatchData, error)
 		return nil, ErrNoUsersToProcess
 	}
 
+	// Charge the picked users against their lanes
+	laneCounts := make(map[manager.QueuePriority]int, len(allocation))
+	for _, priority := range userBatch.Priorities {
+		laneCounts[priority]++
+	}
+
+	w.lanes.record(laneCounts)
+
 	// Get existing users from database
 	existingUsers, err := w.app.DB.Model().User().GetUsersByIDs(
 		ctx, userBatch.UserIDs, types.UserFieldAll,
@@ -261,6 +346,8 @@ func (w *Worker) getBatchForProcessing(ctx context.Context) (*BatchData, error)
 		ProfileFlags:  make(map[int64]struct{}),
 		FriendsFlags:  make(map[int64]struct{}),
 		GroupsFlags:   make(map[int64]struct{}),
+		SourceGroups:  userBatch.SourceGroups,
+		Priorities:    userBatch.Priorities,
 	}
 
 	for _, id := range userBatch.UserIDs {
@@ -275,6 +362,12 @@ func (w *Worker) getBatchForProcessing(ctx context.Context) (*BatchData, error)
 				zap.String("currentStatus", existingUser.Status.String()))
 		}
 
+		if groupID, exists := userBatch.SourceGroups[id]; exists {
+			w.logger.Debug("Processing user queued from group",
+				zap.Int64("userID", id),
+				zap.Int64("groupID", groupID))
+		}
+
 		// Set flag types based on queue flags
 		if _, exists := userBatch.InappropriateOutfitFlags[id]; exists {
 			batchData.OutfitFlags[id] = struct{}{}
@@ -11,6 +11,7 @@ import (
 	"github.com/robalyx/rotector/internal/database/types/enum"
 	"github.com/robalyx/rotector/internal/roblox/checker"
 	"github.com/robalyx/rotector/internal/setup"
+	"github.com/robalyx/rotector/internal/translator"
 	"github.com/robalyx/rotector/internal/tui/components"
 	"github.com/robalyx/rotector/internal/worker/core"
 	"github.com/robalyx/rotector/pkg/utils"
@@ -28,6 +29,7 @@ type Worker struct {
 	groupChecker  *checker.GroupChecker
 	condoChecker  *checker.CondoChecker
 	reporter      *core.StatusReporter
+	control       *core.Controller
 	logger        *zap.Logger
 	batchSize     int
 	batchDelay    time.Duration
@@ -35,15 +37,16 @@ type Worker struct {
 
 // New creates a new reason worker.
 func New(app *setup.App, bar *components.ProgressBar, logger *zap.Logger, instanceID string) *Worker {
-	reporter := core.NewStatusReporter(app.StatusClient, "reason", instanceID, logger)
+	reporter := core.NewStatusReporter(app.StatusClient, app.DB, bar, "reason", instanceID, logger)
 
 	return &Worker{
 		db:            app.DB,
 		bar:           bar,
 		friendChecker: checker.NewFriendChecker(app, logger),
-		groupChecker:  checker.NewGroupChecker(app, logger),
+		groupChecker:  checker.NewGroupChecker(app, translator.New(app.RoAPI.GetClient()), logger),
 		condoChecker:  checker.NewCondoChecker(app, logger),
 		reporter:      reporter,
+		control:       core.NewController(app.StatusClient, "reason", &app.Config.Worker, bar, reporter, logger),
 		logger:        logger.Named("reason_worker"),
 		batchSize:     200,
 		batchDelay:    1 * time.Second,
@@ -58,6 +61,11 @@ func (w *Worker) Start(ctx context.Context) {
 	w.reporter.Start(ctx)
 	defer w.reporter.Stop()
 
+	// Honour control plane commands
+	if !w.control.Checkpoint(ctx) {
+		return
+	}
+
 	w.bar.SetTotal(100)
 	w.bar.SetStepMessage("Starting reason check process", 0)
 
@@ -65,6 +73,10 @@ func (w *Worker) Start(ctx context.Context) {
 	w.bar.SetStepMessage("Processing users missing condo reasons", 15)
 
 	condoCount, err := w.processUsersWithoutReason(ctx, enum.UserReasonTypeCondo)
+	if errors.Is(err, core.ErrWorkerStopped) {
+		return
+	}
+
 	if err != nil {
 		w.logger.Error("Failed to process users without condo reasons", zap.Error(err))
 	} else {
@@ -75,6 +87,10 @@ func (w *Worker) Start(ctx context.Context) {
 	w.bar.SetStepMessage("Processing users missing friend reasons", 30)
 
 	friendCount, err := w.processUsersWithoutReason(ctx, enum.UserReasonTypeFriend)
+	if errors.Is(err, core.ErrWorkerStopped) {
+		return
+	}
+
 	if err != nil {
 		w.logger.Error("Failed to process users without friend reasons", zap.Error(err))
 	} else {
@@ -85,6 +101,10 @@ func (w *Worker) Start(ctx context.Context) {
 	w.bar.SetStepMessage("Processing users missing group reasons", 45)
 
 	groupCount, err := w.processUsersWithoutReason(ctx, enum.UserReasonTypeGroup)
+	if errors.Is(err, core.ErrWorkerStopped) {
+		return
+	}
+
 	if err != nil {
 		w.logger.Error("Failed to process users without group reasons", zap.Error(err))
 	} else {
@@ -95,6 +115,10 @@ func (w *Worker) Start(ctx context.Context) {
 	w.bar.SetStepMessage("Recalculating condo reason confidences", 60)
 
 	condoRecalcCount, err := w.processUsersWithReason(ctx, enum.UserReasonTypeCondo)
+	if errors.Is(err, core.ErrWorkerStopped) {
+		return
+	}
+
 	if err != nil {
 		w.logger.Error("Failed to recalculate condo reason confidences", zap.Error(err))
 	} else {
@@ -105,6 +129,10 @@ func (w *Worker) Start(ctx context.Context) {
 	w.bar.SetStepMessage("Recalculating friend reason confidences", 75)
 
 	friendRecalcCount, err := w.processUsersWithReason(ctx, enum.UserReasonTypeFriend)
+	if errors.Is(err, core.ErrWorkerStopped) {
+		return
+	}
+
 	if err != nil {
 		w.logger.Error("Failed to recalculate friend reason confidences", zap.Error(err))
 	} else {
@@ -115,6 +143,10 @@ func (w *Worker) Start(ctx context.Context) {
 	w.bar.SetStepMessage("Recalculating group reason confidences", 90)
 
 	groupRecalcCount, err := w.processUsersWithReason(ctx, enum.UserReasonTypeGroup)
+	if errors.Is(err, core.ErrWorkerStopped) {
+		return
+	}
+
 	if err != nil {
 		w.logger.Error("Failed to recalculate group reason confidences", zap.Error(err))
 	} else {
@@ -143,6 +175,11 @@ func (w *Worker) processUsersWithoutReason(ctx context.Context, reasonType enum.
 	cursorID := int64(0)
 
 	for !utils.ContextGuardWithLog(ctx, w.logger, "Context cancelled during batch processing") {
+		// Honour control plane commands between batches
+		if !w.control.Checkpoint(ctx) {
+			return totalProcessed, core.ErrWorkerStopped
+		}
+
 		// Get batch of users without this reason type
 		users, err := w.db.Model().User().GetUsersWithoutReason(ctx, reasonType, w.batchSize, cursorID)
 		if err != nil {
@@ -196,6 +233,11 @@ func (w *Worker) processUsersWithReason(ctx context.Context, reasonType enum.Use
 	cursorID := int64(0)
 
 	for !utils.ContextGuardWithLog(ctx, w.logger, "Context cancelled during recalculation batch processing") {
+		// Honour control plane commands between batches
+		if !w.control.Checkpoint(ctx) {
+			return totalProcessed, core.ErrWorkerStopped
+		}
+
 		// Get batch of users with this reason type
 		users, err := w.db.Model().User().GetUsersWithReason(ctx, reasonType, w.batchSize, cursorID)
 		if err != nil {
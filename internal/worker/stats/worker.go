@@ -3,6 +3,7 @@ package stats
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/robalyx/rotector/internal/tui/components"
 	"github.com/robalyx/rotector/internal/worker/core"
 	"github.com/robalyx/rotector/pkg/utils"
+	"github.com/uptrace/bun"
 	"go.uber.org/zap"
 )
 
@@ -29,6 +31,8 @@ type Worker struct {
 	db          database.Client
 	bar         *components.ProgressBar
 	reporter    *core.StatusReporter
+	control     *core.Controller
+	leader      *core.LeaderElector
 	analyzer    *ai.StatsAnalyzer
 	redisClient rueidis.Client
 	logger      *zap.Logger
@@ -42,10 +46,14 @@ func New(app *setup.App, bar *components.ProgressBar, logger *zap.Logger, instan
 		logger.Fatal("Failed to get Redis client for stats", zap.Error(err))
 	}
 
+	reporter := core.NewStatusReporter(app.StatusClient, app.DB, bar, "stats", instanceID, logger)
+
 	return &Worker{
 		db:          app.DB,
 		bar:         bar,
-		reporter:    core.NewStatusReporter(app.StatusClient, "stats", instanceID, logger),
+		reporter:    reporter,
+		control:     core.NewController(app.StatusClient, "stats", &app.Config.Worker, bar, reporter, logger),
+		leader:      core.NewLeaderElector(app.StatusClient, "stats", instanceID, logger),
 		analyzer:    ai.NewStatsAnalyzer(app, logger),
 		redisClient: statsClient,
 		logger:      logger.Named("stats_worker"),
@@ -59,6 +67,16 @@ func (w *Worker) Start(ctx context.Context) {
 	w.reporter.Start(ctx)
 	defer w.reporter.Stop()
 
+	// Wait on standby until this instance is the leader
+	w.bar.SetStepMessage("Standby: waiting for leadership", 0)
+	w.reporter.UpdateStatus("Standby: waiting for leadership", 0)
+
+	ctx, ok := w.leader.Campaign(ctx)
+	if !ok {
+		return
+	}
+	defer w.leader.Resign()
+
 	w.bar.SetTotal(100)
 
 	for {
@@ -70,6 +88,11 @@ func (w *Worker) Start(ctx context.Context) {
 			return
 		}
 
+		// Honour control plane commands
+		if !w.control.Checkpoint(ctx) {
+			return
+		}
+
 		w.bar.Reset()
 		w.reporter.SetHealthy(true)
 
@@ -96,7 +119,16 @@ func (w *Worker) Start(ctx context.Context) {
 			w.bar.SetStepMessage("Saving statistics", 30)
 			w.reporter.UpdateStatus("Saving statistics", 30)
 
-			if err := w.db.Service().Stats().SaveHourlyStats(ctx); err != nil {
+			// Fence the write so a newer leader's snapshot is never overwritten
+			err := w.leader.RunFenced(ctx, w.db, func(ctx context.Context, tx bun.Tx) error {
+				return w.db.Service().Stats().SaveHourlyStatsWithTx(ctx, tx)
+			})
+			if errors.Is(err, core.ErrLeadershipLost) {
+				w.logger.Warn("Skipping hourly stats save", zap.Error(err))
+				return
+			}
+
+			if err != nil {
 				w.logger.Error("Failed to save hourly stats", zap.Error(err))
 				w.reporter.SetHealthy(false)
 
@@ -174,9 +206,8 @@ func (w *Worker) Start(ctx context.Context) {
 		nextHour := currentHour.Add(time.Hour)
 
 		// Wait for next hour
-		if utils.ContextSleepUntilWithLog(
-			ctx, nextHour, w.logger, "Context cancelled during wait for next hour, stopping stats worker",
-		) == utils.SleepCancelled {
+		if !w.control.Sleep(ctx, time.Until(nextHour)) {
+			w.logger.Info("Stopped during wait for next hour, stopping stats worker")
 			return
 		}
 
@@ -47,6 +47,7 @@ type Worker struct {
 	verificationManager *verification.ServiceManager
 	bar                 *components.ProgressBar
 	reporter            *core.StatusReporter
+	control             *core.Controller
 	logger              *zap.Logger
 	config              *config.Config
 	messageAnalyzer     *ai.MessageAnalyzer
@@ -94,7 +95,7 @@ func New(app *setup.App, bar *components.ProgressBar, logger *zap.Logger, instan
 	messageAnalyzer := ai.NewMessageAnalyzer(app, logger)
 
 	// Create status reporter
-	reporter := core.NewStatusReporter(app.StatusClient, "sync", instanceID, logger)
+	reporter := core.NewStatusReporter(app.StatusClient, app.DB, bar, "sync", instanceID, logger)
 
 	// Initialize arrays for multi-account support
 	states := make([]*state.State, 0, len(app.Config.Common.Discord.SyncTokens))
@@ -153,6 +154,7 @@ func New(app *setup.App, bar *components.ProgressBar, logger *zap.Logger, instan
 		verificationManager: verificationManager,
 		bar:                 bar,
 		reporter:            reporter,
+		control:             core.NewController(app.StatusClient, "sync", &app.Config.Worker, bar, reporter, logger),
 		logger:              syncLogger,
 		config:              app.Config,
 		messageAnalyzer:     messageAnalyzer,
@@ -218,6 +220,11 @@ func (w *Worker) Start(ctx context.Context) {
 			return
 		}
 
+		// Honour control plane commands
+		if !w.control.Checkpoint(ctx) {
+			return
+		}
+
 		w.bar.Reset()
 		w.reporter.SetHealthy(true)
 
@@ -228,7 +235,7 @@ func (w *Worker) Start(ctx context.Context) {
 		w.bar.SetStepMessage("Waiting for next cycle", 100)
 		w.reporter.UpdateStatus("Waiting for next cycle", 100)
 
-		if !utils.IntervalSleep(ctx, 15*time.Minute, w.logger, "sync worker") {
+		if !w.control.Sleep(ctx, 15*time.Minute) {
 			return
 		}
 	}
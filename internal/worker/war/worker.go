@@ -2,6 +2,7 @@ package war
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/robalyx/rotector/internal/tui/components"
 	"github.com/robalyx/rotector/internal/worker/core"
 	"github.com/robalyx/rotector/pkg/utils"
+	"github.com/uptrace/bun"
 	"go.uber.org/zap"
 )
 
@@ -31,6 +33,8 @@ type Worker struct {
 	db                    database.Client
 	bar                   *components.ProgressBar
 	reporter              *core.StatusReporter
+	control               *core.Controller
+	leader                *core.LeaderElector
 	warData               *manager.WarData
 	warManager            *manager.WarManager
 	warStats              *manager.WarStats
@@ -44,12 +48,14 @@ type Worker struct {
 // New creates a new war worker.
 func New(app *setup.App, bar *components.ProgressBar, logger *zap.Logger, instanceID string) *Worker {
 	userFetcher := fetcher.NewUserFetcher(app, logger)
-	reporter := core.NewStatusReporter(app.StatusClient, "war", instanceID, logger)
+	reporter := core.NewStatusReporter(app.StatusClient, app.DB, bar, "war", instanceID, logger)
 
 	return &Worker{
 		db:                 app.DB,
 		bar:                bar,
 		reporter:           reporter,
+		control:            core.NewController(app.StatusClient, "war", &app.Config.Worker, bar, reporter, logger),
+		leader:             core.NewLeaderElector(app.StatusClient, "war", instanceID, logger),
 		warData:            app.CFClient.WarData,
 		warManager:         app.CFClient.WarManager,
 		warStats:           manager.NewWarStats(app.CFClient.GetD1Client(), logger),
@@ -66,39 +72,43 @@ func (w *Worker) Start(ctx context.Context) {
 	w.reporter.Start(ctx)
 	defer w.reporter.Stop()
 
-	w.bar.SetTotal(100)
-	w.bar.Reset()
-	w.reporter.SetHealthy(true)
+	// Wait on standby until this instance is the leader
+	w.bar.SetStepMessage("Standby: waiting for leadership", 0)
+	w.reporter.UpdateStatus("Standby: waiting for leadership", 0)
 
-	if err := w.updateWarState(ctx); err != nil {
-		w.logger.Error("Failed to update war state", zap.Error(err))
-		w.reporter.SetHealthy(false)
+	ctx, ok := w.leader.Campaign(ctx)
+	if !ok {
+		return
 	}
+	defer w.leader.Resign()
 
-	ticker := time.NewTicker(5 * time.Minute) // Check every 5 minutes
-	defer ticker.Stop()
+	w.bar.SetTotal(100)
 
 	for {
-		select {
-		case <-ctx.Done():
-			w.bar.SetStepMessage("Shutting down", 100)
-			w.reporter.UpdateStatus("Shutting down", 100)
-
+		// Honour control plane commands
+		if !w.control.Checkpoint(ctx) {
 			return
+		}
 
-		case <-ticker.C:
-			w.bar.Reset()
-			w.reporter.SetHealthy(true)
+		w.bar.Reset()
+		w.reporter.SetHealthy(true)
 
-			if err := w.updateWarState(ctx); err != nil {
-				w.logger.Error("Failed to update war state", zap.Error(err))
-				w.reporter.SetHealthy(false)
+		if err := w.updateWarState(ctx); err != nil {
+			w.logger.Error("Failed to update war state", zap.Error(err))
+			w.reporter.SetHealthy(false)
 
-				if !utils.ErrorSleep(ctx, 30*time.Second, w.logger, "war worker") {
-					return
-				}
+			if !utils.ErrorSleep(ctx, 30*time.Second, w.logger, "war worker") {
+				return
 			}
 		}
+
+		// Check every 5 minutes unless woken by a run-now command
+		if !w.control.Sleep(ctx, 5*time.Minute) {
+			w.bar.SetStepMessage("Shutting down", 100)
+			w.reporter.UpdateStatus("Shutting down", 100)
+
+			return
+		}
 	}
 }
 
@@ -467,7 +477,15 @@ func (w *Worker) processExtensionReports(ctx context.Context) error {
 	processedCount := 0
 
 	for _, report := range reports {
-		if err := w.processExtensionReport(ctx, report); err != nil {
+		// Fence each report so a newer leader never processes it concurrently
+		err := w.leader.RunFenced(ctx, w.db, func(ctx context.Context, _ bun.Tx) error {
+			return w.processExtensionReport(ctx, report)
+		})
+		if errors.Is(err, core.ErrLeadershipLost) {
+			return fmt.Errorf("stopping extension reports: %w", err)
+		}
+
+		if err != nil {
 			w.logger.Warn("Failed to process extension report",
 				zap.Int64("reportID", report.ID),
 				zap.Error(err))
@@ -672,8 +690,16 @@ func (w *Worker) updateLeaderboardCache(ctx context.Context) {
 		return
 	}
 
-	// Update leaderboard cache
-	if err := w.leaderboardManager.SaveLeaderboardToR2(ctx); err != nil {
+	// Fence the upload so a stale leader cannot overwrite a newer leaderboard
+	err := w.leader.RunFenced(ctx, w.db, func(ctx context.Context, _ bun.Tx) error {
+		return w.leaderboardManager.SaveLeaderboardToR2(ctx)
+	})
+	if errors.Is(err, core.ErrLeadershipLost) {
+		w.logger.Warn("Skipping leaderboard update", zap.Error(err))
+		return
+	}
+
+	if err != nil {
 		w.logger.Error("Failed to update leaderboard cache", zap.Error(err))
 
 		return
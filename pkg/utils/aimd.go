@@ -0,0 +1,181 @@
+package utils
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// AIMDBounds limits the values an AIMDController may choose.
+type AIMDBounds struct {
+	MinBatch       int
+	MaxBatch       int
+	MinConcurrency int
+	MaxConcurrency int
+}
+
+// AIMDOptions configures the signals an AIMDController reacts to.
+type AIMDOptions struct {
+	// TargetLatency is the request latency above which the batch size shrinks.
+	// Zero disables latency-based decreases.
+	TargetLatency time.Duration
+	// TokenBudget is the completion token count above which the batch size shrinks.
+	// Zero disables token-based decreases.
+	TokenBudget int64
+	// DecreaseFactor is the multiplier applied when decreasing, between 0 and 1.
+	DecreaseFactor float64
+}
+
+// AIMDOutcome describes the result of a single request.
+type AIMDOutcome struct {
+	Latency     time.Duration
+	Tokens      int64
+	Truncated   bool
+	RateLimited bool
+	Failed      bool
+}
+
+// AIMDState is a snapshot of an AIMDController's current settings.
+type AIMDState struct {
+	BatchSize   int
+	Concurrency int
+	InFlight    int
+}
+
+// AIMDController adapts batch size and concurrency using additive increase
+// and multiplicative decrease. Truncated responses and slow or token-heavy
+// requests shrink the batch size, rate limits shrink concurrency, and runs of
+// clean requests grow both by one. It also acts as a resizable semaphore.
+type AIMDController struct {
+	mu          sync.Mutex
+	bounds      AIMDBounds
+	opts        AIMDOptions
+	batchSize   int
+	concurrency int
+	inFlight    int
+	successes   int
+	wake        chan struct{}
+}
+
+// NewAIMDController creates a controller starting at the given values,
+// clamped to the bounds.
+func NewAIMDController(batchSize, concurrency int, bounds AIMDBounds, opts AIMDOptions) *AIMDController {
+	bounds.MinBatch = max(bounds.MinBatch, 1)
+	bounds.MaxBatch = max(bounds.MaxBatch, bounds.MinBatch)
+	bounds.MinConcurrency = max(bounds.MinConcurrency, 1)
+	bounds.MaxConcurrency = max(bounds.MaxConcurrency, bounds.MinConcurrency)
+
+	if opts.DecreaseFactor <= 0 || opts.DecreaseFactor >= 1 {
+		opts.DecreaseFactor = 0.5
+	}
+
+	return &AIMDController{
+		bounds:      bounds,
+		opts:        opts,
+		batchSize:   min(max(batchSize, bounds.MinBatch), bounds.MaxBatch),
+		concurrency: min(max(concurrency, bounds.MinConcurrency), bounds.MaxConcurrency),
+		wake:        make(chan struct{}),
+	}
+}
+
+// NewFixedAIMDController creates a controller that never changes its values.
+func NewFixedAIMDController(batchSize, concurrency int) *AIMDController {
+	return NewAIMDController(batchSize, concurrency, AIMDBounds{
+		MinBatch:       batchSize,
+		MaxBatch:       batchSize,
+		MinConcurrency: concurrency,
+		MaxConcurrency: concurrency,
+	}, AIMDOptions{})
+}
+
+// BatchSize returns the current batch size.
+func (c *AIMDController) BatchSize() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.batchSize
+}
+
+// State returns a snapshot of the current settings.
+func (c *AIMDController) State() AIMDState {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return AIMDState{
+		BatchSize:   c.batchSize,
+		Concurrency: c.concurrency,
+		InFlight:    c.inFlight,
+	}
+}
+
+// Acquire blocks until a request slot is available under the current concurrency.
+func (c *AIMDController) Acquire(ctx context.Context) error {
+	for {
+		c.mu.Lock()
+		if c.inFlight < c.concurrency {
+			c.inFlight++
+			c.mu.Unlock()
+
+			return nil
+		}
+
+		wake := c.wake
+		c.mu.Unlock()
+
+		select {
+		case <-wake:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Release frees a request slot acquired with Acquire.
+func (c *AIMDController) Release() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.inFlight--
+	c.broadcast()
+}
+
+// Observe adjusts the settings based on the outcome of a request.
+func (c *AIMDController) Observe(outcome AIMDOutcome) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch {
+	case outcome.RateLimited:
+		c.concurrency = c.decrease(c.concurrency, c.bounds.MinConcurrency)
+		c.successes = 0
+	case outcome.Truncated:
+		c.batchSize = c.decrease(c.batchSize, c.bounds.MinBatch)
+		c.successes = 0
+	case outcome.Failed:
+		c.successes = 0
+	case c.opts.TargetLatency > 0 && outcome.Latency > c.opts.TargetLatency,
+		c.opts.TokenBudget > 0 && outcome.Tokens > c.opts.TokenBudget:
+		c.batchSize = c.decrease(c.batchSize, c.bounds.MinBatch)
+		c.successes = 0
+	default:
+		// Grow once per round of clean requests across all slots
+		c.successes++
+		if c.successes >= c.concurrency {
+			c.successes = 0
+			c.batchSize = min(c.batchSize+1, c.bounds.MaxBatch)
+			c.concurrency = min(c.concurrency+1, c.bounds.MaxConcurrency)
+			c.broadcast()
+		}
+	}
+}
+
+// decrease applies the multiplicative decrease without going below the minimum.
+func (c *AIMDController) decrease(value, minValue int) int {
+	return max(int(float64(value)*c.opts.DecreaseFactor), minValue)
+}
+
+// broadcast wakes all goroutines waiting in Acquire. Must be called with the lock held.
+func (c *AIMDController) broadcast() {
+	close(c.wake)
+	c.wake = make(chan struct{})
+}
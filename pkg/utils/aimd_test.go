@@ -0,0 +1,137 @@
+package utils_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/robalyx/rotector/pkg/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAIMDController(t *testing.T) {
+	t.Parallel()
+
+	bounds := utils.AIMDBounds{MinBatch: 2, MaxBatch: 20, MinConcurrency: 1, MaxConcurrency: 4}
+	opts := utils.AIMDOptions{TargetLatency: 10 * time.Second, TokenBudget: 1000, DecreaseFactor: 0.5}
+
+	tests := []struct {
+		name            string
+		outcomes        []utils.AIMDOutcome
+		wantBatch       int
+		wantConcurrency int
+	}{
+		{
+			name:            "initial values",
+			wantBatch:       10,
+			wantConcurrency: 2,
+		},
+		{
+			name:            "truncation halves batch size",
+			outcomes:        []utils.AIMDOutcome{{Truncated: true}},
+			wantBatch:       5,
+			wantConcurrency: 2,
+		},
+		{
+			name:            "rate limit halves concurrency",
+			outcomes:        []utils.AIMDOutcome{{RateLimited: true}},
+			wantBatch:       10,
+			wantConcurrency: 1,
+		},
+		{
+			name:            "slow request shrinks batch size",
+			outcomes:        []utils.AIMDOutcome{{Latency: 20 * time.Second}},
+			wantBatch:       5,
+			wantConcurrency: 2,
+		},
+		{
+			name:            "token heavy request shrinks batch size",
+			outcomes:        []utils.AIMDOutcome{{Tokens: 2000}},
+			wantBatch:       5,
+			wantConcurrency: 2,
+		},
+		{
+			name:            "round of clean requests grows both",
+			outcomes:        []utils.AIMDOutcome{{Latency: time.Second}, {Latency: time.Second}},
+			wantBatch:       11,
+			wantConcurrency: 3,
+		},
+		{
+			name:            "failure resets growth",
+			outcomes:        []utils.AIMDOutcome{{Latency: time.Second}, {Failed: true}, {Latency: time.Second}},
+			wantBatch:       10,
+			wantConcurrency: 2,
+		},
+		{
+			name: "decrease stops at minimum",
+			outcomes: []utils.AIMDOutcome{
+				{Truncated: true}, {Truncated: true}, {Truncated: true}, {RateLimited: true}, {RateLimited: true},
+			},
+			wantBatch:       2,
+			wantConcurrency: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := utils.NewAIMDController(10, 2, bounds, opts)
+			for _, outcome := range tt.outcomes {
+				c.Observe(outcome)
+			}
+
+			state := c.State()
+			assert.Equal(t, tt.wantBatch, state.BatchSize)
+			assert.Equal(t, tt.wantConcurrency, state.Concurrency)
+		})
+	}
+}
+
+func TestAIMDControllerBounds(t *testing.T) {
+	t.Parallel()
+
+	c := utils.NewAIMDController(50, 0, utils.AIMDBounds{MinBatch: 1, MaxBatch: 12, MinConcurrency: 1, MaxConcurrency: 2}, utils.AIMDOptions{})
+	assert.Equal(t, 12, c.BatchSize())
+	assert.Equal(t, 1, c.State().Concurrency)
+
+	for range 20 {
+		c.Observe(utils.AIMDOutcome{})
+	}
+
+	assert.Equal(t, 12, c.BatchSize())
+	assert.Equal(t, 2, c.State().Concurrency)
+
+	fixed := utils.NewFixedAIMDController(15, 5)
+	fixed.Observe(utils.AIMDOutcome{Truncated: true})
+	fixed.Observe(utils.AIMDOutcome{RateLimited: true})
+	assert.Equal(t, utils.AIMDState{BatchSize: 15, Concurrency: 5}, fixed.State())
+}
+
+func TestAIMDControllerAcquire(t *testing.T) {
+	t.Parallel()
+
+	c := utils.NewFixedAIMDController(1, 1)
+	require.NoError(t, c.Acquire(context.Background()))
+
+	// Second acquire blocks until the context expires
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, c.Acquire(ctx), context.DeadlineExceeded)
+
+	// Releasing the slot unblocks a waiting acquire
+	done := make(chan error, 1)
+
+	go func() { done <- c.Acquire(context.Background()) }()
+
+	c.Release()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("acquire did not unblock after release")
+	}
+}
@@ -44,3 +44,73 @@ func CalculateProcessingInterval(createdAt time.Time) time.Duration {
 
 	return scaledInterval
 }
+
+const (
+	// MinRiskProcessingInterval is the shortest time between scans for the highest risk users.
+	MinRiskProcessingInterval = 6 * time.Hour
+	// RiskIntervalReduction is the fraction of the age-based interval removed at maximum risk.
+	RiskIntervalReduction = 0.75
+	// ClearedRiskMultiplier scales down the risk score of users cleared by a reviewer.
+	ClearedRiskMultiplier = 0.25
+	// ClearedIntervalMultiplier stretches the interval of users cleared by a reviewer.
+	ClearedIntervalMultiplier = 2.0
+)
+
+// RiskFactors contains the signals used to schedule a user's next scan.
+type RiskFactors struct {
+	Confidence         float64 // Confidence from the last scan (0-1)
+	NeighbourhoodRisk  float64 // Risk from flagged friends and groups (0-1)
+	DescriptionChanged bool    // Whether the description changed since the last scan
+	FriendCountChanged bool    // Whether the friend count changed since the last scan
+	Cleared            bool    // Whether a reviewer cleared the user
+	Confirmed          bool    // Whether a reviewer confirmed the user
+}
+
+// CalculateRiskScore combines the risk factors into a score between 0 and 1.
+// Higher scores are scanned sooner and pulled first from the scan queue.
+func CalculateRiskScore(factors RiskFactors) float64 {
+	if factors.Confirmed {
+		return 0
+	}
+
+	score := 0.5*clampUnit(factors.Confidence) + 0.3*clampUnit(factors.NeighbourhoodRisk)
+
+	if factors.DescriptionChanged {
+		score += 0.1
+	}
+
+	if factors.FriendCountChanged {
+		score += 0.1
+	}
+
+	if factors.Cleared {
+		score *= ClearedRiskMultiplier
+	}
+
+	return math.Round(clampUnit(score)*100) / 100
+}
+
+// CalculateRiskInterval determines how long to wait before reprocessing a user based on
+// their account age and risk. The age-based interval is shortened by up to 75% for the
+// riskiest users, cleared users wait twice as long, and confirmed users wait the maximum.
+func CalculateRiskInterval(createdAt time.Time, factors RiskFactors) time.Duration {
+	if factors.Confirmed {
+		return MaxProcessingInterval
+	}
+
+	interval := float64(CalculateProcessingInterval(createdAt))
+
+	if factors.Cleared {
+		return min(time.Duration(interval*ClearedIntervalMultiplier), MaxProcessingInterval)
+	}
+
+	score := CalculateRiskScore(factors)
+	scaled := time.Duration(interval * (1 - score*RiskIntervalReduction))
+
+	return max(scaled, MinRiskProcessingInterval)
+}
+
+// clampUnit restricts a value to the range 0 to 1.
+func clampUnit(value float64) float64 {
+	return math.Max(0, math.Min(value, 1))
+}
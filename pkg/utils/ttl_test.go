@@ -164,3 +164,117 @@ func TestCalculateProcessingInterval_PowerCurve(t *testing.T) {
 			"power curve should show acceleration: later growth should exceed early growth")
 	})
 }
+
+func TestCalculateRiskScore(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		factors  utils.RiskFactors
+		expected float64
+	}{
+		{
+			name:     "no risk signals",
+			factors:  utils.RiskFactors{},
+			expected: 0,
+		},
+		{
+			name:     "confidence and neighbourhood risk",
+			factors:  utils.RiskFactors{Confidence: 0.8, NeighbourhoodRisk: 0.5},
+			expected: 0.55,
+		},
+		{
+			name: "all signals at maximum",
+			factors: utils.RiskFactors{
+				Confidence: 1, NeighbourhoodRisk: 1, DescriptionChanged: true, FriendCountChanged: true,
+			},
+			expected: 1,
+		},
+		{
+			name:     "profile churn only",
+			factors:  utils.RiskFactors{DescriptionChanged: true, FriendCountChanged: true},
+			expected: 0.2,
+		},
+		{
+			name:     "cleared by reviewer",
+			factors:  utils.RiskFactors{Confidence: 0.8, NeighbourhoodRisk: 0.5, Cleared: true},
+			expected: 0.14,
+		},
+		{
+			name:     "confirmed by reviewer",
+			factors:  utils.RiskFactors{Confidence: 1, Confirmed: true},
+			expected: 0,
+		},
+		{
+			name:     "out of range values are clamped",
+			factors:  utils.RiskFactors{Confidence: 2, NeighbourhoodRisk: -1},
+			expected: 0.5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := utils.CalculateRiskScore(tt.factors)
+			assert.InDelta(t, tt.expected, got, 0.001)
+		})
+	}
+}
+
+func TestCalculateRiskInterval(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	oldAccount := now.Add(-365 * 24 * time.Hour)
+
+	t.Run("no risk matches age-based interval", func(t *testing.T) {
+		t.Parallel()
+
+		interval := utils.CalculateRiskInterval(oldAccount, utils.RiskFactors{})
+		assert.Equal(t, utils.MaxProcessingInterval, interval)
+	})
+
+	t.Run("maximum risk shortens interval", func(t *testing.T) {
+		t.Parallel()
+
+		interval := utils.CalculateRiskInterval(oldAccount, utils.RiskFactors{
+			Confidence: 1, NeighbourhoodRisk: 1, DescriptionChanged: true, FriendCountChanged: true,
+		})
+		assert.Equal(t, 7*24*time.Hour+12*time.Hour, interval)
+	})
+
+	t.Run("minimum risk interval enforced", func(t *testing.T) {
+		t.Parallel()
+
+		interval := utils.CalculateRiskInterval(now.Add(-12*time.Hour), utils.RiskFactors{
+			Confidence: 1, NeighbourhoodRisk: 1, DescriptionChanged: true, FriendCountChanged: true,
+		})
+		assert.Equal(t, 6*time.Hour, interval)
+	})
+
+	t.Run("cleared users wait longer", func(t *testing.T) {
+		t.Parallel()
+
+		createdAt := now.Add(-14 * 24 * time.Hour)
+		base := utils.CalculateProcessingInterval(createdAt)
+		interval := utils.CalculateRiskInterval(createdAt, utils.RiskFactors{Confidence: 0.9, Cleared: true})
+		assert.InDelta(t, float64(2*base), float64(interval), float64(time.Minute))
+	})
+
+	t.Run("confirmed users wait the maximum", func(t *testing.T) {
+		t.Parallel()
+
+		interval := utils.CalculateRiskInterval(now.Add(-12*time.Hour), utils.RiskFactors{Confirmed: true})
+		assert.Equal(t, utils.MaxProcessingInterval, interval)
+	})
+
+	t.Run("higher risk never scans later", func(t *testing.T) {
+		t.Parallel()
+
+		createdAt := now.Add(-30 * 24 * time.Hour)
+		low := utils.CalculateRiskInterval(createdAt, utils.RiskFactors{Confidence: 0.2})
+		high := utils.CalculateRiskInterval(createdAt, utils.RiskFactors{Confidence: 0.9})
+		assert.Less(t, high, low)
+	})
+}